@@ -0,0 +1,102 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/randfill"
+
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+	"sigs.k8s.io/gateway-api/apis/v1alpha3"
+	"sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+const fuzzIterations = 100
+
+func TestConversionRoundTrip(t *testing.T) {
+	scheme := runtime.NewScheme()
+	for _, install := range []func(*runtime.Scheme) error{v1.Install, v1alpha2.Install, v1alpha3.Install, v1beta1.Install} {
+		require.NoError(t, install(scheme))
+	}
+
+	testCases := []struct {
+		name  string
+		spoke func() runtime.Object
+		hub   func() runtime.Object
+	}{
+		{"v1alpha2 GRPCRoute", func() runtime.Object { return &v1alpha2.GRPCRoute{} }, func() runtime.Object { return &v1.GRPCRoute{} }},
+		{"v1alpha2 ReferenceGrant", func() runtime.Object { return &v1alpha2.ReferenceGrant{} }, func() runtime.Object { return &v1.ReferenceGrant{} }},
+		{"v1alpha2 TCPRoute", func() runtime.Object { return &v1alpha2.TCPRoute{} }, func() runtime.Object { return &v1.TCPRoute{} }},
+		{"v1alpha2 TLSRoute", func() runtime.Object { return &v1alpha2.TLSRoute{} }, func() runtime.Object { return &v1.TLSRoute{} }},
+		{"v1alpha2 UDPRoute", func() runtime.Object { return &v1alpha2.UDPRoute{} }, func() runtime.Object { return &v1.UDPRoute{} }},
+		{"v1alpha3 BackendTLSPolicy", func() runtime.Object { return &v1alpha3.BackendTLSPolicy{} }, func() runtime.Object { return &v1.BackendTLSPolicy{} }},
+		{"v1alpha3 TLSRoute", func() runtime.Object { return &v1alpha3.TLSRoute{} }, func() runtime.Object { return &v1.TLSRoute{} }},
+		{"v1beta1 Gateway", func() runtime.Object { return &v1beta1.Gateway{} }, func() runtime.Object { return &v1.Gateway{} }},
+		{"v1beta1 GatewayClass", func() runtime.Object { return &v1beta1.GatewayClass{} }, func() runtime.Object { return &v1.GatewayClass{} }},
+		{"v1beta1 HTTPRoute", func() runtime.Object { return &v1beta1.HTTPRoute{} }, func() runtime.Object { return &v1.HTTPRoute{} }},
+		{"v1beta1 ReferenceGrant", func() runtime.Object { return &v1beta1.ReferenceGrant{} }, func() runtime.Object { return &v1.ReferenceGrant{} }},
+	}
+
+	filler := randfill.New().NilChance(0.3).NumElements(1, 3).Funcs(
+		// the type meta is not converted, as the caller sets the one of the
+		// destination version
+		func(*metav1.TypeMeta, randfill.Continue) {},
+	)
+	for _, tc := range testCases {
+		t.Run(tc.name+" spoke-hub-spoke", func(t *testing.T) {
+			for range fuzzIterations {
+				spoke := tc.spoke()
+				filler.Fill(spoke)
+				hub := tc.hub()
+				require.NoError(t, scheme.Convert(spoke, hub, nil))
+				res := tc.spoke()
+				require.NoError(t, scheme.Convert(hub, res, nil))
+				require.True(t, apiequality.Semantic.DeepEqual(spoke, res), "round trip changed %#v into %#v", spoke, res)
+			}
+		})
+		t.Run(tc.name+" hub-spoke-hub", func(t *testing.T) {
+			for range fuzzIterations {
+				hub := tc.hub()
+				filler.Fill(hub)
+				spoke := tc.spoke()
+				require.NoError(t, scheme.Convert(hub, spoke, nil))
+				res := tc.hub()
+				require.NoError(t, scheme.Convert(spoke, res, nil))
+				require.True(t, apiequality.Semantic.DeepEqual(hub, res), "round trip changed %#v into %#v", hub, res)
+			}
+		})
+	}
+}
+
+func TestSchemeConversion(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1.Install(scheme))
+	require.NoError(t, v1beta1.Install(scheme))
+
+	var in v1beta1.HTTPRoute
+	randfill.New().NilChance(0.3).NumElements(1, 3).Fill(&in)
+	var out v1.HTTPRoute
+	require.NoError(t, scheme.Convert(&in, &out, nil))
+	require.True(t, apiequality.Semantic.DeepEqual(in.Spec, out.Spec))
+	require.True(t, apiequality.Semantic.DeepEqual(in.Status, out.Status))
+}
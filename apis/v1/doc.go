@@ -17,6 +17,11 @@ limitations under the License.
 // Package v1 contains API Schema definitions for the gateway.networking.k8s.io
 // API group.
 //
+// The v1 types are the hubs the other versions of their kinds are converted
+// through. The hub and spoke converters controller-runtime conversion
+// webhooks can be built with are provided by the
+// sigs.k8s.io/gateway-api/crdtools/conversion package.
+//
 // +k8s:openapi-gen=true
 // +kubebuilder:object:generate=true
 // +groupName=gateway.networking.k8s.io
@@ -0,0 +1,843 @@
+//go:build !ignore_autogenerated
+// +build !ignore_autogenerated
+
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by conversion-gen. DO NOT EDIT.
+
+package v1alpha2
+
+import (
+	unsafe "unsafe"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	conversion "k8s.io/apimachinery/pkg/conversion"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+func init() {
+	localSchemeBuilder.Register(RegisterConversions)
+}
+
+// RegisterConversions adds conversion functions to the given scheme.
+// Public to allow building arbitrary schemes.
+func RegisterConversions(s *runtime.Scheme) error {
+	if err := s.AddGeneratedConversionFunc((*GRPCRoute)(nil), (*v1.GRPCRoute)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_GRPCRoute_To_v1_GRPCRoute(a.(*GRPCRoute), b.(*v1.GRPCRoute), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.GRPCRoute)(nil), (*GRPCRoute)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_GRPCRoute_To_v1alpha2_GRPCRoute(a.(*v1.GRPCRoute), b.(*GRPCRoute), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*GRPCRouteList)(nil), (*v1.GRPCRouteList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_GRPCRouteList_To_v1_GRPCRouteList(a.(*GRPCRouteList), b.(*v1.GRPCRouteList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.GRPCRouteList)(nil), (*GRPCRouteList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_GRPCRouteList_To_v1alpha2_GRPCRouteList(a.(*v1.GRPCRouteList), b.(*GRPCRouteList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*LocalPolicyTargetReference)(nil), (*v1.LocalPolicyTargetReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_LocalPolicyTargetReference_To_v1_LocalPolicyTargetReference(a.(*LocalPolicyTargetReference), b.(*v1.LocalPolicyTargetReference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.LocalPolicyTargetReference)(nil), (*LocalPolicyTargetReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_LocalPolicyTargetReference_To_v1alpha2_LocalPolicyTargetReference(a.(*v1.LocalPolicyTargetReference), b.(*LocalPolicyTargetReference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*LocalPolicyTargetReferenceWithSectionName)(nil), (*v1.LocalPolicyTargetReferenceWithSectionName)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_LocalPolicyTargetReferenceWithSectionName_To_v1_LocalPolicyTargetReferenceWithSectionName(a.(*LocalPolicyTargetReferenceWithSectionName), b.(*v1.LocalPolicyTargetReferenceWithSectionName), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.LocalPolicyTargetReferenceWithSectionName)(nil), (*LocalPolicyTargetReferenceWithSectionName)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_LocalPolicyTargetReferenceWithSectionName_To_v1alpha2_LocalPolicyTargetReferenceWithSectionName(a.(*v1.LocalPolicyTargetReferenceWithSectionName), b.(*LocalPolicyTargetReferenceWithSectionName), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NamespacedPolicyTargetReference)(nil), (*v1.NamespacedPolicyTargetReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_NamespacedPolicyTargetReference_To_v1_NamespacedPolicyTargetReference(a.(*NamespacedPolicyTargetReference), b.(*v1.NamespacedPolicyTargetReference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.NamespacedPolicyTargetReference)(nil), (*NamespacedPolicyTargetReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_NamespacedPolicyTargetReference_To_v1alpha2_NamespacedPolicyTargetReference(a.(*v1.NamespacedPolicyTargetReference), b.(*NamespacedPolicyTargetReference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PolicyAncestorStatus)(nil), (*v1.PolicyAncestorStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_PolicyAncestorStatus_To_v1_PolicyAncestorStatus(a.(*PolicyAncestorStatus), b.(*v1.PolicyAncestorStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.PolicyAncestorStatus)(nil), (*PolicyAncestorStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_PolicyAncestorStatus_To_v1alpha2_PolicyAncestorStatus(a.(*v1.PolicyAncestorStatus), b.(*PolicyAncestorStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PolicyStatus)(nil), (*v1.PolicyStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_PolicyStatus_To_v1_PolicyStatus(a.(*PolicyStatus), b.(*v1.PolicyStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.PolicyStatus)(nil), (*PolicyStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_PolicyStatus_To_v1alpha2_PolicyStatus(a.(*v1.PolicyStatus), b.(*PolicyStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ReferenceGrant)(nil), (*v1.ReferenceGrant)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_ReferenceGrant_To_v1_ReferenceGrant(a.(*ReferenceGrant), b.(*v1.ReferenceGrant), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.ReferenceGrant)(nil), (*ReferenceGrant)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ReferenceGrant_To_v1alpha2_ReferenceGrant(a.(*v1.ReferenceGrant), b.(*ReferenceGrant), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ReferenceGrantList)(nil), (*v1.ReferenceGrantList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_ReferenceGrantList_To_v1_ReferenceGrantList(a.(*ReferenceGrantList), b.(*v1.ReferenceGrantList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.ReferenceGrantList)(nil), (*ReferenceGrantList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ReferenceGrantList_To_v1alpha2_ReferenceGrantList(a.(*v1.ReferenceGrantList), b.(*ReferenceGrantList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TCPRoute)(nil), (*v1.TCPRoute)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_TCPRoute_To_v1_TCPRoute(a.(*TCPRoute), b.(*v1.TCPRoute), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.TCPRoute)(nil), (*TCPRoute)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_TCPRoute_To_v1alpha2_TCPRoute(a.(*v1.TCPRoute), b.(*TCPRoute), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TCPRouteList)(nil), (*v1.TCPRouteList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_TCPRouteList_To_v1_TCPRouteList(a.(*TCPRouteList), b.(*v1.TCPRouteList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.TCPRouteList)(nil), (*TCPRouteList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_TCPRouteList_To_v1alpha2_TCPRouteList(a.(*v1.TCPRouteList), b.(*TCPRouteList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TCPRouteRule)(nil), (*v1.TCPRouteRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_TCPRouteRule_To_v1_TCPRouteRule(a.(*TCPRouteRule), b.(*v1.TCPRouteRule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.TCPRouteRule)(nil), (*TCPRouteRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_TCPRouteRule_To_v1alpha2_TCPRouteRule(a.(*v1.TCPRouteRule), b.(*TCPRouteRule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TCPRouteSpec)(nil), (*v1.TCPRouteSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_TCPRouteSpec_To_v1_TCPRouteSpec(a.(*TCPRouteSpec), b.(*v1.TCPRouteSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.TCPRouteSpec)(nil), (*TCPRouteSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_TCPRouteSpec_To_v1alpha2_TCPRouteSpec(a.(*v1.TCPRouteSpec), b.(*TCPRouteSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TCPRouteStatus)(nil), (*v1.TCPRouteStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_TCPRouteStatus_To_v1_TCPRouteStatus(a.(*TCPRouteStatus), b.(*v1.TCPRouteStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.TCPRouteStatus)(nil), (*TCPRouteStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_TCPRouteStatus_To_v1alpha2_TCPRouteStatus(a.(*v1.TCPRouteStatus), b.(*TCPRouteStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TLSRoute)(nil), (*v1.TLSRoute)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_TLSRoute_To_v1_TLSRoute(a.(*TLSRoute), b.(*v1.TLSRoute), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.TLSRoute)(nil), (*TLSRoute)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_TLSRoute_To_v1alpha2_TLSRoute(a.(*v1.TLSRoute), b.(*TLSRoute), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TLSRouteList)(nil), (*v1.TLSRouteList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_TLSRouteList_To_v1_TLSRouteList(a.(*TLSRouteList), b.(*v1.TLSRouteList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.TLSRouteList)(nil), (*TLSRouteList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_TLSRouteList_To_v1alpha2_TLSRouteList(a.(*v1.TLSRouteList), b.(*TLSRouteList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TLSRouteRule)(nil), (*v1.TLSRouteRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_TLSRouteRule_To_v1_TLSRouteRule(a.(*TLSRouteRule), b.(*v1.TLSRouteRule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.TLSRouteRule)(nil), (*TLSRouteRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_TLSRouteRule_To_v1alpha2_TLSRouteRule(a.(*v1.TLSRouteRule), b.(*TLSRouteRule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TLSRouteSpec)(nil), (*v1.TLSRouteSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_TLSRouteSpec_To_v1_TLSRouteSpec(a.(*TLSRouteSpec), b.(*v1.TLSRouteSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.TLSRouteSpec)(nil), (*TLSRouteSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_TLSRouteSpec_To_v1alpha2_TLSRouteSpec(a.(*v1.TLSRouteSpec), b.(*TLSRouteSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TLSRouteStatus)(nil), (*v1.TLSRouteStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_TLSRouteStatus_To_v1_TLSRouteStatus(a.(*TLSRouteStatus), b.(*v1.TLSRouteStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.TLSRouteStatus)(nil), (*TLSRouteStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_TLSRouteStatus_To_v1alpha2_TLSRouteStatus(a.(*v1.TLSRouteStatus), b.(*TLSRouteStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*UDPRoute)(nil), (*v1.UDPRoute)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_UDPRoute_To_v1_UDPRoute(a.(*UDPRoute), b.(*v1.UDPRoute), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.UDPRoute)(nil), (*UDPRoute)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_UDPRoute_To_v1alpha2_UDPRoute(a.(*v1.UDPRoute), b.(*UDPRoute), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*UDPRouteList)(nil), (*v1.UDPRouteList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_UDPRouteList_To_v1_UDPRouteList(a.(*UDPRouteList), b.(*v1.UDPRouteList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.UDPRouteList)(nil), (*UDPRouteList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_UDPRouteList_To_v1alpha2_UDPRouteList(a.(*v1.UDPRouteList), b.(*UDPRouteList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*UDPRouteRule)(nil), (*v1.UDPRouteRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_UDPRouteRule_To_v1_UDPRouteRule(a.(*UDPRouteRule), b.(*v1.UDPRouteRule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.UDPRouteRule)(nil), (*UDPRouteRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_UDPRouteRule_To_v1alpha2_UDPRouteRule(a.(*v1.UDPRouteRule), b.(*UDPRouteRule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*UDPRouteSpec)(nil), (*v1.UDPRouteSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_UDPRouteSpec_To_v1_UDPRouteSpec(a.(*UDPRouteSpec), b.(*v1.UDPRouteSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.UDPRouteSpec)(nil), (*UDPRouteSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_UDPRouteSpec_To_v1alpha2_UDPRouteSpec(a.(*v1.UDPRouteSpec), b.(*UDPRouteSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*UDPRouteStatus)(nil), (*v1.UDPRouteStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_UDPRouteStatus_To_v1_UDPRouteStatus(a.(*UDPRouteStatus), b.(*v1.UDPRouteStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.UDPRouteStatus)(nil), (*UDPRouteStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_UDPRouteStatus_To_v1alpha2_UDPRouteStatus(a.(*v1.UDPRouteStatus), b.(*UDPRouteStatus), scope)
+	}); err != nil {
+		return err
+	}
+	return nil
+}
+
+func autoConvert_v1alpha2_GRPCRoute_To_v1_GRPCRoute(in *GRPCRoute, out *v1.GRPCRoute, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	out.Spec = in.Spec
+	out.Status = in.Status
+	return nil
+}
+
+// Convert_v1alpha2_GRPCRoute_To_v1_GRPCRoute is an autogenerated conversion function.
+func Convert_v1alpha2_GRPCRoute_To_v1_GRPCRoute(in *GRPCRoute, out *v1.GRPCRoute, s conversion.Scope) error {
+	return autoConvert_v1alpha2_GRPCRoute_To_v1_GRPCRoute(in, out, s)
+}
+
+func autoConvert_v1_GRPCRoute_To_v1alpha2_GRPCRoute(in *v1.GRPCRoute, out *GRPCRoute, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	out.Spec = in.Spec
+	out.Status = in.Status
+	return nil
+}
+
+// Convert_v1_GRPCRoute_To_v1alpha2_GRPCRoute is an autogenerated conversion function.
+func Convert_v1_GRPCRoute_To_v1alpha2_GRPCRoute(in *v1.GRPCRoute, out *GRPCRoute, s conversion.Scope) error {
+	return autoConvert_v1_GRPCRoute_To_v1alpha2_GRPCRoute(in, out, s)
+}
+
+func autoConvert_v1alpha2_GRPCRouteList_To_v1_GRPCRouteList(in *GRPCRouteList, out *v1.GRPCRouteList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]v1.GRPCRoute)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha2_GRPCRouteList_To_v1_GRPCRouteList is an autogenerated conversion function.
+func Convert_v1alpha2_GRPCRouteList_To_v1_GRPCRouteList(in *GRPCRouteList, out *v1.GRPCRouteList, s conversion.Scope) error {
+	return autoConvert_v1alpha2_GRPCRouteList_To_v1_GRPCRouteList(in, out, s)
+}
+
+func autoConvert_v1_GRPCRouteList_To_v1alpha2_GRPCRouteList(in *v1.GRPCRouteList, out *GRPCRouteList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]GRPCRoute)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1_GRPCRouteList_To_v1alpha2_GRPCRouteList is an autogenerated conversion function.
+func Convert_v1_GRPCRouteList_To_v1alpha2_GRPCRouteList(in *v1.GRPCRouteList, out *GRPCRouteList, s conversion.Scope) error {
+	return autoConvert_v1_GRPCRouteList_To_v1alpha2_GRPCRouteList(in, out, s)
+}
+
+func autoConvert_v1alpha2_LocalPolicyTargetReference_To_v1_LocalPolicyTargetReference(in *LocalPolicyTargetReference, out *v1.LocalPolicyTargetReference, s conversion.Scope) error {
+	out.Group = v1.Group(in.Group)
+	out.Kind = v1.Kind(in.Kind)
+	out.Name = v1.ObjectName(in.Name)
+	return nil
+}
+
+// Convert_v1alpha2_LocalPolicyTargetReference_To_v1_LocalPolicyTargetReference is an autogenerated conversion function.
+func Convert_v1alpha2_LocalPolicyTargetReference_To_v1_LocalPolicyTargetReference(in *LocalPolicyTargetReference, out *v1.LocalPolicyTargetReference, s conversion.Scope) error {
+	return autoConvert_v1alpha2_LocalPolicyTargetReference_To_v1_LocalPolicyTargetReference(in, out, s)
+}
+
+func autoConvert_v1_LocalPolicyTargetReference_To_v1alpha2_LocalPolicyTargetReference(in *v1.LocalPolicyTargetReference, out *LocalPolicyTargetReference, s conversion.Scope) error {
+	out.Group = v1.Group(in.Group)
+	out.Kind = v1.Kind(in.Kind)
+	out.Name = v1.ObjectName(in.Name)
+	return nil
+}
+
+// Convert_v1_LocalPolicyTargetReference_To_v1alpha2_LocalPolicyTargetReference is an autogenerated conversion function.
+func Convert_v1_LocalPolicyTargetReference_To_v1alpha2_LocalPolicyTargetReference(in *v1.LocalPolicyTargetReference, out *LocalPolicyTargetReference, s conversion.Scope) error {
+	return autoConvert_v1_LocalPolicyTargetReference_To_v1alpha2_LocalPolicyTargetReference(in, out, s)
+}
+
+func autoConvert_v1alpha2_LocalPolicyTargetReferenceWithSectionName_To_v1_LocalPolicyTargetReferenceWithSectionName(in *LocalPolicyTargetReferenceWithSectionName, out *v1.LocalPolicyTargetReferenceWithSectionName, s conversion.Scope) error {
+	out.LocalPolicyTargetReference = in.LocalPolicyTargetReference
+	out.SectionName = (*v1.SectionName)(unsafe.Pointer(in.SectionName))
+	return nil
+}
+
+// Convert_v1alpha2_LocalPolicyTargetReferenceWithSectionName_To_v1_LocalPolicyTargetReferenceWithSectionName is an autogenerated conversion function.
+func Convert_v1alpha2_LocalPolicyTargetReferenceWithSectionName_To_v1_LocalPolicyTargetReferenceWithSectionName(in *LocalPolicyTargetReferenceWithSectionName, out *v1.LocalPolicyTargetReferenceWithSectionName, s conversion.Scope) error {
+	return autoConvert_v1alpha2_LocalPolicyTargetReferenceWithSectionName_To_v1_LocalPolicyTargetReferenceWithSectionName(in, out, s)
+}
+
+func autoConvert_v1_LocalPolicyTargetReferenceWithSectionName_To_v1alpha2_LocalPolicyTargetReferenceWithSectionName(in *v1.LocalPolicyTargetReferenceWithSectionName, out *LocalPolicyTargetReferenceWithSectionName, s conversion.Scope) error {
+	out.LocalPolicyTargetReference = in.LocalPolicyTargetReference
+	out.SectionName = (*v1.SectionName)(unsafe.Pointer(in.SectionName))
+	return nil
+}
+
+// Convert_v1_LocalPolicyTargetReferenceWithSectionName_To_v1alpha2_LocalPolicyTargetReferenceWithSectionName is an autogenerated conversion function.
+func Convert_v1_LocalPolicyTargetReferenceWithSectionName_To_v1alpha2_LocalPolicyTargetReferenceWithSectionName(in *v1.LocalPolicyTargetReferenceWithSectionName, out *LocalPolicyTargetReferenceWithSectionName, s conversion.Scope) error {
+	return autoConvert_v1_LocalPolicyTargetReferenceWithSectionName_To_v1alpha2_LocalPolicyTargetReferenceWithSectionName(in, out, s)
+}
+
+func autoConvert_v1alpha2_NamespacedPolicyTargetReference_To_v1_NamespacedPolicyTargetReference(in *NamespacedPolicyTargetReference, out *v1.NamespacedPolicyTargetReference, s conversion.Scope) error {
+	out.Group = v1.Group(in.Group)
+	out.Kind = v1.Kind(in.Kind)
+	out.Name = v1.ObjectName(in.Name)
+	out.Namespace = (*v1.Namespace)(unsafe.Pointer(in.Namespace))
+	return nil
+}
+
+// Convert_v1alpha2_NamespacedPolicyTargetReference_To_v1_NamespacedPolicyTargetReference is an autogenerated conversion function.
+func Convert_v1alpha2_NamespacedPolicyTargetReference_To_v1_NamespacedPolicyTargetReference(in *NamespacedPolicyTargetReference, out *v1.NamespacedPolicyTargetReference, s conversion.Scope) error {
+	return autoConvert_v1alpha2_NamespacedPolicyTargetReference_To_v1_NamespacedPolicyTargetReference(in, out, s)
+}
+
+func autoConvert_v1_NamespacedPolicyTargetReference_To_v1alpha2_NamespacedPolicyTargetReference(in *v1.NamespacedPolicyTargetReference, out *NamespacedPolicyTargetReference, s conversion.Scope) error {
+	out.Group = v1.Group(in.Group)
+	out.Kind = v1.Kind(in.Kind)
+	out.Name = v1.ObjectName(in.Name)
+	out.Namespace = (*v1.Namespace)(unsafe.Pointer(in.Namespace))
+	return nil
+}
+
+// Convert_v1_NamespacedPolicyTargetReference_To_v1alpha2_NamespacedPolicyTargetReference is an autogenerated conversion function.
+func Convert_v1_NamespacedPolicyTargetReference_To_v1alpha2_NamespacedPolicyTargetReference(in *v1.NamespacedPolicyTargetReference, out *NamespacedPolicyTargetReference, s conversion.Scope) error {
+	return autoConvert_v1_NamespacedPolicyTargetReference_To_v1alpha2_NamespacedPolicyTargetReference(in, out, s)
+}
+
+func autoConvert_v1alpha2_PolicyAncestorStatus_To_v1_PolicyAncestorStatus(in *PolicyAncestorStatus, out *v1.PolicyAncestorStatus, s conversion.Scope) error {
+	out.AncestorRef = in.AncestorRef
+	out.ControllerName = v1.GatewayController(in.ControllerName)
+	out.Conditions = *(*[]metav1.Condition)(unsafe.Pointer(&in.Conditions))
+	return nil
+}
+
+// Convert_v1alpha2_PolicyAncestorStatus_To_v1_PolicyAncestorStatus is an autogenerated conversion function.
+func Convert_v1alpha2_PolicyAncestorStatus_To_v1_PolicyAncestorStatus(in *PolicyAncestorStatus, out *v1.PolicyAncestorStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha2_PolicyAncestorStatus_To_v1_PolicyAncestorStatus(in, out, s)
+}
+
+func autoConvert_v1_PolicyAncestorStatus_To_v1alpha2_PolicyAncestorStatus(in *v1.PolicyAncestorStatus, out *PolicyAncestorStatus, s conversion.Scope) error {
+	out.AncestorRef = in.AncestorRef
+	out.ControllerName = v1.GatewayController(in.ControllerName)
+	out.Conditions = *(*[]metav1.Condition)(unsafe.Pointer(&in.Conditions))
+	return nil
+}
+
+// Convert_v1_PolicyAncestorStatus_To_v1alpha2_PolicyAncestorStatus is an autogenerated conversion function.
+func Convert_v1_PolicyAncestorStatus_To_v1alpha2_PolicyAncestorStatus(in *v1.PolicyAncestorStatus, out *PolicyAncestorStatus, s conversion.Scope) error {
+	return autoConvert_v1_PolicyAncestorStatus_To_v1alpha2_PolicyAncestorStatus(in, out, s)
+}
+
+func autoConvert_v1alpha2_PolicyStatus_To_v1_PolicyStatus(in *PolicyStatus, out *v1.PolicyStatus, s conversion.Scope) error {
+	out.Ancestors = *(*[]v1.PolicyAncestorStatus)(unsafe.Pointer(&in.Ancestors))
+	return nil
+}
+
+// Convert_v1alpha2_PolicyStatus_To_v1_PolicyStatus is an autogenerated conversion function.
+func Convert_v1alpha2_PolicyStatus_To_v1_PolicyStatus(in *PolicyStatus, out *v1.PolicyStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha2_PolicyStatus_To_v1_PolicyStatus(in, out, s)
+}
+
+func autoConvert_v1_PolicyStatus_To_v1alpha2_PolicyStatus(in *v1.PolicyStatus, out *PolicyStatus, s conversion.Scope) error {
+	out.Ancestors = *(*[]v1.PolicyAncestorStatus)(unsafe.Pointer(&in.Ancestors))
+	return nil
+}
+
+// Convert_v1_PolicyStatus_To_v1alpha2_PolicyStatus is an autogenerated conversion function.
+func Convert_v1_PolicyStatus_To_v1alpha2_PolicyStatus(in *v1.PolicyStatus, out *PolicyStatus, s conversion.Scope) error {
+	return autoConvert_v1_PolicyStatus_To_v1alpha2_PolicyStatus(in, out, s)
+}
+
+func autoConvert_v1alpha2_ReferenceGrant_To_v1_ReferenceGrant(in *ReferenceGrant, out *v1.ReferenceGrant, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	out.Spec = in.Spec
+	return nil
+}
+
+// Convert_v1alpha2_ReferenceGrant_To_v1_ReferenceGrant is an autogenerated conversion function.
+func Convert_v1alpha2_ReferenceGrant_To_v1_ReferenceGrant(in *ReferenceGrant, out *v1.ReferenceGrant, s conversion.Scope) error {
+	return autoConvert_v1alpha2_ReferenceGrant_To_v1_ReferenceGrant(in, out, s)
+}
+
+func autoConvert_v1_ReferenceGrant_To_v1alpha2_ReferenceGrant(in *v1.ReferenceGrant, out *ReferenceGrant, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	out.Spec = in.Spec
+	return nil
+}
+
+// Convert_v1_ReferenceGrant_To_v1alpha2_ReferenceGrant is an autogenerated conversion function.
+func Convert_v1_ReferenceGrant_To_v1alpha2_ReferenceGrant(in *v1.ReferenceGrant, out *ReferenceGrant, s conversion.Scope) error {
+	return autoConvert_v1_ReferenceGrant_To_v1alpha2_ReferenceGrant(in, out, s)
+}
+
+func autoConvert_v1alpha2_ReferenceGrantList_To_v1_ReferenceGrantList(in *ReferenceGrantList, out *v1.ReferenceGrantList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]v1.ReferenceGrant)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha2_ReferenceGrantList_To_v1_ReferenceGrantList is an autogenerated conversion function.
+func Convert_v1alpha2_ReferenceGrantList_To_v1_ReferenceGrantList(in *ReferenceGrantList, out *v1.ReferenceGrantList, s conversion.Scope) error {
+	return autoConvert_v1alpha2_ReferenceGrantList_To_v1_ReferenceGrantList(in, out, s)
+}
+
+func autoConvert_v1_ReferenceGrantList_To_v1alpha2_ReferenceGrantList(in *v1.ReferenceGrantList, out *ReferenceGrantList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]ReferenceGrant)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1_ReferenceGrantList_To_v1alpha2_ReferenceGrantList is an autogenerated conversion function.
+func Convert_v1_ReferenceGrantList_To_v1alpha2_ReferenceGrantList(in *v1.ReferenceGrantList, out *ReferenceGrantList, s conversion.Scope) error {
+	return autoConvert_v1_ReferenceGrantList_To_v1alpha2_ReferenceGrantList(in, out, s)
+}
+
+func autoConvert_v1alpha2_TCPRoute_To_v1_TCPRoute(in *TCPRoute, out *v1.TCPRoute, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha2_TCPRouteSpec_To_v1_TCPRouteSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha2_TCPRouteStatus_To_v1_TCPRouteStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha2_TCPRoute_To_v1_TCPRoute is an autogenerated conversion function.
+func Convert_v1alpha2_TCPRoute_To_v1_TCPRoute(in *TCPRoute, out *v1.TCPRoute, s conversion.Scope) error {
+	return autoConvert_v1alpha2_TCPRoute_To_v1_TCPRoute(in, out, s)
+}
+
+func autoConvert_v1_TCPRoute_To_v1alpha2_TCPRoute(in *v1.TCPRoute, out *TCPRoute, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1_TCPRouteSpec_To_v1alpha2_TCPRouteSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1_TCPRouteStatus_To_v1alpha2_TCPRouteStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_TCPRoute_To_v1alpha2_TCPRoute is an autogenerated conversion function.
+func Convert_v1_TCPRoute_To_v1alpha2_TCPRoute(in *v1.TCPRoute, out *TCPRoute, s conversion.Scope) error {
+	return autoConvert_v1_TCPRoute_To_v1alpha2_TCPRoute(in, out, s)
+}
+
+func autoConvert_v1alpha2_TCPRouteList_To_v1_TCPRouteList(in *TCPRouteList, out *v1.TCPRouteList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]v1.TCPRoute)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha2_TCPRouteList_To_v1_TCPRouteList is an autogenerated conversion function.
+func Convert_v1alpha2_TCPRouteList_To_v1_TCPRouteList(in *TCPRouteList, out *v1.TCPRouteList, s conversion.Scope) error {
+	return autoConvert_v1alpha2_TCPRouteList_To_v1_TCPRouteList(in, out, s)
+}
+
+func autoConvert_v1_TCPRouteList_To_v1alpha2_TCPRouteList(in *v1.TCPRouteList, out *TCPRouteList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]TCPRoute)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1_TCPRouteList_To_v1alpha2_TCPRouteList is an autogenerated conversion function.
+func Convert_v1_TCPRouteList_To_v1alpha2_TCPRouteList(in *v1.TCPRouteList, out *TCPRouteList, s conversion.Scope) error {
+	return autoConvert_v1_TCPRouteList_To_v1alpha2_TCPRouteList(in, out, s)
+}
+
+func autoConvert_v1alpha2_TCPRouteRule_To_v1_TCPRouteRule(in *TCPRouteRule, out *v1.TCPRouteRule, s conversion.Scope) error {
+	out.Name = (*v1.SectionName)(unsafe.Pointer(in.Name))
+	out.BackendRefs = *(*[]v1.BackendRef)(unsafe.Pointer(&in.BackendRefs))
+	return nil
+}
+
+// Convert_v1alpha2_TCPRouteRule_To_v1_TCPRouteRule is an autogenerated conversion function.
+func Convert_v1alpha2_TCPRouteRule_To_v1_TCPRouteRule(in *TCPRouteRule, out *v1.TCPRouteRule, s conversion.Scope) error {
+	return autoConvert_v1alpha2_TCPRouteRule_To_v1_TCPRouteRule(in, out, s)
+}
+
+func autoConvert_v1_TCPRouteRule_To_v1alpha2_TCPRouteRule(in *v1.TCPRouteRule, out *TCPRouteRule, s conversion.Scope) error {
+	out.Name = (*v1.SectionName)(unsafe.Pointer(in.Name))
+	out.BackendRefs = *(*[]v1.BackendRef)(unsafe.Pointer(&in.BackendRefs))
+	return nil
+}
+
+// Convert_v1_TCPRouteRule_To_v1alpha2_TCPRouteRule is an autogenerated conversion function.
+func Convert_v1_TCPRouteRule_To_v1alpha2_TCPRouteRule(in *v1.TCPRouteRule, out *TCPRouteRule, s conversion.Scope) error {
+	return autoConvert_v1_TCPRouteRule_To_v1alpha2_TCPRouteRule(in, out, s)
+}
+
+func autoConvert_v1alpha2_TCPRouteSpec_To_v1_TCPRouteSpec(in *TCPRouteSpec, out *v1.TCPRouteSpec, s conversion.Scope) error {
+	out.CommonRouteSpec = in.CommonRouteSpec
+	out.Rules = *(*[]v1.TCPRouteRule)(unsafe.Pointer(&in.Rules))
+	return nil
+}
+
+// Convert_v1alpha2_TCPRouteSpec_To_v1_TCPRouteSpec is an autogenerated conversion function.
+func Convert_v1alpha2_TCPRouteSpec_To_v1_TCPRouteSpec(in *TCPRouteSpec, out *v1.TCPRouteSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha2_TCPRouteSpec_To_v1_TCPRouteSpec(in, out, s)
+}
+
+func autoConvert_v1_TCPRouteSpec_To_v1alpha2_TCPRouteSpec(in *v1.TCPRouteSpec, out *TCPRouteSpec, s conversion.Scope) error {
+	out.CommonRouteSpec = in.CommonRouteSpec
+	out.Rules = *(*[]TCPRouteRule)(unsafe.Pointer(&in.Rules))
+	return nil
+}
+
+// Convert_v1_TCPRouteSpec_To_v1alpha2_TCPRouteSpec is an autogenerated conversion function.
+func Convert_v1_TCPRouteSpec_To_v1alpha2_TCPRouteSpec(in *v1.TCPRouteSpec, out *TCPRouteSpec, s conversion.Scope) error {
+	return autoConvert_v1_TCPRouteSpec_To_v1alpha2_TCPRouteSpec(in, out, s)
+}
+
+func autoConvert_v1alpha2_TCPRouteStatus_To_v1_TCPRouteStatus(in *TCPRouteStatus, out *v1.TCPRouteStatus, s conversion.Scope) error {
+	out.RouteStatus = in.RouteStatus
+	return nil
+}
+
+// Convert_v1alpha2_TCPRouteStatus_To_v1_TCPRouteStatus is an autogenerated conversion function.
+func Convert_v1alpha2_TCPRouteStatus_To_v1_TCPRouteStatus(in *TCPRouteStatus, out *v1.TCPRouteStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha2_TCPRouteStatus_To_v1_TCPRouteStatus(in, out, s)
+}
+
+func autoConvert_v1_TCPRouteStatus_To_v1alpha2_TCPRouteStatus(in *v1.TCPRouteStatus, out *TCPRouteStatus, s conversion.Scope) error {
+	out.RouteStatus = in.RouteStatus
+	return nil
+}
+
+// Convert_v1_TCPRouteStatus_To_v1alpha2_TCPRouteStatus is an autogenerated conversion function.
+func Convert_v1_TCPRouteStatus_To_v1alpha2_TCPRouteStatus(in *v1.TCPRouteStatus, out *TCPRouteStatus, s conversion.Scope) error {
+	return autoConvert_v1_TCPRouteStatus_To_v1alpha2_TCPRouteStatus(in, out, s)
+}
+
+func autoConvert_v1alpha2_TLSRoute_To_v1_TLSRoute(in *TLSRoute, out *v1.TLSRoute, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha2_TLSRouteSpec_To_v1_TLSRouteSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha2_TLSRouteStatus_To_v1_TLSRouteStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha2_TLSRoute_To_v1_TLSRoute is an autogenerated conversion function.
+func Convert_v1alpha2_TLSRoute_To_v1_TLSRoute(in *TLSRoute, out *v1.TLSRoute, s conversion.Scope) error {
+	return autoConvert_v1alpha2_TLSRoute_To_v1_TLSRoute(in, out, s)
+}
+
+func autoConvert_v1_TLSRoute_To_v1alpha2_TLSRoute(in *v1.TLSRoute, out *TLSRoute, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1_TLSRouteSpec_To_v1alpha2_TLSRouteSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1_TLSRouteStatus_To_v1alpha2_TLSRouteStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_TLSRoute_To_v1alpha2_TLSRoute is an autogenerated conversion function.
+func Convert_v1_TLSRoute_To_v1alpha2_TLSRoute(in *v1.TLSRoute, out *TLSRoute, s conversion.Scope) error {
+	return autoConvert_v1_TLSRoute_To_v1alpha2_TLSRoute(in, out, s)
+}
+
+func autoConvert_v1alpha2_TLSRouteList_To_v1_TLSRouteList(in *TLSRouteList, out *v1.TLSRouteList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]v1.TLSRoute)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha2_TLSRouteList_To_v1_TLSRouteList is an autogenerated conversion function.
+func Convert_v1alpha2_TLSRouteList_To_v1_TLSRouteList(in *TLSRouteList, out *v1.TLSRouteList, s conversion.Scope) error {
+	return autoConvert_v1alpha2_TLSRouteList_To_v1_TLSRouteList(in, out, s)
+}
+
+func autoConvert_v1_TLSRouteList_To_v1alpha2_TLSRouteList(in *v1.TLSRouteList, out *TLSRouteList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]TLSRoute)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1_TLSRouteList_To_v1alpha2_TLSRouteList is an autogenerated conversion function.
+func Convert_v1_TLSRouteList_To_v1alpha2_TLSRouteList(in *v1.TLSRouteList, out *TLSRouteList, s conversion.Scope) error {
+	return autoConvert_v1_TLSRouteList_To_v1alpha2_TLSRouteList(in, out, s)
+}
+
+func autoConvert_v1alpha2_TLSRouteRule_To_v1_TLSRouteRule(in *TLSRouteRule, out *v1.TLSRouteRule, s conversion.Scope) error {
+	out.Name = (*v1.SectionName)(unsafe.Pointer(in.Name))
+	out.BackendRefs = *(*[]v1.BackendRef)(unsafe.Pointer(&in.BackendRefs))
+	return nil
+}
+
+// Convert_v1alpha2_TLSRouteRule_To_v1_TLSRouteRule is an autogenerated conversion function.
+func Convert_v1alpha2_TLSRouteRule_To_v1_TLSRouteRule(in *TLSRouteRule, out *v1.TLSRouteRule, s conversion.Scope) error {
+	return autoConvert_v1alpha2_TLSRouteRule_To_v1_TLSRouteRule(in, out, s)
+}
+
+func autoConvert_v1_TLSRouteRule_To_v1alpha2_TLSRouteRule(in *v1.TLSRouteRule, out *TLSRouteRule, s conversion.Scope) error {
+	out.Name = (*v1.SectionName)(unsafe.Pointer(in.Name))
+	out.BackendRefs = *(*[]v1.BackendRef)(unsafe.Pointer(&in.BackendRefs))
+	return nil
+}
+
+// Convert_v1_TLSRouteRule_To_v1alpha2_TLSRouteRule is an autogenerated conversion function.
+func Convert_v1_TLSRouteRule_To_v1alpha2_TLSRouteRule(in *v1.TLSRouteRule, out *TLSRouteRule, s conversion.Scope) error {
+	return autoConvert_v1_TLSRouteRule_To_v1alpha2_TLSRouteRule(in, out, s)
+}
+
+func autoConvert_v1alpha2_TLSRouteSpec_To_v1_TLSRouteSpec(in *TLSRouteSpec, out *v1.TLSRouteSpec, s conversion.Scope) error {
+	out.CommonRouteSpec = in.CommonRouteSpec
+	out.Hostnames = *(*[]v1.Hostname)(unsafe.Pointer(&in.Hostnames))
+	out.Rules = *(*[]v1.TLSRouteRule)(unsafe.Pointer(&in.Rules))
+	return nil
+}
+
+// Convert_v1alpha2_TLSRouteSpec_To_v1_TLSRouteSpec is an autogenerated conversion function.
+func Convert_v1alpha2_TLSRouteSpec_To_v1_TLSRouteSpec(in *TLSRouteSpec, out *v1.TLSRouteSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha2_TLSRouteSpec_To_v1_TLSRouteSpec(in, out, s)
+}
+
+func autoConvert_v1_TLSRouteSpec_To_v1alpha2_TLSRouteSpec(in *v1.TLSRouteSpec, out *TLSRouteSpec, s conversion.Scope) error {
+	out.CommonRouteSpec = in.CommonRouteSpec
+	out.Hostnames = *(*[]v1.Hostname)(unsafe.Pointer(&in.Hostnames))
+	out.Rules = *(*[]TLSRouteRule)(unsafe.Pointer(&in.Rules))
+	return nil
+}
+
+// Convert_v1_TLSRouteSpec_To_v1alpha2_TLSRouteSpec is an autogenerated conversion function.
+func Convert_v1_TLSRouteSpec_To_v1alpha2_TLSRouteSpec(in *v1.TLSRouteSpec, out *TLSRouteSpec, s conversion.Scope) error {
+	return autoConvert_v1_TLSRouteSpec_To_v1alpha2_TLSRouteSpec(in, out, s)
+}
+
+func autoConvert_v1alpha2_TLSRouteStatus_To_v1_TLSRouteStatus(in *TLSRouteStatus, out *v1.TLSRouteStatus, s conversion.Scope) error {
+	out.RouteStatus = in.RouteStatus
+	return nil
+}
+
+// Convert_v1alpha2_TLSRouteStatus_To_v1_TLSRouteStatus is an autogenerated conversion function.
+func Convert_v1alpha2_TLSRouteStatus_To_v1_TLSRouteStatus(in *TLSRouteStatus, out *v1.TLSRouteStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha2_TLSRouteStatus_To_v1_TLSRouteStatus(in, out, s)
+}
+
+func autoConvert_v1_TLSRouteStatus_To_v1alpha2_TLSRouteStatus(in *v1.TLSRouteStatus, out *TLSRouteStatus, s conversion.Scope) error {
+	out.RouteStatus = in.RouteStatus
+	return nil
+}
+
+// Convert_v1_TLSRouteStatus_To_v1alpha2_TLSRouteStatus is an autogenerated conversion function.
+func Convert_v1_TLSRouteStatus_To_v1alpha2_TLSRouteStatus(in *v1.TLSRouteStatus, out *TLSRouteStatus, s conversion.Scope) error {
+	return autoConvert_v1_TLSRouteStatus_To_v1alpha2_TLSRouteStatus(in, out, s)
+}
+
+func autoConvert_v1alpha2_UDPRoute_To_v1_UDPRoute(in *UDPRoute, out *v1.UDPRoute, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha2_UDPRouteSpec_To_v1_UDPRouteSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha2_UDPRouteStatus_To_v1_UDPRouteStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha2_UDPRoute_To_v1_UDPRoute is an autogenerated conversion function.
+func Convert_v1alpha2_UDPRoute_To_v1_UDPRoute(in *UDPRoute, out *v1.UDPRoute, s conversion.Scope) error {
+	return autoConvert_v1alpha2_UDPRoute_To_v1_UDPRoute(in, out, s)
+}
+
+func autoConvert_v1_UDPRoute_To_v1alpha2_UDPRoute(in *v1.UDPRoute, out *UDPRoute, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1_UDPRouteSpec_To_v1alpha2_UDPRouteSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1_UDPRouteStatus_To_v1alpha2_UDPRouteStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_UDPRoute_To_v1alpha2_UDPRoute is an autogenerated conversion function.
+func Convert_v1_UDPRoute_To_v1alpha2_UDPRoute(in *v1.UDPRoute, out *UDPRoute, s conversion.Scope) error {
+	return autoConvert_v1_UDPRoute_To_v1alpha2_UDPRoute(in, out, s)
+}
+
+func autoConvert_v1alpha2_UDPRouteList_To_v1_UDPRouteList(in *UDPRouteList, out *v1.UDPRouteList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]v1.UDPRoute)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha2_UDPRouteList_To_v1_UDPRouteList is an autogenerated conversion function.
+func Convert_v1alpha2_UDPRouteList_To_v1_UDPRouteList(in *UDPRouteList, out *v1.UDPRouteList, s conversion.Scope) error {
+	return autoConvert_v1alpha2_UDPRouteList_To_v1_UDPRouteList(in, out, s)
+}
+
+func autoConvert_v1_UDPRouteList_To_v1alpha2_UDPRouteList(in *v1.UDPRouteList, out *UDPRouteList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]UDPRoute)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1_UDPRouteList_To_v1alpha2_UDPRouteList is an autogenerated conversion function.
+func Convert_v1_UDPRouteList_To_v1alpha2_UDPRouteList(in *v1.UDPRouteList, out *UDPRouteList, s conversion.Scope) error {
+	return autoConvert_v1_UDPRouteList_To_v1alpha2_UDPRouteList(in, out, s)
+}
+
+func autoConvert_v1alpha2_UDPRouteRule_To_v1_UDPRouteRule(in *UDPRouteRule, out *v1.UDPRouteRule, s conversion.Scope) error {
+	out.Name = (*v1.SectionName)(unsafe.Pointer(in.Name))
+	out.BackendRefs = *(*[]v1.BackendRef)(unsafe.Pointer(&in.BackendRefs))
+	return nil
+}
+
+// Convert_v1alpha2_UDPRouteRule_To_v1_UDPRouteRule is an autogenerated conversion function.
+func Convert_v1alpha2_UDPRouteRule_To_v1_UDPRouteRule(in *UDPRouteRule, out *v1.UDPRouteRule, s conversion.Scope) error {
+	return autoConvert_v1alpha2_UDPRouteRule_To_v1_UDPRouteRule(in, out, s)
+}
+
+func autoConvert_v1_UDPRouteRule_To_v1alpha2_UDPRouteRule(in *v1.UDPRouteRule, out *UDPRouteRule, s conversion.Scope) error {
+	out.Name = (*v1.SectionName)(unsafe.Pointer(in.Name))
+	out.BackendRefs = *(*[]v1.BackendRef)(unsafe.Pointer(&in.BackendRefs))
+	return nil
+}
+
+// Convert_v1_UDPRouteRule_To_v1alpha2_UDPRouteRule is an autogenerated conversion function.
+func Convert_v1_UDPRouteRule_To_v1alpha2_UDPRouteRule(in *v1.UDPRouteRule, out *UDPRouteRule, s conversion.Scope) error {
+	return autoConvert_v1_UDPRouteRule_To_v1alpha2_UDPRouteRule(in, out, s)
+}
+
+func autoConvert_v1alpha2_UDPRouteSpec_To_v1_UDPRouteSpec(in *UDPRouteSpec, out *v1.UDPRouteSpec, s conversion.Scope) error {
+	out.CommonRouteSpec = in.CommonRouteSpec
+	out.Rules = *(*[]v1.UDPRouteRule)(unsafe.Pointer(&in.Rules))
+	return nil
+}
+
+// Convert_v1alpha2_UDPRouteSpec_To_v1_UDPRouteSpec is an autogenerated conversion function.
+func Convert_v1alpha2_UDPRouteSpec_To_v1_UDPRouteSpec(in *UDPRouteSpec, out *v1.UDPRouteSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha2_UDPRouteSpec_To_v1_UDPRouteSpec(in, out, s)
+}
+
+func autoConvert_v1_UDPRouteSpec_To_v1alpha2_UDPRouteSpec(in *v1.UDPRouteSpec, out *UDPRouteSpec, s conversion.Scope) error {
+	out.CommonRouteSpec = in.CommonRouteSpec
+	out.Rules = *(*[]UDPRouteRule)(unsafe.Pointer(&in.Rules))
+	return nil
+}
+
+// Convert_v1_UDPRouteSpec_To_v1alpha2_UDPRouteSpec is an autogenerated conversion function.
+func Convert_v1_UDPRouteSpec_To_v1alpha2_UDPRouteSpec(in *v1.UDPRouteSpec, out *UDPRouteSpec, s conversion.Scope) error {
+	return autoConvert_v1_UDPRouteSpec_To_v1alpha2_UDPRouteSpec(in, out, s)
+}
+
+func autoConvert_v1alpha2_UDPRouteStatus_To_v1_UDPRouteStatus(in *UDPRouteStatus, out *v1.UDPRouteStatus, s conversion.Scope) error {
+	out.RouteStatus = in.RouteStatus
+	return nil
+}
+
+// Convert_v1alpha2_UDPRouteStatus_To_v1_UDPRouteStatus is an autogenerated conversion function.
+func Convert_v1alpha2_UDPRouteStatus_To_v1_UDPRouteStatus(in *UDPRouteStatus, out *v1.UDPRouteStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha2_UDPRouteStatus_To_v1_UDPRouteStatus(in, out, s)
+}
+
+func autoConvert_v1_UDPRouteStatus_To_v1alpha2_UDPRouteStatus(in *v1.UDPRouteStatus, out *UDPRouteStatus, s conversion.Scope) error {
+	out.RouteStatus = in.RouteStatus
+	return nil
+}
+
+// Convert_v1_UDPRouteStatus_To_v1alpha2_UDPRouteStatus is an autogenerated conversion function.
+func Convert_v1_UDPRouteStatus_To_v1alpha2_UDPRouteStatus(in *v1.UDPRouteStatus, out *UDPRouteStatus, s conversion.Scope) error {
+	return autoConvert_v1_UDPRouteStatus_To_v1alpha2_UDPRouteStatus(in, out, s)
+}
@@ -19,5 +19,6 @@ limitations under the License.
 //
 // +k8s:openapi-gen=true
 // +kubebuilder:object:generate=true
+// +k8s:conversion-gen=sigs.k8s.io/gateway-api/apis/v1
 // +groupName=gateway.networking.k8s.io
 package v1beta1
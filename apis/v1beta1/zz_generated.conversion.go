@@ -0,0 +1,302 @@
+//go:build !ignore_autogenerated
+// +build !ignore_autogenerated
+
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by conversion-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	unsafe "unsafe"
+
+	conversion "k8s.io/apimachinery/pkg/conversion"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+func init() {
+	localSchemeBuilder.Register(RegisterConversions)
+}
+
+// RegisterConversions adds conversion functions to the given scheme.
+// Public to allow building arbitrary schemes.
+func RegisterConversions(s *runtime.Scheme) error {
+	if err := s.AddGeneratedConversionFunc((*Gateway)(nil), (*v1.Gateway)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_Gateway_To_v1_Gateway(a.(*Gateway), b.(*v1.Gateway), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.Gateway)(nil), (*Gateway)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_Gateway_To_v1beta1_Gateway(a.(*v1.Gateway), b.(*Gateway), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*GatewayClass)(nil), (*v1.GatewayClass)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_GatewayClass_To_v1_GatewayClass(a.(*GatewayClass), b.(*v1.GatewayClass), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.GatewayClass)(nil), (*GatewayClass)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_GatewayClass_To_v1beta1_GatewayClass(a.(*v1.GatewayClass), b.(*GatewayClass), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*GatewayClassList)(nil), (*v1.GatewayClassList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_GatewayClassList_To_v1_GatewayClassList(a.(*GatewayClassList), b.(*v1.GatewayClassList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.GatewayClassList)(nil), (*GatewayClassList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_GatewayClassList_To_v1beta1_GatewayClassList(a.(*v1.GatewayClassList), b.(*GatewayClassList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*GatewayList)(nil), (*v1.GatewayList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_GatewayList_To_v1_GatewayList(a.(*GatewayList), b.(*v1.GatewayList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.GatewayList)(nil), (*GatewayList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_GatewayList_To_v1beta1_GatewayList(a.(*v1.GatewayList), b.(*GatewayList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*HTTPRoute)(nil), (*v1.HTTPRoute)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_HTTPRoute_To_v1_HTTPRoute(a.(*HTTPRoute), b.(*v1.HTTPRoute), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.HTTPRoute)(nil), (*HTTPRoute)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_HTTPRoute_To_v1beta1_HTTPRoute(a.(*v1.HTTPRoute), b.(*HTTPRoute), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*HTTPRouteList)(nil), (*v1.HTTPRouteList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_HTTPRouteList_To_v1_HTTPRouteList(a.(*HTTPRouteList), b.(*v1.HTTPRouteList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.HTTPRouteList)(nil), (*HTTPRouteList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_HTTPRouteList_To_v1beta1_HTTPRouteList(a.(*v1.HTTPRouteList), b.(*HTTPRouteList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ReferenceGrant)(nil), (*v1.ReferenceGrant)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ReferenceGrant_To_v1_ReferenceGrant(a.(*ReferenceGrant), b.(*v1.ReferenceGrant), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.ReferenceGrant)(nil), (*ReferenceGrant)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ReferenceGrant_To_v1beta1_ReferenceGrant(a.(*v1.ReferenceGrant), b.(*ReferenceGrant), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ReferenceGrantList)(nil), (*v1.ReferenceGrantList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ReferenceGrantList_To_v1_ReferenceGrantList(a.(*ReferenceGrantList), b.(*v1.ReferenceGrantList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.ReferenceGrantList)(nil), (*ReferenceGrantList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ReferenceGrantList_To_v1beta1_ReferenceGrantList(a.(*v1.ReferenceGrantList), b.(*ReferenceGrantList), scope)
+	}); err != nil {
+		return err
+	}
+	return nil
+}
+
+func autoConvert_v1beta1_Gateway_To_v1_Gateway(in *Gateway, out *v1.Gateway, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	out.Spec = in.Spec
+	out.Status = in.Status
+	return nil
+}
+
+// Convert_v1beta1_Gateway_To_v1_Gateway is an autogenerated conversion function.
+func Convert_v1beta1_Gateway_To_v1_Gateway(in *Gateway, out *v1.Gateway, s conversion.Scope) error {
+	return autoConvert_v1beta1_Gateway_To_v1_Gateway(in, out, s)
+}
+
+func autoConvert_v1_Gateway_To_v1beta1_Gateway(in *v1.Gateway, out *Gateway, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	out.Spec = in.Spec
+	out.Status = in.Status
+	return nil
+}
+
+// Convert_v1_Gateway_To_v1beta1_Gateway is an autogenerated conversion function.
+func Convert_v1_Gateway_To_v1beta1_Gateway(in *v1.Gateway, out *Gateway, s conversion.Scope) error {
+	return autoConvert_v1_Gateway_To_v1beta1_Gateway(in, out, s)
+}
+
+func autoConvert_v1beta1_GatewayClass_To_v1_GatewayClass(in *GatewayClass, out *v1.GatewayClass, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	out.Spec = in.Spec
+	out.Status = in.Status
+	return nil
+}
+
+// Convert_v1beta1_GatewayClass_To_v1_GatewayClass is an autogenerated conversion function.
+func Convert_v1beta1_GatewayClass_To_v1_GatewayClass(in *GatewayClass, out *v1.GatewayClass, s conversion.Scope) error {
+	return autoConvert_v1beta1_GatewayClass_To_v1_GatewayClass(in, out, s)
+}
+
+func autoConvert_v1_GatewayClass_To_v1beta1_GatewayClass(in *v1.GatewayClass, out *GatewayClass, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	out.Spec = in.Spec
+	out.Status = in.Status
+	return nil
+}
+
+// Convert_v1_GatewayClass_To_v1beta1_GatewayClass is an autogenerated conversion function.
+func Convert_v1_GatewayClass_To_v1beta1_GatewayClass(in *v1.GatewayClass, out *GatewayClass, s conversion.Scope) error {
+	return autoConvert_v1_GatewayClass_To_v1beta1_GatewayClass(in, out, s)
+}
+
+func autoConvert_v1beta1_GatewayClassList_To_v1_GatewayClassList(in *GatewayClassList, out *v1.GatewayClassList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]v1.GatewayClass)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1beta1_GatewayClassList_To_v1_GatewayClassList is an autogenerated conversion function.
+func Convert_v1beta1_GatewayClassList_To_v1_GatewayClassList(in *GatewayClassList, out *v1.GatewayClassList, s conversion.Scope) error {
+	return autoConvert_v1beta1_GatewayClassList_To_v1_GatewayClassList(in, out, s)
+}
+
+func autoConvert_v1_GatewayClassList_To_v1beta1_GatewayClassList(in *v1.GatewayClassList, out *GatewayClassList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]GatewayClass)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1_GatewayClassList_To_v1beta1_GatewayClassList is an autogenerated conversion function.
+func Convert_v1_GatewayClassList_To_v1beta1_GatewayClassList(in *v1.GatewayClassList, out *GatewayClassList, s conversion.Scope) error {
+	return autoConvert_v1_GatewayClassList_To_v1beta1_GatewayClassList(in, out, s)
+}
+
+func autoConvert_v1beta1_GatewayList_To_v1_GatewayList(in *GatewayList, out *v1.GatewayList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]v1.Gateway)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1beta1_GatewayList_To_v1_GatewayList is an autogenerated conversion function.
+func Convert_v1beta1_GatewayList_To_v1_GatewayList(in *GatewayList, out *v1.GatewayList, s conversion.Scope) error {
+	return autoConvert_v1beta1_GatewayList_To_v1_GatewayList(in, out, s)
+}
+
+func autoConvert_v1_GatewayList_To_v1beta1_GatewayList(in *v1.GatewayList, out *GatewayList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]Gateway)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1_GatewayList_To_v1beta1_GatewayList is an autogenerated conversion function.
+func Convert_v1_GatewayList_To_v1beta1_GatewayList(in *v1.GatewayList, out *GatewayList, s conversion.Scope) error {
+	return autoConvert_v1_GatewayList_To_v1beta1_GatewayList(in, out, s)
+}
+
+func autoConvert_v1beta1_HTTPRoute_To_v1_HTTPRoute(in *HTTPRoute, out *v1.HTTPRoute, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	out.Spec = in.Spec
+	out.Status = in.Status
+	return nil
+}
+
+// Convert_v1beta1_HTTPRoute_To_v1_HTTPRoute is an autogenerated conversion function.
+func Convert_v1beta1_HTTPRoute_To_v1_HTTPRoute(in *HTTPRoute, out *v1.HTTPRoute, s conversion.Scope) error {
+	return autoConvert_v1beta1_HTTPRoute_To_v1_HTTPRoute(in, out, s)
+}
+
+func autoConvert_v1_HTTPRoute_To_v1beta1_HTTPRoute(in *v1.HTTPRoute, out *HTTPRoute, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	out.Spec = in.Spec
+	out.Status = in.Status
+	return nil
+}
+
+// Convert_v1_HTTPRoute_To_v1beta1_HTTPRoute is an autogenerated conversion function.
+func Convert_v1_HTTPRoute_To_v1beta1_HTTPRoute(in *v1.HTTPRoute, out *HTTPRoute, s conversion.Scope) error {
+	return autoConvert_v1_HTTPRoute_To_v1beta1_HTTPRoute(in, out, s)
+}
+
+func autoConvert_v1beta1_HTTPRouteList_To_v1_HTTPRouteList(in *HTTPRouteList, out *v1.HTTPRouteList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]v1.HTTPRoute)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1beta1_HTTPRouteList_To_v1_HTTPRouteList is an autogenerated conversion function.
+func Convert_v1beta1_HTTPRouteList_To_v1_HTTPRouteList(in *HTTPRouteList, out *v1.HTTPRouteList, s conversion.Scope) error {
+	return autoConvert_v1beta1_HTTPRouteList_To_v1_HTTPRouteList(in, out, s)
+}
+
+func autoConvert_v1_HTTPRouteList_To_v1beta1_HTTPRouteList(in *v1.HTTPRouteList, out *HTTPRouteList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]HTTPRoute)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1_HTTPRouteList_To_v1beta1_HTTPRouteList is an autogenerated conversion function.
+func Convert_v1_HTTPRouteList_To_v1beta1_HTTPRouteList(in *v1.HTTPRouteList, out *HTTPRouteList, s conversion.Scope) error {
+	return autoConvert_v1_HTTPRouteList_To_v1beta1_HTTPRouteList(in, out, s)
+}
+
+func autoConvert_v1beta1_ReferenceGrant_To_v1_ReferenceGrant(in *ReferenceGrant, out *v1.ReferenceGrant, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	out.Spec = in.Spec
+	return nil
+}
+
+// Convert_v1beta1_ReferenceGrant_To_v1_ReferenceGrant is an autogenerated conversion function.
+func Convert_v1beta1_ReferenceGrant_To_v1_ReferenceGrant(in *ReferenceGrant, out *v1.ReferenceGrant, s conversion.Scope) error {
+	return autoConvert_v1beta1_ReferenceGrant_To_v1_ReferenceGrant(in, out, s)
+}
+
+func autoConvert_v1_ReferenceGrant_To_v1beta1_ReferenceGrant(in *v1.ReferenceGrant, out *ReferenceGrant, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	out.Spec = in.Spec
+	return nil
+}
+
+// Convert_v1_ReferenceGrant_To_v1beta1_ReferenceGrant is an autogenerated conversion function.
+func Convert_v1_ReferenceGrant_To_v1beta1_ReferenceGrant(in *v1.ReferenceGrant, out *ReferenceGrant, s conversion.Scope) error {
+	return autoConvert_v1_ReferenceGrant_To_v1beta1_ReferenceGrant(in, out, s)
+}
+
+func autoConvert_v1beta1_ReferenceGrantList_To_v1_ReferenceGrantList(in *ReferenceGrantList, out *v1.ReferenceGrantList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]v1.ReferenceGrant)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1beta1_ReferenceGrantList_To_v1_ReferenceGrantList is an autogenerated conversion function.
+func Convert_v1beta1_ReferenceGrantList_To_v1_ReferenceGrantList(in *ReferenceGrantList, out *v1.ReferenceGrantList, s conversion.Scope) error {
+	return autoConvert_v1beta1_ReferenceGrantList_To_v1_ReferenceGrantList(in, out, s)
+}
+
+func autoConvert_v1_ReferenceGrantList_To_v1beta1_ReferenceGrantList(in *v1.ReferenceGrantList, out *ReferenceGrantList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]ReferenceGrant)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1_ReferenceGrantList_To_v1beta1_ReferenceGrantList is an autogenerated conversion function.
+func Convert_v1_ReferenceGrantList_To_v1beta1_ReferenceGrantList(in *v1.ReferenceGrantList, out *ReferenceGrantList, s conversion.Scope) error {
+	return autoConvert_v1_ReferenceGrantList_To_v1beta1_ReferenceGrantList(in, out, s)
+}
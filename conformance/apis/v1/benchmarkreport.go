@@ -0,0 +1,77 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// BenchmarkReport is a report of the scale and propagation-latency benchmark,
+// which measures how fast an implementation reconciles and programs a large
+// number of HTTPRoutes attached to a single Gateway.
+type BenchmarkReport struct {
+	metav1.TypeMeta `json:",inline"`
+	Implementation  `json:"implementation"`
+
+	// Date indicates the date that this report was generated.
+	Date string `json:"date"`
+
+	// GatewayAPIVersion indicates which release version of Gateway API this
+	// benchmark was run against.
+	GatewayAPIVersion string `json:"gatewayAPIVersion"`
+
+	// GatewayAPIChannel indicates which release channel of Gateway API this
+	// benchmark was run against.
+	GatewayAPIChannel string `json:"gatewayAPIChannel"`
+
+	// Mode is the operating mode the implementation used to run the
+	// benchmark.
+	Mode string `json:"mode"`
+
+	// Routes is the number of HTTPRoutes created by the benchmark.
+	Routes int `json:"routes"`
+
+	// Namespaces is the number of namespaces the HTTPRoutes were spread
+	// across.
+	Namespaces int `json:"namespaces"`
+
+	// Latencies is the list of the latencies measured by the benchmark.
+	Latencies []LatencyReport `json:"latencies"`
+}
+
+// LatencyReport summarizes the distribution of a latency measured for many
+// HTTPRoutes.
+type LatencyReport struct {
+	// Name identifies what was measured, e.g. "RouteAccepted".
+	Name string `json:"name"`
+
+	// Samples is the number of HTTPRoutes the latency was measured for.
+	Samples int `json:"samples"`
+
+	// Failures is the number of HTTPRoutes for which the measured event did
+	// not happen before the benchmark timed out. They are not included in
+	// the percentiles.
+	Failures int `json:"failures"`
+
+	// P50, P90 and P99 are the 50th, 90th and 99th percentiles of the
+	// latency, and Max its maximum. They are unset when there are no
+	// samples.
+	P50 metav1.Duration `json:"p50"`
+	P90 metav1.Duration `json:"p90"`
+	P99 metav1.Duration `json:"p99"`
+	Max metav1.Duration `json:"max"`
+}
@@ -50,6 +50,21 @@ type ConformanceReport struct {
 	// SucceededProvisionalTests is a list of the names of the provisional tests that
 	// have been successfully run.
 	SucceededProvisionalTests []string `json:"succeededProvisionalTests,omitempty"`
+
+	// RepeatedTests contains the per-test outcomes of a run in which each test
+	// was executed multiple times. It is only populated when the suite is
+	// configured to repeat tests.
+	RepeatedTests []RepeatedTestReport `json:"repeatedTests,omitempty"`
+
+	// ImplementationSpecific is a list of the reports for the tests that the
+	// implementation registered under its own feature namespaces. These tests
+	// are not part of any conformance profile.
+	ImplementationSpecific []ImplementationSpecificReport `json:"implementationSpecific,omitempty"`
+
+	// CRDVersionSkew describes the installed Gateway API CRDs and the tests
+	// skipped because of them. It is only populated when the suite is run in
+	// CRD version-skew mode.
+	CRDVersionSkew *CRDVersionSkew `json:"crdVersionSkew,omitempty"`
 }
 
 // Implementation provides metadata information on the downstream
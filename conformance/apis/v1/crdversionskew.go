@@ -0,0 +1,63 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+// CRDVersionSkew describes the Gateway API CRDs installed in the cluster when
+// the suite is run against CRDs whose version or channel may differ from the
+// ones the suite was built for.
+type CRDVersionSkew struct {
+	// SuiteVersion is the Gateway API bundle version the conformance suite
+	// was built for.
+	SuiteVersion string `json:"suiteVersion"`
+
+	// InstalledCRDs lists the Gateway API CRDs installed in the cluster,
+	// sorted by name.
+	InstalledCRDs []InstalledCRD `json:"installedCRDs,omitempty"`
+
+	// SkippedTests lists the tests that were skipped because their manifests
+	// use resources, versions or fields absent from the installed CRDs,
+	// sorted by name.
+	SkippedTests []SkewSkippedTest `json:"skippedTests,omitempty"`
+}
+
+// InstalledCRD is the bundle version and channel of a Gateway API CRD
+// installed in the cluster.
+type InstalledCRD struct {
+	// Name is the name of the CRD, e.g. "httproutes.gateway.networking.k8s.io".
+	Name string `json:"name"`
+
+	// BundleVersion is the value of the gateway.networking.k8s.io/bundle-version
+	// annotation of the CRD.
+	BundleVersion string `json:"bundleVersion"`
+
+	// Channel is the value of the gateway.networking.k8s.io/channel annotation
+	// of the CRD.
+	Channel string `json:"channel"`
+
+	// ServedVersions lists the API versions served by the CRD.
+	ServedVersions []string `json:"servedVersions,omitempty"`
+}
+
+// SkewSkippedTest is a test skipped because of the installed CRDs.
+type SkewSkippedTest struct {
+	// Name is the short name of the test.
+	Name string `json:"name"`
+
+	// Reason explains which resource, version or field is absent from the
+	// installed CRDs.
+	Reason string `json:"reason"`
+}
@@ -0,0 +1,37 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+// ImplementationSpecificReport is the generated report for the test results
+// of the tests an implementation registered under one of its own feature
+// namespaces.
+type ImplementationSpecificReport struct {
+	// Namespace is the implementation-defined feature namespace the tests
+	// were registered under (e.g. "example.com").
+	Namespace string `json:"namespace"`
+
+	Status `json:",inline"`
+
+	// SupportedFeatures indicates which implementation-specific features were
+	// flagged as supported by the implementation and tests will be attempted
+	// for.
+	SupportedFeatures []string `json:"supportedFeatures,omitempty"`
+
+	// UnsupportedFeatures indicates which implementation-specific features
+	// were not flagged as supported and therefore were not tested.
+	UnsupportedFeatures []string `json:"unsupportedFeatures,omitempty"`
+}
@@ -0,0 +1,37 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+// RepeatedTestReport summarizes the outcomes of a test that was run multiple
+// times in a row, which helps to tell apart flaky tests from flaky
+// implementations.
+type RepeatedTestReport struct {
+	// Name is the short name of the repeated test.
+	Name string `json:"name"`
+
+	// Statistics includes the number of runs of the test that passed, failed
+	// or were skipped.
+	Statistics `json:"statistics"`
+
+	// PassRate is the percentage of the runs that were not skipped which
+	// completed successfully (e.g. "80.0%").
+	PassRate string `json:"passRate"`
+
+	// Stable indicates whether all the runs that were not skipped had the
+	// same outcome.
+	Stable bool `json:"stable"`
+}
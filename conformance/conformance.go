@@ -19,17 +19,18 @@ package conformance
 import (
 	"io/fs"
 	"os"
+	"slices"
 	"testing"
 
 	v1 "sigs.k8s.io/gateway-api/apis/v1"
 	"sigs.k8s.io/gateway-api/apis/v1alpha2"
 	"sigs.k8s.io/gateway-api/apis/v1alpha3"
 	xv1alpha1 "sigs.k8s.io/gateway-api/apisx/v1alpha1"
-	confv1 "sigs.k8s.io/gateway-api/conformance/apis/v1"
 	"sigs.k8s.io/gateway-api/conformance/tests"
 	conformanceconfig "sigs.k8s.io/gateway-api/conformance/utils/config"
 	"sigs.k8s.io/gateway-api/conformance/utils/flags"
 	"sigs.k8s.io/gateway-api/conformance/utils/suite"
+	"sigs.k8s.io/gateway-api/pkg/features"
 
 	"github.com/stretchr/testify/require"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
@@ -48,6 +49,16 @@ func DefaultOptions(t *testing.T) suite.ConformanceOptions {
 	// This line prevents controller-runtime from complaining about log.SetLogger never being called
 	log.SetLogger(zap.New(zap.WriteTo(os.Stdout), zap.UseDevMode(true)))
 
+	configurableOpts := loadConfigurableOptions(t)
+
+	// Listing tests doesn't contact the cluster, so the clients are not needed.
+	if configurableOpts.ListTests {
+		return suite.ConformanceOptions{
+			ConfigurableOptions: *configurableOpts,
+			ManifestFS:          []fs.FS{&Manifests},
+		}
+	}
+
 	cfg, err := config.GetConfig()
 	require.NoError(t, err, "error loading Kubernetes config")
 	clientOptions := client.Options{}
@@ -66,6 +77,20 @@ func DefaultOptions(t *testing.T) suite.ConformanceOptions {
 	require.NoError(t, v1.Install(client.Scheme()))
 	require.NoError(t, apiextensionsv1.AddToScheme(client.Scheme()))
 
+	return suite.ConformanceOptions{
+		ConfigurableOptions: *configurableOpts,
+		Client:              client,
+		ClientOptions:       clientOptions,
+		Clientset:           clientset,
+		ManifestFS:          []fs.FS{&Manifests},
+		RestConfig:          cfg,
+	}
+}
+
+// loadConfigurableOptions loads the configurable conformance options from
+// the flag defaults, the options file and the command line flags that were
+// explicitly set, each overriding the previous ones.
+func loadConfigurableOptions(t *testing.T) *suite.ConfigurableOptions {
 	// Load configurable conformance options, using flag defaults as needed.
 	configurableOpts := &suite.ConfigurableOptions{
 		CleanupBaseResources: flags.DefaultCleanupBaseResources,
@@ -73,6 +98,8 @@ func DefaultOptions(t *testing.T) suite.ConformanceOptions {
 		GatewayClassName:     flags.DefaultGatewayClassName,
 		Mode:                 flags.DefaultMode,
 		TimeoutConfig:        conformanceconfig.DefaultTimeoutConfig(),
+		WeightConfig:         conformanceconfig.DefaultWeightConfig(),
+		BenchmarkConfig:      conformanceconfig.DefaultBenchmarkConfig(),
 	}
 
 	// Load conformance options provided via yaml file, overriding defaults.
@@ -86,14 +113,7 @@ func DefaultOptions(t *testing.T) suite.ConformanceOptions {
 	// Override options with any command line flags that were explicitly set.
 	flags.ApplyAll(configurableOpts)
 
-	return suite.ConformanceOptions{
-		ConfigurableOptions: *configurableOpts,
-		Client:              client,
-		ClientOptions:       clientOptions,
-		Clientset:           clientset,
-		ManifestFS:          []fs.FS{&Manifests},
-		RestConfig:          cfg,
-	}
+	return configurableOpts
 }
 
 // RunConformance will run the Gateway API Conformance tests
@@ -114,6 +134,24 @@ func RunConformanceWithOptions(t *testing.T, opts suite.ConformanceOptions) {
 		opts.ManifestFS = []fs.FS{&Manifests}
 	}
 
+	conformanceTests := slices.Concat(tests.ConformanceTests, suite.ImplementationSpecificTests())
+	for _, test := range suite.ImplementationSpecificTests() {
+		require.False(t, slices.ContainsFunc(tests.ConformanceTests, func(ct suite.ConformanceTest) bool {
+			return ct.ShortName == test.ShortName
+		}), "implementation-specific test %q has the same name as an upstream test", test.ShortName)
+	}
+
+	if opts.ListTests {
+		plan, err := suite.PlanConformanceTests(opts, conformanceTests)
+		require.NoError(t, err, "error planning conformance tests")
+		t.Log("Listing conformance tests with:")
+		logOptions(t, opts)
+		for _, entry := range plan {
+			t.Log(entry.String())
+		}
+		return
+	}
+
 	t.Log("Running conformance tests with:")
 	logOptions(t, opts)
 
@@ -124,15 +162,57 @@ func RunConformanceWithOptions(t *testing.T, opts suite.ConformanceOptions) {
 		t.Cleanup(func() {
 			report, rErr := cSuite.Report()
 			require.NoError(t, rErr, "error generating conformance profile report")
-			require.NoError(t, writeReport(t.Logf, *report, opts.ReportOutputPath), "error writing report")
+			require.NoError(t, writeReport(t.Logf, "Conformance", *report, opts.ReportOutputPath), "error writing report")
 		})
 	}
 
-	cSuite.Setup(t, tests.ConformanceTests)
-	err = cSuite.Run(t, tests.ConformanceTests)
+	cSuite.Setup(t, conformanceTests)
+	err = cSuite.Run(t, conformanceTests)
 	require.NoError(t, err)
 }
 
+// RunBenchmark will run the scale and propagation-latency benchmark using the
+// default ConformanceOptions computed from command line flags. It is skipped
+// unless the benchmark is enabled.
+func RunBenchmark(t *testing.T) {
+	// The options are checked before building the clients, which require a
+	// cluster.
+	if !loadConfigurableOptions(t).Benchmark {
+		skipBenchmark(t)
+	}
+	RunBenchmarkWithOptions(t, DefaultOptions(t))
+}
+
+// RunBenchmarkWithOptions will run the scale and propagation-latency
+// benchmark with the supplied options. It is skipped unless the benchmark is
+// enabled.
+func RunBenchmarkWithOptions(t *testing.T, opts suite.ConformanceOptions) {
+	if !opts.Benchmark {
+		skipBenchmark(t)
+	}
+
+	// if no FS is provided, use the default Manifests FS
+	if opts.ManifestFS == nil {
+		opts.ManifestFS = []fs.FS{&Manifests}
+	}
+
+	cSuite, err := suite.NewConformanceTestSuite(opts)
+	require.NoError(t, err, "error initializing conformance suite")
+	if !cSuite.SupportedFeatures.Has(features.SupportGateway) || !cSuite.SupportedFeatures.Has(features.SupportHTTPRoute) {
+		t.Skip("Skipping benchmark: it requires the Gateway and HTTPRoute features to be supported")
+	}
+
+	t.Logf("Running benchmark with %d HTTPRoutes across %d namespaces", cSuite.BenchmarkConfig.Routes, cSuite.BenchmarkConfig.Namespaces)
+	cSuite.Setup(t, nil)
+	report := cSuite.Benchmark(t)
+	require.NoError(t, writeReport(t.Logf, "Benchmark", *report, opts.BenchmarkReportOutputPath), "error writing benchmark report")
+}
+
+func skipBenchmark(t *testing.T) {
+	t.Helper()
+	t.Skip("Skipping benchmark: it is only run when enabled with --benchmark")
+}
+
 func logOptions(t *testing.T, opts suite.ConformanceOptions) {
 	t.Logf("  GatewayClass: %s", opts.GatewayClassName)
 	t.Logf("  Cleanup Base Resources: %t", opts.CleanupBaseResources)
@@ -142,9 +222,30 @@ func logOptions(t *testing.T, opts suite.ConformanceOptions) {
 	t.Logf("  Supported Features: %v", opts.SupportedFeatures)
 	t.Logf("  ExemptFeatures: %v", opts.ExemptFeatures)
 	t.Logf("  ConformanceProfiles: %v", opts.ConformanceProfiles)
+	if opts.RunTestsMatching != "" {
+		t.Logf("  Run Tests Matching: %s", opts.RunTestsMatching)
+	}
+	if opts.FeatureExpression != "" {
+		t.Logf("  Feature Expression: %s", opts.FeatureExpression)
+	}
+	if len(opts.SelectProfiles) > 0 {
+		t.Logf("  Select Profiles: %v", opts.SelectProfiles)
+	}
+	if opts.DebugBundleDir != "" {
+		t.Logf("  Debug Bundle Directory: %s", opts.DebugBundleDir)
+	}
+	if opts.CRDVersionSkew {
+		t.Logf("  CRD Version Skew: enabled")
+	}
+	if opts.GatewayDialer != "" {
+		t.Logf("  Gateway Dialer: %s", opts.GatewayDialer)
+	}
+	if opts.Repeat > 1 {
+		t.Logf("  Repeat: %d", opts.Repeat)
+	}
 }
 
-func writeReport(logf func(string, ...any), report confv1.ConformanceReport, output string) error {
+func writeReport(logf func(string, ...any), kind string, report any, output string) error {
 	rawReport, err := yaml.Marshal(report)
 	if err != nil {
 		return err
@@ -155,6 +256,6 @@ func writeReport(logf func(string, ...any), report confv1.ConformanceReport, out
 			return err
 		}
 	}
-	logf("Conformance report:\n%s", string(rawReport))
+	logf("%s report:\n%s", kind, string(rawReport))
 	return nil
 }
@@ -25,3 +25,7 @@ import (
 func TestConformance(t *testing.T) {
 	conformance.RunConformance(t)
 }
+
+func TestBenchmark(t *testing.T) {
+	conformance.RunBenchmark(t)
+}
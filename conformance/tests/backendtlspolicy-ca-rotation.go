@@ -0,0 +1,110 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tests
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	h "sigs.k8s.io/gateway-api/conformance/utils/http"
+	"sigs.k8s.io/gateway-api/conformance/utils/kubernetes"
+	confsuite "sigs.k8s.io/gateway-api/conformance/utils/suite"
+	"sigs.k8s.io/gateway-api/pkg/features"
+)
+
+func init() {
+	ConformanceTests = append(ConformanceTests, BackendTLSPolicyCACertificateRotation)
+}
+
+var BackendTLSPolicyCACertificateRotation = confsuite.ConformanceTest{
+	ShortName:   "BackendTLSPolicyCACertificateRotation",
+	Description: "Rotating the CA certificate in the ConfigMap referenced by a BackendTLSPolicy should change the backend certificates trusted by the Gateway without recreating it",
+	Features: []features.FeatureName{
+		features.SupportGateway,
+		features.SupportHTTPRoute,
+		features.SupportBackendTLSPolicy,
+	},
+	Provisional: true,
+	Manifests:   []string{"tests/backendtlspolicy-ca-rotation.yaml"},
+	Test: func(t *testing.T, suite *confsuite.ConformanceTestSuite) {
+		ns := confsuite.InfrastructureNamespace
+		routeNN := types.NamespacedName{Name: "backendtlspolicy-ca-rotation", Namespace: ns}
+		gwNN := types.NamespacedName{Name: "same-namespace", Namespace: ns}
+		policyNN := types.NamespacedName{Name: "ca-rotation", Namespace: ns}
+		cmNN := types.NamespacedName{Name: "backendtlspolicy-ca-rotation-ca-certificate", Namespace: ns}
+
+		// The ConfigMap starts with a CA which did not issue the certificate
+		// of the tls-backend.
+		unrelatedCA := kubernetes.MustGenerateCertificate(t, kubernetes.CertificateOptions{IsCA: true})
+		cm := unrelatedCA.CACertConfigMap(cmNN.Namespace, cmNN.Name)
+		unrelatedCAData := cm.Data["ca.crt"]
+		suite.Applier.MustApplyObjectsWithCleanup(t, suite.Client, suite.TimeoutConfig, []client.Object{cm}, suite.CleanupTestResources)
+
+		kubernetes.NamespacesMustBeReady(t, suite.Client, suite.TimeoutConfig, []string{ns})
+		gwAddr := kubernetes.GatewayAndRoutesMustBeAccepted(t, suite.Client, suite.TimeoutConfig, suite.ControllerName, kubernetes.NewGatewayRef(gwNN), &gatewayv1.HTTPRoute{}, false, routeNN)
+		kubernetes.HTTPRouteMustHaveResolvedRefsConditionsTrue(t, suite.Client, suite.TimeoutConfig, routeNN, gwNN)
+
+		kubernetes.BackendTLSPolicyMustHaveCondition(t, suite.Client, suite.TimeoutConfig, policyNN, gwNN, metav1.Condition{
+			Type:   string(gatewayv1.PolicyConditionAccepted),
+			Status: metav1.ConditionTrue,
+			Reason: string(gatewayv1.PolicyReasonAccepted),
+		})
+		kubernetes.BackendTLSPolicyMustHaveCondition(t, suite.Client, suite.TimeoutConfig, policyNN, gwNN, metav1.Condition{
+			Type:   string(gatewayv1.BackendTLSPolicyConditionResolvedRefs),
+			Status: metav1.ConditionTrue,
+			Reason: string(gatewayv1.BackendTLSPolicyReasonResolvedRefs),
+		})
+
+		gw := &gatewayv1.Gateway{}
+		require.NoError(t, suite.Client.Get(t.Context(), gwNN, gw), "error getting Gateway")
+
+		expected := h.ExpectedResponse{
+			Namespace: ns,
+			Request: h.Request{
+				Host: "abc.example.com",
+				Path: "/backendtlspolicy-ca-rotation",
+				SNI:  "abc.example.com",
+			},
+			Response: h.Response{StatusCodes: []int{200}},
+		}
+		h.MakeRequestAndExpectFailure(t, suite.RoundTripper, suite.TimeoutConfig, gwAddr, expected)
+
+		sharedCM := &corev1.ConfigMap{}
+		require.NoError(t, suite.Client.Get(t.Context(), types.NamespacedName{Name: "tls-checks-ca-certificate", Namespace: ns}, sharedCM), "failed to get shared ConfigMap")
+
+		t.Run("Rotating the ConfigMap to the CA of the backend certificate should be picked up", func(t *testing.T) {
+			patchConfigMapCACert(t.Context(), t, suite.Client, cmNN, sharedCM.Data["ca.crt"])
+			h.MakeRequestAndExpectEventuallyConsistentResponse(t, suite.RoundTripper, suite.TimeoutConfig, gwAddr, expected)
+		})
+
+		t.Run("Rotating the ConfigMap to an unrelated CA should be picked up", func(t *testing.T) {
+			patchConfigMapCACert(t.Context(), t, suite.Client, cmNN, unrelatedCAData)
+			h.MakeRequestAndExpectFailure(t, suite.RoundTripper, suite.TimeoutConfig, gwAddr, expected)
+		})
+
+		current := &gatewayv1.Gateway{}
+		require.NoError(t, suite.Client.Get(t.Context(), gwNN, current), "error getting Gateway")
+		require.Equal(t, gw.UID, current.UID, "Gateway was recreated to rotate the backend CA certificate")
+		require.Equal(t, gw.Generation, current.Generation, "Gateway was updated to rotate the backend CA certificate")
+	},
+}
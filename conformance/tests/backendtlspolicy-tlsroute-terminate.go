@@ -87,7 +87,7 @@ var BackendTLSPolicyTLSRouteTerminate = suite.ConformanceTest{
 			// serverStr is the client-facing hostname from the TLSRoute/Gateway listener.
 			// expectedBackendHostname is the backend TLS identity validated via
 			// BackendTLSPolicy.validation.hostname during re-encryption to the Service backend.
-			tcp.MakeTCPRequestAndExpectEventuallyValidResponse(t, suite.TimeoutConfig, gwAddr, []byte(caString), serverStr, true,
+			suite.TCPClient.MakeTCPRequestAndExpectEventuallyValidResponse(t, suite.TimeoutConfig, gwAddr, []byte(caString), serverStr, true,
 				tcp.ExpectedResponse{
 					BackendIsTLS: true,
 					Backend:      expectedBackendName,
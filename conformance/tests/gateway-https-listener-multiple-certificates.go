@@ -0,0 +1,102 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tests
+
+import (
+	"crypto/x509"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"sigs.k8s.io/gateway-api/conformance/utils/http"
+	"sigs.k8s.io/gateway-api/conformance/utils/kubernetes"
+	confsuite "sigs.k8s.io/gateway-api/conformance/utils/suite"
+	"sigs.k8s.io/gateway-api/conformance/utils/tls"
+	"sigs.k8s.io/gateway-api/pkg/features"
+)
+
+func init() {
+	ConformanceTests = append(ConformanceTests, GatewayHTTPSListenerMultipleCertificates)
+}
+
+var GatewayHTTPSListenerMultipleCertificates = confsuite.ConformanceTest{
+	ShortName:   "GatewayHTTPSListenerMultipleCertificates",
+	Description: "A Gateway HTTPS listener referencing an RSA and an ECDSA certificate should serve to each client the certificate it is capable of",
+	Features: []features.FeatureName{
+		features.SupportGateway,
+		features.SupportGatewayHTTPSListenerMultipleCertificates,
+		features.SupportHTTPRoute,
+	},
+	Provisional: true,
+	Manifests:   []string{"tests/gateway-https-listener-multiple-certificates.yaml"},
+	Test: func(t *testing.T, suite *confsuite.ConformanceTestSuite) {
+		ns := confsuite.InfrastructureNamespace
+		gwNN := types.NamespacedName{Name: "gateway-https-listener-multiple-certificates", Namespace: ns}
+		routeNN := types.NamespacedName{Name: "gateway-https-listener-multiple-certificates", Namespace: ns}
+		hostname := "multiple-certificates.example.org"
+
+		// Both certificates are issued by the same root CA, so that only the
+		// algorithm of their keys tells them apart.
+		rootCA := kubernetes.MustGenerateCertificate(t, kubernetes.CertificateOptions{IsCA: true})
+		rsaCert := kubernetes.MustGenerateCertificate(t, kubernetes.CertificateOptions{
+			Hosts:        []string{hostname},
+			KeyAlgorithm: kubernetes.RSAKeyAlgorithm,
+			Issuer:       rootCA,
+		})
+		ecdsaCert := kubernetes.MustGenerateCertificate(t, kubernetes.CertificateOptions{
+			Hosts:        []string{hostname},
+			KeyAlgorithm: kubernetes.ECDSAP256KeyAlgorithm,
+			Issuer:       rootCA,
+		})
+		suite.Applier.MustApplyObjectsWithCleanup(t, suite.Client, suite.TimeoutConfig, []client.Object{
+			rsaCert.Secret(ns, "gateway-https-listener-multiple-certificates-rsa"),
+			ecdsaCert.Secret(ns, "gateway-https-listener-multiple-certificates-ecdsa"),
+		}, suite.CleanupTestResources)
+
+		// The test creates an additional Gateway in the gateway-conformance-infra
+		// namespace so we have to wait for it to be ready.
+		kubernetes.NamespacesMustBeReady(t, suite.Client, suite.TimeoutConfig, []string{ns})
+		gwAddr := kubernetes.GatewayAndHTTPRoutesMustBeAccepted(t, suite.Client, suite.TimeoutConfig, suite.ControllerName, kubernetes.NewGatewayRef(gwNN), routeNN)
+
+		t.Run("A client only capable of RSA should be served the RSA certificate", func(t *testing.T) {
+			tls.ExpectEventuallyServedCertificate(t, suite.TimeoutConfig, gwAddr, tls.ExpectedCertificate{
+				ServerName:        hostname,
+				ServerCertificate: rootCA.CertificatePEM,
+				KeyAlgorithm:      x509.RSA,
+				Certificate:       rsaCert.Certificate,
+			})
+		})
+
+		t.Run("A client only capable of ECDSA should be served the ECDSA certificate", func(t *testing.T) {
+			tls.ExpectEventuallyServedCertificate(t, suite.TimeoutConfig, gwAddr, tls.ExpectedCertificate{
+				ServerName:        hostname,
+				ServerCertificate: rootCA.CertificatePEM,
+				KeyAlgorithm:      x509.ECDSA,
+				Certificate:       ecdsaCert.Certificate,
+			})
+		})
+
+		t.Run("A client capable of both should be routed to the backend", func(t *testing.T) {
+			tls.MakeTLSRequestAndExpectEventuallyConsistentResponse(t, suite.RoundTripper, suite.TimeoutConfig, gwAddr, rootCA.CertificatePEM, nil, nil, hostname, http.ExpectedResponse{
+				Request:   http.Request{Host: hostname, Path: "/"},
+				Backend:   confsuite.InfraBackendServiceNameV1,
+				Namespace: ns,
+			})
+		})
+	},
+}
@@ -0,0 +1,125 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tests
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/conformance/utils/http"
+	"sigs.k8s.io/gateway-api/conformance/utils/kubernetes"
+	confsuite "sigs.k8s.io/gateway-api/conformance/utils/suite"
+	"sigs.k8s.io/gateway-api/conformance/utils/tls"
+	"sigs.k8s.io/gateway-api/pkg/features"
+)
+
+func init() {
+	ConformanceTests = append(ConformanceTests, GatewaySecretReferenceGrantDynamics)
+}
+
+var GatewaySecretReferenceGrantDynamics = confsuite.ConformanceTest{
+	ShortName:   "GatewaySecretReferenceGrantDynamics",
+	Description: "A Gateway listener with a certificateRef for a Secret in another namespace should follow a ReferenceGrant that is created late, narrowed and deleted while the test is running",
+	Features: []features.FeatureName{
+		features.SupportGateway,
+		features.SupportHTTPRoute,
+		features.SupportReferenceGrant,
+	},
+	Provisional: true,
+	Manifests:   []string{"tests/gateway-secret-reference-grant-dynamics.yaml"},
+	Test: func(t *testing.T, suite *confsuite.ConformanceTestSuite) {
+		gwNN := types.NamespacedName{Name: "gateway-secret-reference-grant-dynamics", Namespace: confsuite.InfrastructureNamespace}
+		secretNN := types.NamespacedName{Name: "gateway-secret-reference-grant-dynamics-certificate", Namespace: confsuite.WebBackendNamespace}
+		hostname := "secret-reference-grant-dynamics.example.org"
+		timeoutConfig := referenceGrantDynamicsTimeoutConfig(suite.TimeoutConfig)
+
+		cert := kubernetes.MustGenerateCertificate(t, kubernetes.CertificateOptions{Hosts: []string{hostname}})
+		suite.Applier.MustApplyObjectsWithCleanup(t, suite.Client, suite.TimeoutConfig, []client.Object{cert.Secret(secretNN.Namespace, secretNN.Name)}, suite.CleanupTestResources)
+
+		// The https listener is not expected to be programmed until the
+		// ReferenceGrant exists, so only wait for the Gateway address here.
+		gwAddr, err := kubernetes.WaitForGatewayAddress(t, suite.Client, suite.TimeoutConfig, kubernetes.NewGatewayRef(gwNN, "https"))
+		require.NoError(t, err, "timed out waiting for Gateway address to be assigned")
+
+		refNotPermitted := metav1.Condition{
+			Type:   string(v1.ListenerConditionResolvedRefs),
+			Status: metav1.ConditionFalse,
+			Reason: string(v1.ListenerReasonRefNotPermitted),
+		}
+		resolvedRefs := metav1.Condition{
+			Type:   string(v1.ListenerConditionResolvedRefs),
+			Status: metav1.ConditionTrue,
+			Reason: string(v1.ListenerReasonResolvedRefs),
+		}
+		expected := http.ExpectedResponse{
+			Request:   http.Request{Host: hostname, Path: "/"},
+			Backend:   confsuite.InfraBackendServiceNameV1,
+			Namespace: confsuite.InfrastructureNamespace,
+		}
+		expectNotPermitted := func(t *testing.T) {
+			kubernetes.GatewayListenersMustHaveConditions(t, suite.Client, timeoutConfig, gwNN, []metav1.Condition{refNotPermitted}, "https")
+			tls.MakeTLSRequestAndExpectEventuallyConsistentFailureResponse(t, suite.RoundTripper, timeoutConfig, gwAddr, cert.CertificatePEM, nil, nil, hostname, expected)
+		}
+		expectPermitted := func(t *testing.T) {
+			kubernetes.GatewayListenersMustHaveConditions(t, suite.Client, timeoutConfig, gwNN, []metav1.Condition{resolvedRefs}, "https")
+			tls.MakeTLSRequestAndExpectEventuallyConsistentResponse(t, suite.RoundTripper, timeoutConfig, gwAddr, cert.CertificatePEM, nil, nil, hostname, expected)
+		}
+
+		t.Run("Gateway listener should not be permitted to reference the Secret before the ReferenceGrant exists", expectNotPermitted)
+
+		rg := &v1.ReferenceGrant{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "gateway-secret-reference-grant-dynamics",
+				Namespace: confsuite.WebBackendNamespace,
+			},
+			Spec: v1.ReferenceGrantSpec{
+				From: []v1.ReferenceGrantFrom{{
+					Group:     v1.Group(v1.GroupName),
+					Kind:      "Gateway",
+					Namespace: v1.Namespace(confsuite.InfrastructureNamespace),
+				}},
+				To: []v1.ReferenceGrantTo{{
+					Group: "",
+					Kind:  "Secret",
+				}},
+			},
+		}
+		suite.Applier.MustApplyObjectsWithCleanup(t, suite.Client, suite.TimeoutConfig, []client.Object{rg}, suite.CleanupTestResources)
+
+		t.Run("Gateway listener should serve the Secret once a ReferenceGrant for all Secrets is created", expectPermitted)
+
+		t.Run("Gateway listener should not be permitted once the ReferenceGrant is narrowed to another Secret", func(t *testing.T) {
+			patchReferenceGrantToName(t, suite.Client, rg, "other-certificate")
+			expectNotPermitted(t)
+		})
+
+		t.Run("Gateway listener should serve the Secret once the ReferenceGrant is narrowed to the referenced Secret", func(t *testing.T) {
+			patchReferenceGrantToName(t, suite.Client, rg, secretNN.Name)
+			expectPermitted(t)
+		})
+
+		t.Run("Gateway listener should not be permitted once the ReferenceGrant is deleted", func(t *testing.T) {
+			require.NoError(t, suite.Client.Delete(t.Context(), rg), "error deleting ReferenceGrant")
+			expectNotPermitted(t)
+		})
+	},
+}
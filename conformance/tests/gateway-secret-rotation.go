@@ -0,0 +1,107 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tests
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/conformance/utils/http"
+	"sigs.k8s.io/gateway-api/conformance/utils/kubernetes"
+	confsuite "sigs.k8s.io/gateway-api/conformance/utils/suite"
+	"sigs.k8s.io/gateway-api/conformance/utils/tls"
+	"sigs.k8s.io/gateway-api/pkg/features"
+)
+
+func init() {
+	ConformanceTests = append(ConformanceTests, GatewaySecretRotation)
+}
+
+var GatewaySecretRotation = confsuite.ConformanceTest{
+	ShortName:   "GatewaySecretRotation",
+	Description: "Updating the Secret referenced by a Gateway HTTPS listener should rotate the served certificate without recreating the Gateway",
+	Features: []features.FeatureName{
+		features.SupportGateway,
+		features.SupportHTTPRoute,
+	},
+	Provisional: true,
+	Manifests:   []string{"tests/gateway-secret-rotation.yaml"},
+	Test: func(t *testing.T, suite *confsuite.ConformanceTestSuite) {
+		ns := confsuite.InfrastructureNamespace
+		gwNN := types.NamespacedName{Name: "gateway-secret-rotation", Namespace: ns}
+		routeNN := types.NamespacedName{Name: "gateway-secret-rotation", Namespace: ns}
+		secretNN := types.NamespacedName{Name: "gateway-secret-rotation-certificate", Namespace: ns}
+		hostname := "rotation.example.org"
+
+		// The first certificate is issued directly by its root CA.
+		rootCA := kubernetes.MustGenerateCertificate(t, kubernetes.CertificateOptions{IsCA: true})
+		cert := kubernetes.MustGenerateCertificate(t, kubernetes.CertificateOptions{Hosts: []string{hostname}, Issuer: rootCA})
+		suite.Applier.MustApplyObjectsWithCleanup(t, suite.Client, suite.TimeoutConfig, []client.Object{cert.Secret(secretNN.Namespace, secretNN.Name)}, suite.CleanupTestResources)
+
+		// The test creates an additional Gateway in the gateway-conformance-infra
+		// namespace so we have to wait for it to be ready.
+		kubernetes.NamespacesMustBeReady(t, suite.Client, suite.TimeoutConfig, []string{ns})
+		gwAddr := kubernetes.GatewayAndHTTPRoutesMustBeAccepted(t, suite.Client, suite.TimeoutConfig, suite.ControllerName, kubernetes.NewGatewayRef(gwNN), routeNN)
+
+		gw := &gatewayv1.Gateway{}
+		require.NoError(t, suite.Client.Get(t.Context(), gwNN, gw), "error getting Gateway")
+
+		expected := http.ExpectedResponse{
+			Request:   http.Request{Host: hostname, Path: "/"},
+			Backend:   confsuite.InfraBackendServiceNameV1,
+			Namespace: ns,
+		}
+		tls.ExpectEventuallyServedCertificate(t, suite.TimeoutConfig, gwAddr, tls.ExpectedCertificate{
+			ServerName:        hostname,
+			ServerCertificate: rootCA.CertificatePEM,
+			Certificate:       cert.Certificate,
+		})
+		tls.MakeTLSRequestAndExpectEventuallyConsistentResponse(t, suite.RoundTripper, suite.TimeoutConfig, gwAddr, rootCA.CertificatePEM, nil, nil, hostname, expected)
+
+		t.Run("Rotating the Secret to a certificate issued by an intermediate CA should be picked up", func(t *testing.T) {
+			// The rotated certificate is issued by an intermediate CA of
+			// another root CA, which can only be verified when the Gateway
+			// serves the whole chain of the Secret.
+			rotatedRootCA := kubernetes.MustGenerateCertificate(t, kubernetes.CertificateOptions{IsCA: true})
+			intermediateCA := kubernetes.MustGenerateCertificate(t, kubernetes.CertificateOptions{IsCA: true, Issuer: rotatedRootCA})
+			rotatedCert := kubernetes.MustGenerateCertificate(t, kubernetes.CertificateOptions{Hosts: []string{hostname}, Issuer: intermediateCA})
+
+			original := &corev1.Secret{}
+			require.NoError(t, suite.Client.Get(t.Context(), secretNN, original), "error getting Secret")
+			rotated := original.DeepCopy()
+			rotated.Data = rotatedCert.Secret(secretNN.Namespace, secretNN.Name).Data
+			require.NoError(t, suite.Client.Patch(t.Context(), rotated, client.MergeFrom(original)), "error patching Secret")
+
+			tls.ExpectEventuallyServedCertificate(t, suite.TimeoutConfig, gwAddr, tls.ExpectedCertificate{
+				ServerName:        hostname,
+				ServerCertificate: rotatedRootCA.CertificatePEM,
+				Certificate:       rotatedCert.Certificate,
+			})
+			tls.MakeTLSRequestAndExpectEventuallyConsistentResponse(t, suite.RoundTripper, suite.TimeoutConfig, gwAddr, rotatedRootCA.CertificatePEM, nil, nil, hostname, expected)
+
+			current := &gatewayv1.Gateway{}
+			require.NoError(t, suite.Client.Get(t.Context(), gwNN, current), "error getting Gateway")
+			require.Equal(t, gw.UID, current.UID, "Gateway was recreated to rotate its certificate")
+			require.Equal(t, gw.Generation, current.Generation, "Gateway was updated to rotate its certificate")
+		})
+	},
+}
@@ -0,0 +1,109 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tests
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	"sigs.k8s.io/gateway-api/conformance/utils/http"
+	"sigs.k8s.io/gateway-api/conformance/utils/kubernetes"
+	"sigs.k8s.io/gateway-api/conformance/utils/roundtripper"
+	confsuite "sigs.k8s.io/gateway-api/conformance/utils/suite"
+	"sigs.k8s.io/gateway-api/conformance/utils/tls"
+	"sigs.k8s.io/gateway-api/pkg/features"
+)
+
+func init() {
+	ConformanceTests = append(ConformanceTests, HTTPRouteHTTP3)
+}
+
+var HTTPRouteHTTP3 = confsuite.ConformanceTest{
+	ShortName:   "HTTPRouteHTTP3",
+	Description: "HTTPRoutes attached to a Gateway's HTTPS listener are served over HTTP/3",
+	Features: []features.FeatureName{
+		features.SupportGateway,
+		features.SupportHTTPRoute,
+		features.SupportGatewayHTTP3,
+	},
+	Manifests: []string{"tests/httproute-http3.yaml"},
+	Test: func(t *testing.T, suite *confsuite.ConformanceTestSuite) {
+		ns := confsuite.InfrastructureNamespace
+		routeNN := types.NamespacedName{Name: "httproute-http3-test", Namespace: ns}
+		routeNoHostNN := types.NamespacedName{Name: "httproute-http3-test-no-hostname", Namespace: ns}
+		routeHeaderMatchingNN := types.NamespacedName{Name: "httproute-http3-header-matching", Namespace: ns}
+
+		gwNN := types.NamespacedName{Name: "same-namespace-with-https-listener", Namespace: ns}
+		gwAddr := kubernetes.GatewayAndHTTPRoutesMustBeAccepted(t, suite.Client, suite.TimeoutConfig, suite.ControllerName, kubernetes.NewGatewayRef(gwNN), routeNN, routeNoHostNN, routeHeaderMatchingNN)
+		kubernetes.HTTPRouteMustHaveResolvedRefsConditionsTrue(t, suite.Client, suite.TimeoutConfig, routeNN, gwNN)
+		kubernetes.HTTPRouteMustHaveResolvedRefsConditionsTrue(t, suite.Client, suite.TimeoutConfig, routeNoHostNN, gwNN)
+		kubernetes.HTTPRouteMustHaveResolvedRefsConditionsTrue(t, suite.Client, suite.TimeoutConfig, routeHeaderMatchingNN, gwNN)
+
+		certNN := types.NamespacedName{Name: "tls-validity-checks-certificate", Namespace: ns}
+		serverCertPem, _, err := kubernetes.GetTLSSecret(suite.Client, certNN)
+		if err != nil {
+			t.Fatalf("unexpected error finding TLS secret: %v", err)
+		}
+		if len(serverCertPem) == 0 {
+			t.Fatal("missing required server certificate pem for the test")
+		}
+
+		// The same cases as HTTPRouteHTTPSListener and a subset of
+		// HTTPRouteHeaderMatching, with requests made over QUIC to the UDP port
+		// matching the HTTPS listener port.
+		testCases := []http.ExpectedResponse{{
+			Request:   http.Request{Host: "example.org", Path: "/"},
+			Backend:   confsuite.InfraBackendServiceNameV1,
+			Namespace: ns,
+		}, {
+			Request:  http.Request{Host: "unknown-example.org", Path: "/"},
+			Response: http.Response{StatusCode: 404},
+		}, {
+			Request:   http.Request{Host: "second-example.org", Path: "/"},
+			Backend:   confsuite.InfraBackendServiceNameV2,
+			Namespace: ns,
+		}, {
+			Request:   http.Request{Host: "header-matching.org", Path: "/", Headers: map[string]string{"Version": "one"}},
+			Backend:   confsuite.InfraBackendServiceNameV1,
+			Namespace: ns,
+		}, {
+			Request:   http.Request{Host: "header-matching.org", Path: "/", Headers: map[string]string{"Version": "two"}},
+			Backend:   confsuite.InfraBackendServiceNameV2,
+			Namespace: ns,
+		}, {
+			Request:   http.Request{Host: "header-matching.org", Path: "/", Headers: map[string]string{"Version": "two", "Color": "orange"}},
+			Backend:   confsuite.InfraBackendServiceNameV1,
+			Namespace: ns,
+		}, {
+			Request:  http.Request{Host: "header-matching.org", Path: "/", Headers: map[string]string{"Color": "orange"}},
+			Response: http.Response{StatusCode: 404},
+		}}
+
+		for i := range testCases {
+			// Declare tc here to avoid loop variable
+			// reuse issues across parallel tests.
+			tc := testCases[i]
+			tc.Request.Protocol = roundtripper.H3Protocol
+			tc.Response.Protocol = "HTTP/3.0"
+			t.Run(tc.GetTestCaseName(i), func(t *testing.T) {
+				t.Parallel()
+				tls.MakeTLSRequestAndExpectEventuallyConsistentResponse(t, suite.RoundTripper, suite.TimeoutConfig, gwAddr, serverCertPem, nil, nil, tc.Request.Host, tc)
+			})
+		}
+	},
+}
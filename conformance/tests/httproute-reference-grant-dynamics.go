@@ -0,0 +1,142 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tests
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/conformance/utils/config"
+	"sigs.k8s.io/gateway-api/conformance/utils/http"
+	"sigs.k8s.io/gateway-api/conformance/utils/kubernetes"
+	confsuite "sigs.k8s.io/gateway-api/conformance/utils/suite"
+	"sigs.k8s.io/gateway-api/pkg/features"
+)
+
+func init() {
+	ConformanceTests = append(ConformanceTests, HTTPRouteReferenceGrantDynamics)
+}
+
+var HTTPRouteReferenceGrantDynamics = confsuite.ConformanceTest{
+	ShortName:   "HTTPRouteReferenceGrantDynamics",
+	Description: "An HTTPRoute with a backendRef in another namespace should follow a ReferenceGrant that is created late, narrowed and deleted while the test is running",
+	Features: []features.FeatureName{
+		features.SupportGateway,
+		features.SupportHTTPRoute,
+		features.SupportReferenceGrant,
+	},
+	Provisional: true,
+	Manifests:   []string{"tests/httproute-reference-grant-dynamics.yaml"},
+	Test: func(t *testing.T, suite *confsuite.ConformanceTestSuite) {
+		routeNN := types.NamespacedName{Name: "reference-grant-dynamics", Namespace: confsuite.InfrastructureNamespace}
+		gwNN := types.NamespacedName{Name: "same-namespace", Namespace: confsuite.InfrastructureNamespace}
+		timeoutConfig := referenceGrantDynamicsTimeoutConfig(suite.TimeoutConfig)
+		gwAddr := kubernetes.GatewayAndHTTPRoutesMustBeAccepted(t, suite.Client, suite.TimeoutConfig, suite.ControllerName, kubernetes.NewGatewayRef(gwNN), routeNN)
+
+		refNotPermitted := metav1.Condition{
+			Type:   string(v1.RouteConditionResolvedRefs),
+			Status: metav1.ConditionFalse,
+			Reason: string(v1.RouteReasonRefNotPermitted),
+		}
+		resolvedRefs := metav1.Condition{
+			Type:   string(v1.RouteConditionResolvedRefs),
+			Status: metav1.ConditionTrue,
+			Reason: string(v1.RouteReasonResolvedRefs),
+		}
+		request := http.Request{Host: "reference-grant-dynamics.example.com", Path: "/"}
+		expectNotPermitted := func(t *testing.T) {
+			kubernetes.HTTPRouteMustHaveCondition(t, suite.Client, timeoutConfig, routeNN, gwNN, refNotPermitted)
+			http.MakeRequestAndExpectEventuallyConsistentResponse(t, suite.RoundTripper, timeoutConfig, gwAddr, http.ExpectedResponse{
+				Request:  request,
+				Response: http.Response{StatusCode: 500},
+			})
+		}
+		expectPermitted := func(t *testing.T) {
+			kubernetes.HTTPRouteMustHaveCondition(t, suite.Client, timeoutConfig, routeNN, gwNN, resolvedRefs)
+			http.MakeRequestAndExpectEventuallyConsistentResponse(t, suite.RoundTripper, timeoutConfig, gwAddr, http.ExpectedResponse{
+				Request:   request,
+				Backend:   "web-backend",
+				Namespace: confsuite.WebBackendNamespace,
+			})
+		}
+
+		t.Run("HTTPRoute should not be permitted to reference the backend before the ReferenceGrant exists", expectNotPermitted)
+
+		rg := &v1.ReferenceGrant{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "reference-grant-dynamics",
+				Namespace: confsuite.WebBackendNamespace,
+			},
+			Spec: v1.ReferenceGrantSpec{
+				From: []v1.ReferenceGrantFrom{{
+					Group:     v1.Group(v1.GroupName),
+					Kind:      "HTTPRoute",
+					Namespace: v1.Namespace(confsuite.InfrastructureNamespace),
+				}},
+				To: []v1.ReferenceGrantTo{{
+					Group: "",
+					Kind:  "Service",
+				}},
+			},
+		}
+		suite.Applier.MustApplyObjectsWithCleanup(t, suite.Client, suite.TimeoutConfig, []client.Object{rg}, suite.CleanupTestResources)
+
+		t.Run("HTTPRoute should reach the backend once a ReferenceGrant for all Services is created", expectPermitted)
+
+		t.Run("HTTPRoute should not be permitted once the ReferenceGrant is narrowed to another Service", func(t *testing.T) {
+			patchReferenceGrantToName(t, suite.Client, rg, "other-backend")
+			expectNotPermitted(t)
+		})
+
+		t.Run("HTTPRoute should reach the backend once the ReferenceGrant is narrowed to the referenced Service", func(t *testing.T) {
+			patchReferenceGrantToName(t, suite.Client, rg, "web-backend")
+			expectPermitted(t)
+		})
+
+		t.Run("HTTPRoute should not be permitted once the ReferenceGrant is deleted", func(t *testing.T) {
+			require.NoError(t, suite.Client.Delete(t.Context(), rg), "error deleting ReferenceGrant")
+			expectNotPermitted(t)
+		})
+	},
+}
+
+// referenceGrantDynamicsTimeoutConfig returns a copy of timeoutConfig in which
+// status changes caused by a ReferenceGrant update must be observed within
+// MaxTimeToConsistency, just like the matching data plane changes.
+func referenceGrantDynamicsTimeoutConfig(timeoutConfig config.TimeoutConfig) config.TimeoutConfig {
+	timeoutConfig.HTTPRouteMustHaveCondition = timeoutConfig.MaxTimeToConsistency
+	timeoutConfig.GatewayListenersMustHaveConditions = timeoutConfig.MaxTimeToConsistency
+	return timeoutConfig
+}
+
+// patchReferenceGrantToName narrows every "to" entry of the ReferenceGrant to
+// the given resource name.
+func patchReferenceGrantToName(t *testing.T, c client.Client, rg *v1.ReferenceGrant, name string) {
+	t.Helper()
+
+	original := rg.DeepCopy()
+	for i := range rg.Spec.To {
+		rg.Spec.To[i].Name = ptr.To(v1.ObjectName(name))
+	}
+	require.NoError(t, c.Patch(t.Context(), rg, client.MergeFrom(original)), "error patching ReferenceGrant")
+}
@@ -0,0 +1,126 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tests
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	"sigs.k8s.io/gateway-api/conformance/utils/kubernetes"
+	confsuite "sigs.k8s.io/gateway-api/conformance/utils/suite"
+	"sigs.k8s.io/gateway-api/conformance/utils/websocket"
+	"sigs.k8s.io/gateway-api/pkg/features"
+)
+
+func init() {
+	ConformanceTests = append(ConformanceTests,
+		HTTPRouteWebSocketHeaderMatching,
+	)
+}
+
+var HTTPRouteWebSocketHeaderMatching = confsuite.ConformanceTest{
+	ShortName:   "HTTPRouteWebSocketHeaderMatching",
+	Description: "A HTTPRoute with a header match should route WebSocket upgrade requests carrying the header to a kubernetes.io/ws backend, over plain and TLS listeners",
+	Features: []features.FeatureName{
+		features.SupportGateway,
+		features.SupportHTTPRoute,
+		features.SupportHTTPRouteBackendProtocolWebSocket,
+	},
+	Provisional: true,
+	Manifests: []string{
+		"tests/httproute-websocket-header-matching.yaml",
+	},
+	Test: func(t *testing.T, suite *confsuite.ConformanceTestSuite) {
+		ns := confsuite.InfrastructureNamespace
+		routeNN := types.NamespacedName{Name: "websocket-header-matching", Namespace: ns}
+		gwNN := types.NamespacedName{Name: "same-namespace", Namespace: ns}
+		gwAddr := kubernetes.GatewayAndHTTPRoutesMustBeAccepted(t, suite.Client, suite.TimeoutConfig, suite.ControllerName, kubernetes.NewGatewayRef(gwNN), routeNN)
+		kubernetes.HTTPRouteMustHaveResolvedRefsConditionsTrue(t, suite.Client, suite.TimeoutConfig, routeNN, gwNN)
+
+		tlsRouteNN := types.NamespacedName{Name: "websocket-header-matching-tls", Namespace: ns}
+		tlsGwNN := types.NamespacedName{Name: "same-namespace-with-https-listener", Namespace: ns}
+		tlsGwAddr := kubernetes.GatewayAndHTTPRoutesMustBeAccepted(t, suite.Client, suite.TimeoutConfig, suite.ControllerName, kubernetes.NewGatewayRef(tlsGwNN), tlsRouteNN)
+		kubernetes.HTTPRouteMustHaveResolvedRefsConditionsTrue(t, suite.Client, suite.TimeoutConfig, tlsRouteNN, tlsGwNN)
+
+		certNN := types.NamespacedName{Name: "tls-validity-checks-certificate", Namespace: ns}
+		serverCertPem, _, err := kubernetes.GetTLSSecret(suite.Client, certNN)
+		if err != nil {
+			t.Fatalf("unexpected error finding TLS secret: %v", err)
+		}
+		if len(serverCertPem) == 0 {
+			t.Fatal("missing required server certificate pem for the test")
+		}
+
+		origin := "ws://gateway/HTTPRouteWebSocketHeaderMatching"
+		url := fmt.Sprintf("ws://%s/ws", gwAddr)
+		tlsURL := fmt.Sprintf("wss://%s/ws", tlsGwAddr)
+		messages := []any{"Websocket Support!", []byte{1, 2, 3, 4, 5, 6, 7}}
+
+		testCases := []websocket.ExpectedResponse{{
+			Request:      websocket.Request{URL: url, Origin: origin, Headers: map[string][]string{"Version": {"one"}}},
+			Messages:     messages,
+			TestCaseName: "upgrade request with a matching header should reach the backend",
+		}, {
+			Request:      websocket.Request{URL: url, Origin: origin, Protocols: []string{"echo"}, Headers: map[string][]string{"Version": {"one"}}},
+			Protocol:     "echo",
+			Messages:     messages,
+			TestCaseName: "upgrade request offering a subprotocol should have it selected",
+		}, {
+			Request:      websocket.Request{URL: url, Origin: origin, Headers: map[string][]string{"Version": {"two"}}},
+			StatusCode:   http.StatusNotFound,
+			TestCaseName: "upgrade request with a mismatched header should receive a 404",
+		}, {
+			Request:      websocket.Request{URL: url, Origin: origin},
+			StatusCode:   http.StatusNotFound,
+			TestCaseName: "upgrade request without the header should receive a 404",
+		}, {
+			Request: websocket.Request{
+				URL:               tlsURL,
+				Origin:            origin,
+				Host:              "websocket.org",
+				ServerName:        "websocket.org",
+				ServerCertificate: serverCertPem,
+				Headers:           map[string][]string{"Version": {"one"}},
+			},
+			Messages:     messages,
+			TestCaseName: "upgrade request over TLS with a matching header should reach the backend",
+		}, {
+			Request: websocket.Request{
+				URL:               tlsURL,
+				Origin:            origin,
+				Host:              "websocket.org",
+				ServerName:        "websocket.org",
+				ServerCertificate: serverCertPem,
+			},
+			StatusCode:   http.StatusNotFound,
+			TestCaseName: "upgrade request over TLS without the header should receive a 404",
+		}}
+
+		for i := range testCases {
+			// Declare tc here to avoid loop variable
+			// reuse issues across parallel tests.
+			tc := testCases[i]
+			t.Run(tc.GetTestCaseName(i), func(t *testing.T) {
+				t.Parallel()
+				websocket.MakeRequestAndExpectEventuallyConsistentResponse(t, suite.WebSocketDialer, suite.TimeoutConfig, tc)
+			})
+		}
+	},
+}
@@ -0,0 +1,104 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/conformance/utils/http"
+	"sigs.k8s.io/gateway-api/conformance/utils/kubernetes"
+	confsuite "sigs.k8s.io/gateway-api/conformance/utils/suite"
+	"sigs.k8s.io/gateway-api/conformance/utils/websocket"
+	"sigs.k8s.io/gateway-api/pkg/features"
+)
+
+func init() {
+	ConformanceTests = append(ConformanceTests,
+		HTTPRouteWebSocketWeightChange,
+	)
+}
+
+var HTTPRouteWebSocketWeightChange = confsuite.ConformanceTest{
+	ShortName:   "HTTPRouteWebSocketWeightChange",
+	Description: "An open WebSocket connection should survive a change of the HTTPRoute backend weights, new connections following the new weights",
+	Features: []features.FeatureName{
+		features.SupportGateway,
+		features.SupportHTTPRoute,
+		features.SupportHTTPRouteBackendProtocolWebSocket,
+	},
+	Provisional: true,
+	Manifests: []string{
+		"tests/httproute-websocket-weight-change.yaml",
+	},
+	Test: func(t *testing.T, suite *confsuite.ConformanceTestSuite) {
+		ns := confsuite.InfrastructureNamespace
+		routeNN := types.NamespacedName{Name: "websocket-weight-change", Namespace: ns}
+		gwNN := types.NamespacedName{Name: "same-namespace", Namespace: ns}
+		gwAddr := kubernetes.GatewayAndHTTPRoutesMustBeAccepted(t, suite.Client, suite.TimeoutConfig, suite.ControllerName, kubernetes.NewGatewayRef(gwNN), routeNN)
+		kubernetes.HTTPRouteMustHaveResolvedRefsConditionsTrue(t, suite.Client, suite.TimeoutConfig, routeNN, gwNN)
+
+		messages := []any{"Websocket Support!", []byte{1, 2, 3, 4, 5, 6, 7}}
+		request := websocket.Request{
+			URL:     fmt.Sprintf("ws://%s/ws", gwAddr),
+			Origin:  "ws://gateway/HTTPRouteWebSocketWeightChange",
+			Timeout: suite.TimeoutConfig.RequestTimeout,
+		}
+
+		// The WebSocket echo handler does not report which backend it runs
+		// on, so plain HTTP requests on the same backends are used to tell
+		// when the Gateway follows the weights.
+		expected := http.ExpectedResponse{
+			Request:   http.Request{Path: "/"},
+			Backend:   confsuite.InfraBackendServiceNameV1,
+			Namespace: ns,
+		}
+		http.MakeRequestAndExpectEventuallyConsistentResponse(t, suite.RoundTripper, suite.TimeoutConfig, gwAddr, expected)
+		websocket.MakeRequestAndExpectEventuallyConsistentResponse(t, suite.WebSocketDialer, suite.TimeoutConfig, websocket.ExpectedResponse{
+			Request:  request,
+			Messages: messages,
+		})
+
+		ws, _, err := websocket.DialRequest(suite.WebSocketDialer, request)
+		require.NoError(t, err, "error opening WebSocket connection")
+		defer ws.Close()
+		require.NoError(t, websocket.ExpectEcho(ws, messages...), "error echoing messages before updating the HTTPRoute")
+
+		original := &v1.HTTPRoute{}
+		require.NoError(t, suite.Client.Get(t.Context(), routeNN, original), "error getting HTTPRoute")
+		mutate := original.DeepCopy()
+		mutate.Spec.Rules[0].BackendRefs[0].Weight = ptr.To[int32](0)
+		mutate.Spec.Rules[0].BackendRefs[1].Weight = ptr.To[int32](1)
+		require.NoError(t, suite.Client.Patch(t.Context(), mutate, client.MergeFrom(original)), "error patching HTTPRoute")
+		kubernetes.HTTPRouteMustHaveLatestConditions(t, suite.Client, suite.TimeoutConfig, routeNN)
+
+		expected.Backend = confsuite.InfraBackendServiceNameV2
+		http.MakeRequestAndExpectEventuallyConsistentResponse(t, suite.RoundTripper, suite.TimeoutConfig, gwAddr, expected)
+
+		require.NoError(t, websocket.ExpectEcho(ws, messages...), "open WebSocket connection did not survive the HTTPRoute update")
+		websocket.MakeRequestAndExpectEventuallyConsistentResponse(t, suite.WebSocketDialer, suite.TimeoutConfig, websocket.ExpectedResponse{
+			Request:  request,
+			Messages: messages,
+		})
+	},
+}
@@ -86,9 +86,11 @@ var HTTPRouteWeight = confsuite.ConformanceTest{
 			})
 
 			for i := range weight.MaxTestRetries {
-				if err := weight.TestWeightedDistribution(sender, expectedWeights); err != nil {
+				distribution, err := weight.TestWeightedDistributionWithConfig(sender, expectedWeights, suite.WeightConfig)
+				if err != nil {
 					t.Logf("Traffic distribution test failed (%d/%d): %s", i+1, weight.MaxTestRetries, err)
 				} else {
+					t.Logf("Traffic distribution: %s", distribution)
 					return
 				}
 			}
@@ -72,9 +72,11 @@ var MeshHTTPRouteWeight = suite.ConformanceTest{
 			})
 
 			for i := range weight.MaxTestRetries {
-				if err := weight.TestWeightedDistributionBatch(sender, expectedWeights); err != nil {
+				distribution, err := weight.TestWeightedDistributionBatchWithConfig(sender, expectedWeights, s.WeightConfig)
+				if err != nil {
 					t.Logf("Traffic distribution test failed (%d/%d): %s", i+1, weight.MaxTestRetries, err)
 				} else {
+					t.Logf("Traffic distribution: %s", distribution)
 					return
 				}
 			}
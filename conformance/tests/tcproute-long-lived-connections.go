@@ -0,0 +1,101 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+	"sigs.k8s.io/gateway-api/conformance/utils/kubernetes"
+	confsuite "sigs.k8s.io/gateway-api/conformance/utils/suite"
+	"sigs.k8s.io/gateway-api/conformance/utils/tcp"
+	"sigs.k8s.io/gateway-api/conformance/utils/tlog"
+	"sigs.k8s.io/gateway-api/pkg/features"
+)
+
+func init() {
+	ConformanceTests = append(ConformanceTests, TCPRouteLongLivedConnections)
+}
+
+var TCPRouteLongLivedConnections = confsuite.ConformanceTest{
+	ShortName:   "TCPRouteLongLivedConnections",
+	Description: "An established TCP connection should keep being served by its backend when the TCPRoute backends are updated, and be drained gracefully when the TCPRoute is deleted.",
+	Manifests:   []string{"tests/tcproute-long-lived-connections.yaml"},
+	Features: []features.FeatureName{
+		features.SupportGateway,
+		features.SupportTCPRoute,
+	},
+	Provisional: true,
+	Test: func(t *testing.T, suite *confsuite.ConformanceTestSuite) {
+		ns := confsuite.InfrastructureNamespace
+		gwNN := types.NamespacedName{Name: "tcp-session-gateway", Namespace: ns}
+		routeNN := types.NamespacedName{Name: "tcp-session-route", Namespace: ns}
+
+		// The test creates an additional Gateway in the gateway-conformance-infra
+		// namespace so we have to wait for it to be ready.
+		kubernetes.NamespacesMustBeReady(t, suite.Client, suite.TimeoutConfig, []string{ns})
+
+		gwAddr := kubernetes.GatewayAndTCPRoutesMustBeAccepted(t, suite.Client, suite.TimeoutConfig, suite.ControllerName,
+			kubernetes.NewGatewayRef(gwNN, "tcp"), routeNN)
+
+		suite.TCPClient.ExpectEchoResponseFromBackend(t, suite.TimeoutConfig, gwAddr, "tcp-session-backend-v1")
+
+		session, err := suite.TCPClient.OpenSession(t.Context(), gwAddr, suite.TimeoutConfig.RequestTimeout)
+		require.NoError(t, err, "error opening TCP session")
+		defer session.Close()
+		tlog.Logf(t, "TCP session opened on pod %s", session.Pod())
+
+		t.Run("An established connection should keep its backend when the TCPRoute backends are updated", func(t *testing.T) {
+			original := &v1alpha2.TCPRoute{}
+			require.NoError(t, suite.Client.Get(t.Context(), routeNN, original), "error getting TCPRoute")
+			mutate := original.DeepCopy()
+			mutate.Spec.Rules[0].BackendRefs[0].Name = "tcp-session-backend-v2"
+			require.NoError(t, suite.Client.Patch(t.Context(), mutate, client.MergeFrom(original)), "error patching TCPRoute")
+
+			suite.TCPClient.ExpectEchoResponseFromBackend(t, suite.TimeoutConfig, gwAddr, "tcp-session-backend-v2")
+
+			require.NoError(t, session.ExpectSameBackend(), "established TCP connection did not survive the TCPRoute update")
+		})
+
+		t.Run("An established connection should be drained gracefully when the TCPRoute is deleted", func(t *testing.T) {
+			route := &v1alpha2.TCPRoute{}
+			require.NoError(t, suite.Client.Get(t.Context(), routeNN, route), "error getting TCPRoute")
+			require.NoError(t, suite.Client.Delete(t.Context(), route), "error deleting TCPRoute")
+
+			// New connections must stop reaching the backends once the route
+			// is gone.
+			err := wait.PollUntilContextTimeout(t.Context(), suite.TimeoutConfig.DefaultPollInterval, suite.TimeoutConfig.MaxTimeToConsistency, true,
+				func(ctx context.Context) (bool, error) {
+					pod, err := suite.TCPClient.EchoSendOnce(ctx, gwAddr, suite.TimeoutConfig.RequestTimeout)
+					if err != nil {
+						return true, nil
+					}
+					tlog.Logf(t, "new TCP connection still served by pod %s after deleting the TCPRoute; retrying", pod)
+					return false, nil
+				})
+			require.NoError(t, err, "new TCP connections were still served after deleting the TCPRoute")
+
+			tcp.ExpectSessionDrained(t, suite.TimeoutConfig, session)
+		})
+	},
+}
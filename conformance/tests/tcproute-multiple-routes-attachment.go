@@ -125,7 +125,7 @@ var TCPRouteMultipleRoutesAttachment = confsuite.ConformanceTest{
 			//
 			// First wait for the data plane to converge so the older route is
 			// consistently serving traffic.
-			tcp.MakeTCPRequestAndExpectEventuallyValidResponse(t, suite.TimeoutConfig, gwAddr, nil, "", false,
+			suite.TCPClient.MakeTCPRequestAndExpectEventuallyValidResponse(t, suite.TimeoutConfig, gwAddr, nil, "", false,
 				tcp.ExpectedResponse{
 					Backend:   "tcp-attach-backend-1",
 					Namespace: ns,
@@ -143,7 +143,7 @@ var TCPRouteMultipleRoutesAttachment = confsuite.ConformanceTest{
 			const newerBackendPrefix = "tcp-attach-backend-2-"
 
 			for i := range sampleCount {
-				pod, err := tcp.EchoSendOnce(t.Context(), gwAddr, perReqTimeout)
+				pod, err := suite.TCPClient.EchoSendOnce(t.Context(), gwAddr, perReqTimeout)
 				require.NoErrorf(t, err, "TCP echo request %d/%d failed", i+1, sampleCount)
 				require.Falsef(t, strings.HasPrefix(pod, newerBackendPrefix),
 					"request %d/%d reached newer route backend %q; only the oldest route should be attached", i+1, sampleCount, pod)
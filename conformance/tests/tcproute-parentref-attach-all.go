@@ -84,7 +84,7 @@ var TCPRouteParentRefAttachAll = confsuite.ConformanceTest{
 				if err != nil {
 					t.Fatalf("error getting gateway address for listener %q: %v", listener, err)
 				}
-				tcp.MakeTCPRequestAndExpectEventuallyValidResponse(t, suite.TimeoutConfig, gwAddr, nil, "", false,
+				suite.TCPClient.MakeTCPRequestAndExpectEventuallyValidResponse(t, suite.TimeoutConfig, gwAddr, nil, "", false,
 					tcp.ExpectedResponse{
 						Backend:   backend,
 						Namespace: ns,
@@ -60,7 +60,7 @@ var TCPRouteReferenceGrant = confsuite.ConformanceTest{
 			kubernetes.NewGatewayRef(gwNN, "tcp"), routeNN)
 
 		t.Run("TCP request should reach the cross-namespace backend", func(t *testing.T) {
-			tcp.MakeTCPRequestAndExpectEventuallyValidResponse(t, suite.TimeoutConfig, gwAddr, nil, "", false,
+			suite.TCPClient.MakeTCPRequestAndExpectEventuallyValidResponse(t, suite.TimeoutConfig, gwAddr, nil, "", false,
 				tcp.ExpectedResponse{
 					Backend:   "tcp-reference-grant-backend",
 					Namespace: confsuite.WebBackendNamespace,
@@ -23,7 +23,6 @@ import (
 
 	"sigs.k8s.io/gateway-api/conformance/utils/kubernetes"
 	confsuite "sigs.k8s.io/gateway-api/conformance/utils/suite"
-	"sigs.k8s.io/gateway-api/conformance/utils/tcp"
 	"sigs.k8s.io/gateway-api/conformance/utils/tlog"
 	"sigs.k8s.io/gateway-api/conformance/utils/weight"
 	"sigs.k8s.io/gateway-api/pkg/features"
@@ -61,21 +60,53 @@ var TCPRouteWeightedRouting = confsuite.ConformanceTest{
 				"tcp-backend-v3": 0.0,
 			}
 
-			tcp.ExpectAddressBeAvailable(t, suite.TimeoutConfig, gwAddr)
-			tcp.ExpectEchoResponse(t, suite.TimeoutConfig, gwAddr)
+			suite.TCPClient.ExpectAddressBeAvailable(t, suite.TimeoutConfig, gwAddr)
+			suite.TCPClient.ExpectEchoResponse(t, suite.TimeoutConfig, gwAddr)
 
 			sender := weight.NewFunctionBasedSender(func() (string, error) {
-				return tcp.EchoSendOnce(t.Context(), gwAddr, suite.TimeoutConfig.RequestTimeout)
+				return suite.TCPClient.EchoSendOnce(t.Context(), gwAddr, suite.TimeoutConfig.RequestTimeout)
 			})
 
 			for i := range weight.MaxTestRetries {
-				if err := weight.TestWeightedDistribution(sender, expectedWeights); err != nil {
+				distribution, err := weight.TestWeightedDistributionWithConfig(sender, expectedWeights, suite.WeightConfig)
+				if err != nil {
 					tlog.Logf(t, "TCP weighted distribution attempt %d/%d failed: %s", i+1, weight.MaxTestRetries, err)
 				} else {
+					tlog.Logf(t, "Traffic distribution: %s", distribution)
 					return
 				}
 			}
-			t.Fatal("TCP weighted distribution did not converge within tolerance")
+			t.Fatal("TCP weighted distribution did not follow the weights")
+		})
+
+		t.Run("TCP connections should be distributed across the weighted backends and keep their backend", func(t *testing.T) {
+			expectedWeights := map[string]float64{
+				"tcp-backend-v1": 0.7,
+				"tcp-backend-v2": 0.3,
+				"tcp-backend-v3": 0.0,
+			}
+
+			suite.TCPClient.ExpectEchoResponse(t, suite.TimeoutConfig, gwAddr)
+
+			// Every sample is a connection held open for several exchanges,
+			// all of which must be served by the same backend, so the
+			// distribution of connections is measured rather than the one of
+			// single exchanges.
+			const exchangesPerConnection = 5
+			sender := weight.NewFunctionBasedSender(func() (string, error) {
+				return suite.TCPClient.EchoSendSession(t.Context(), gwAddr, exchangesPerConnection, suite.TimeoutConfig.RequestTimeout)
+			})
+
+			for i := range weight.MaxTestRetries {
+				distribution, err := weight.TestWeightedDistributionWithConfig(sender, expectedWeights, suite.WeightConfig)
+				if err != nil {
+					tlog.Logf(t, "TCP per-connection weighted distribution attempt %d/%d failed: %s", i+1, weight.MaxTestRetries, err)
+				} else {
+					tlog.Logf(t, "Traffic distribution: %s", distribution)
+					return
+				}
+			}
+			t.Fatal("TCP per-connection weighted distribution did not follow the weights")
 		})
 	},
 }
@@ -65,7 +65,7 @@ var TLSRouteTerminateSimpleSameNamespace = confsuite.ConformanceTest{
 		}
 
 		t.Run("Simple TLS request matching terminated TLSRoute should reach tcp-backend with plain text", func(t *testing.T) {
-			tcp.MakeTCPRequestAndExpectEventuallyValidResponse(t, suite.TimeoutConfig, gwAddr, []byte(caString), serverStr, true,
+			suite.TCPClient.MakeTCPRequestAndExpectEventuallyValidResponse(t, suite.TimeoutConfig, gwAddr, []byte(caString), serverStr, true,
 				tcp.ExpectedResponse{
 					BackendIsTLS: false, // It is terminated on the gateway
 					Backend:      "tcp-backend",
@@ -0,0 +1,71 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tests
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	"sigs.k8s.io/gateway-api/conformance/utils/kubernetes"
+	confsuite "sigs.k8s.io/gateway-api/conformance/utils/suite"
+	"sigs.k8s.io/gateway-api/pkg/features"
+)
+
+func init() {
+	ConformanceTests = append(ConformanceTests, UDPRouteFlowAffinity)
+}
+
+var UDPRouteFlowAffinity = confsuite.ConformanceTest{
+	ShortName:   "UDPRouteFlowAffinity",
+	Description: "A UDPRoute with multiple backends should send all the datagrams of a UDP flow, identified by its 5-tuple, to the same backend endpoint.",
+	Manifests:   []string{"tests/udproute-flow-affinity.yaml"},
+	Features: []features.FeatureName{
+		features.SupportGateway,
+		features.SupportUDPRoute,
+		features.SupportUDPRouteFlowAffinity,
+	},
+	Provisional: true,
+	Test: func(t *testing.T, suite *confsuite.ConformanceTestSuite) {
+		ns := confsuite.InfrastructureNamespace
+		gwNN := types.NamespacedName{Name: "udp-flow-affinity-gateway", Namespace: ns}
+		routeNN := types.NamespacedName{Name: "udp-flow-affinity-route", Namespace: ns}
+
+		// The test creates an additional Gateway in the gateway-conformance-infra
+		// namespace so we have to wait for it to be ready.
+		kubernetes.NamespacesMustBeReady(t, suite.Client, suite.TimeoutConfig, []string{ns})
+
+		gwAddr := kubernetes.GatewayAndUDPRoutesMustBeAccepted(t, suite.Client, suite.TimeoutConfig, suite.ControllerName,
+			kubernetes.NewGatewayRef(gwNN, "udp"), routeNN)
+
+		t.Run("UDP datagrams of a flow should stick to a single backend", func(t *testing.T) {
+			const (
+				flows            = 20
+				datagramsPerFlow = 10
+			)
+
+			suite.UDPClient.ExpectEchoResponse(t, suite.TimeoutConfig, gwAddr)
+
+			pods := suite.UDPClient.ExpectFlowAffinity(t, suite.TimeoutConfig, gwAddr, flows, datagramsPerFlow)
+			for _, pod := range pods {
+				if backend := extractBackendName(pod); backend != "udp-flow-backend-v1" && backend != "udp-flow-backend-v2" {
+					t.Errorf("UDP flow was answered by pod %s of an unexpected backend %s", pod, backend)
+				}
+			}
+		})
+	},
+}
@@ -132,7 +132,7 @@ var UDPRouteMultipleRoutesAttachment = confsuite.ConformanceTest{
 			pollErr := wait.PollUntilContextTimeout(t.Context(), time.Second, suite.TimeoutConfig.DefaultTestTimeout, true,
 				func(ctx context.Context) (bool, error) {
 					for i := range probes {
-						pod, err := udpEchoSendOnce(ctx, gwAddr, probeTimeout)
+						pod, err := udpEchoSendOnce(ctx, suite.UDPClient, gwAddr, probeTimeout)
 						if err != nil {
 							tlog.Logf(t, "UDP probe %d failed, will retry: %v", i+1, err)
 							return false, nil
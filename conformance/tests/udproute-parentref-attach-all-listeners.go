@@ -126,7 +126,7 @@ var UDPRouteParentRefAttachAllListeners = confsuite.ConformanceTest{
 				if err != nil {
 					t.Fatalf("error getting gateway address for listener %q: %v", listener, err)
 				}
-				udp.ExpectEchoResponseFromBackend(t, suite.TimeoutConfig, gwAddr, udp.ExpectedResponse{
+				suite.UDPClient.ExpectEchoResponseFromBackend(t, suite.TimeoutConfig, gwAddr, udp.ExpectedResponse{
 					Service:   backend,
 					Namespace: ns,
 				})
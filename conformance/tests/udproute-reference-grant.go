@@ -27,7 +27,6 @@ import (
 	v1 "sigs.k8s.io/gateway-api/apis/v1"
 	"sigs.k8s.io/gateway-api/conformance/utils/kubernetes"
 	confsuite "sigs.k8s.io/gateway-api/conformance/utils/suite"
-	"sigs.k8s.io/gateway-api/conformance/utils/udp"
 	"sigs.k8s.io/gateway-api/pkg/features"
 )
 
@@ -66,7 +65,7 @@ var UDPRouteReferenceGrant = confsuite.ConformanceTest{
 		})
 
 		t.Run("UDP echo request reaches the cross-namespace backend while the ReferenceGrant exists", func(t *testing.T) {
-			udp.ExpectEchoResponse(t, suite.TimeoutConfig, gwAddr)
+			suite.UDPClient.ExpectEchoResponse(t, suite.TimeoutConfig, gwAddr)
 		})
 
 		ctx, cancel := context.WithTimeout(context.Background(), suite.TimeoutConfig.DeleteTimeout)
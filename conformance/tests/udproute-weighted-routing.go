@@ -71,29 +71,36 @@ var UDPRouteWeightedRouting = confsuite.ConformanceTest{
 				"udp-backend-v3": 0.0,
 			}
 
-			udp.ExpectEchoResponse(t, suite.TimeoutConfig, gwAddr)
+			suite.UDPClient.ExpectEchoResponse(t, suite.TimeoutConfig, gwAddr)
 
 			sender := weight.NewFunctionBasedSender(func() (string, error) {
-				return udpEchoSendOnce(t.Context(), gwAddr, 2*time.Second)
+				return udpEchoSendOnce(t.Context(), suite.UDPClient, gwAddr, 2*time.Second)
 			})
 
 			for i := range weight.MaxTestRetries {
-				if err := weight.TestWeightedDistribution(sender, expectedWeights); err != nil {
+				distribution, err := weight.TestWeightedDistributionWithConfig(sender, expectedWeights, suite.WeightConfig)
+				if err != nil {
 					tlog.Logf(t, "UDP weighted distribution attempt %d/%d failed: %s", i+1, weight.MaxTestRetries, err)
 				} else {
+					tlog.Logf(t, "Traffic distribution: %s", distribution)
 					return
 				}
 			}
-			t.Fatal("UDP weighted distribution did not converge within tolerance")
+			t.Fatal("UDP weighted distribution did not follow the weights")
 		})
 	},
 }
 
-// udpEchoSendOnce sends a single UDP datagram to gwAddr and returns the pod
-// name from the JSON envelope returned by the udpechoserver.
-func udpEchoSendOnce(ctx context.Context, gwAddr string, timeout time.Duration) (string, error) {
-	var dialer net.Dialer
-	conn, err := dialer.DialContext(ctx, "udp", gwAddr)
+// udpEchoSendOnce sends a single UDP datagram to gwAddr, dialing through
+// client, and returns the pod name from the JSON envelope returned by the
+// udpechoserver.
+func udpEchoSendOnce(ctx context.Context, client udp.Client, gwAddr string, timeout time.Duration) (string, error) {
+	dialContext := client.DialContext
+	if dialContext == nil {
+		var dialer net.Dialer
+		dialContext = dialer.DialContext
+	}
+	conn, err := dialContext(ctx, "udp", gwAddr)
 	if err != nil {
 		return "", fmt.Errorf("dialing UDP %s: %w", gwAddr, err)
 	}
@@ -0,0 +1,77 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import "time"
+
+// BenchmarkConfig configures the scale and propagation-latency benchmark,
+// which measures how fast an implementation reconciles and programs a large
+// number of HTTPRoutes attached to a single Gateway.
+type BenchmarkConfig struct {
+	// Routes is the number of HTTPRoutes created by the benchmark.
+	Routes int `json:"routes"`
+
+	// Namespaces is the number of namespaces the HTTPRoutes are spread
+	// across.
+	Namespaces int `json:"namespaces"`
+
+	// ConcurrentRequests is the maximum number of HTTPRoutes created, and of
+	// requests in flight, at the same time.
+	ConcurrentRequests int `json:"concurrentRequests"`
+
+	// Samples is the number of HTTPRoutes that are updated, then deleted, to
+	// measure the update and deletion latencies. Every sample is measured on
+	// its own so that they don't interfere with each other.
+	Samples int `json:"samples"`
+
+	// Timeout is the maximum time for all the HTTPRoutes to be accepted and
+	// to serve their first successful request after they are created. The
+	// HTTPRoutes that don't within Timeout are reported as failures.
+	Timeout time.Duration `json:"timeout"`
+}
+
+// DefaultBenchmarkConfig populates a BenchmarkConfig with the default values.
+func DefaultBenchmarkConfig() BenchmarkConfig {
+	return BenchmarkConfig{
+		Routes:             100,
+		Namespaces:         10,
+		ConcurrentRequests: 10,
+		Samples:            10,
+		Timeout:            5 * time.Minute,
+	}
+}
+
+// SetupBenchmarkConfig sets the unset values of benchmarkConfig to their
+// defaults.
+func SetupBenchmarkConfig(benchmarkConfig *BenchmarkConfig) {
+	defaultBenchmarkConfig := DefaultBenchmarkConfig()
+	if benchmarkConfig.Routes == 0 {
+		benchmarkConfig.Routes = defaultBenchmarkConfig.Routes
+	}
+	if benchmarkConfig.Namespaces == 0 {
+		benchmarkConfig.Namespaces = defaultBenchmarkConfig.Namespaces
+	}
+	if benchmarkConfig.ConcurrentRequests == 0 {
+		benchmarkConfig.ConcurrentRequests = defaultBenchmarkConfig.ConcurrentRequests
+	}
+	if benchmarkConfig.Samples == 0 {
+		benchmarkConfig.Samples = defaultBenchmarkConfig.Samples
+	}
+	if benchmarkConfig.Timeout == 0 {
+		benchmarkConfig.Timeout = defaultBenchmarkConfig.Timeout
+	}
+}
@@ -0,0 +1,59 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+// WeightConfig configures how the distribution of traffic across weighted
+// backends is measured and verified.
+type WeightConfig struct {
+	// TotalRequests is the number of requests sent to measure a
+	// distribution. The expected number of requests of every backend with a
+	// non-zero weight should be at least 5 for the verification to be sound.
+	TotalRequests int `json:"totalRequests"`
+
+	// ConcurrentRequests is the maximum number of requests in flight while
+	// measuring a distribution. It does not apply to batch senders.
+	ConcurrentRequests int `json:"concurrentRequests"`
+
+	// FalseFailureRate is the probability of a distribution that follows the
+	// weights to be rejected, i.e. the significance level of the chi-squared
+	// goodness of fit test verifying the distribution. Lowering it makes the
+	// verification more tolerant.
+	FalseFailureRate float64 `json:"falseFailureRate"`
+}
+
+// DefaultWeightConfig populates a WeightConfig with the default values.
+func DefaultWeightConfig() WeightConfig {
+	return WeightConfig{
+		TotalRequests:      500,
+		ConcurrentRequests: 10,
+		FalseFailureRate:   0.001,
+	}
+}
+
+// SetupWeightConfig sets the unset values of weightConfig to their defaults.
+func SetupWeightConfig(weightConfig *WeightConfig) {
+	defaultWeightConfig := DefaultWeightConfig()
+	if weightConfig.TotalRequests == 0 {
+		weightConfig.TotalRequests = defaultWeightConfig.TotalRequests
+	}
+	if weightConfig.ConcurrentRequests == 0 {
+		weightConfig.ConcurrentRequests = defaultWeightConfig.ConcurrentRequests
+	}
+	if weightConfig.FalseFailureRate == 0 {
+		weightConfig.FalseFailureRate = defaultWeightConfig.FalseFailureRate
+	}
+}
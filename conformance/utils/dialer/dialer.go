@@ -0,0 +1,125 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package dialer contains dialers reaching Gateways through the Kubernetes API
+// server, for clusters where the addresses of Gateways are not reachable from
+// where the conformance tests run, e.g. kind clusters on macOS or CI runners
+// without routes to load balancers. Their DialContext methods can be plugged
+// into the HTTP RoundTripper, the gRPC Client, the WebSocket Dialer and the TCP
+// and UDP clients of the conformance tests.
+package dialer
+
+import (
+	"errors"
+	"io"
+	"net"
+	"sync"
+)
+
+// streamConn is a net.Conn over a stream opened through the API server, such
+// as a port-forward stream or the standard input and output of a command. The
+// stream is copied to and from one end of a net.Pipe so that the connection
+// supports deadlines, which the streams don't.
+type streamConn struct {
+	net.Conn
+
+	remoteAddr net.Addr
+
+	// closeStream releases the stream once the connection is closed.
+	closeStream func() error
+	closeOnce   sync.Once
+
+	// mu guards err, the error that ended the stream, if any.
+	mu  sync.Mutex
+	err error
+}
+
+// newStreamConn returns a connection to remoteAddr whose reads come from r and
+// whose writes go to w. w is closed once the connection is closed for writing,
+// and closeStream is called once the connection is closed.
+func newStreamConn(r io.Reader, w io.WriteCloser, closeStream func() error, remoteAddr net.Addr) *streamConn {
+	local, peer := net.Pipe()
+	c := &streamConn{
+		Conn:        local,
+		remoteAddr:  remoteAddr,
+		closeStream: closeStream,
+	}
+
+	go func() {
+		_, _ = io.Copy(w, peer)
+		_ = w.Close()
+	}()
+	go func() {
+		_, err := io.Copy(peer, r)
+		if err != nil {
+			c.setError(err)
+		}
+		_ = peer.Close()
+	}()
+
+	return c
+}
+
+// setError records the error that ended the stream, so that reads return it
+// instead of io.EOF.
+func (c *streamConn) setError(err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.err == nil {
+		c.err = err
+	}
+}
+
+func (c *streamConn) Read(b []byte) (int, error) {
+	n, err := c.Conn.Read(b)
+	if errors.Is(err, io.EOF) {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		if c.err != nil {
+			return n, c.err
+		}
+	}
+	return n, err
+}
+
+func (c *streamConn) Close() error {
+	err := c.Conn.Close()
+	c.closeOnce.Do(func() {
+		if closeErr := c.closeStream(); closeErr != nil && err == nil {
+			err = closeErr
+		}
+	})
+	return err
+}
+
+func (c *streamConn) RemoteAddr() net.Addr {
+	return c.remoteAddr
+}
+
+// addr is the net.Addr of the remote end of a connection made through the API
+// server.
+type addr struct {
+	network string
+	address string
+}
+
+func (a addr) Network() string {
+	return a.network
+}
+
+func (a addr) String() string {
+	return a.address
+}
@@ -0,0 +1,232 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dialer
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+func TestStreamConn(t *testing.T) {
+	// remote is the other end of the stream, echoing every line back
+	remoteReader, streamWriter := io.Pipe()
+	streamReader, remoteWriter := io.Pipe()
+	go func() {
+		scanner := bufio.NewScanner(remoteReader)
+		for scanner.Scan() {
+			if _, err := remoteWriter.Write(append(scanner.Bytes(), '\n')); err != nil {
+				return
+			}
+		}
+		remoteWriter.CloseWithError(errors.New("stream ended"))
+	}()
+
+	closed := make(chan struct{})
+	conn := newStreamConn(streamReader, streamWriter, func() error {
+		close(closed)
+		return nil
+	}, addr{network: "tcp", address: "192.0.2.1:80"})
+
+	assert.Equal(t, "192.0.2.1:80", conn.RemoteAddr().String())
+	assert.Equal(t, "tcp", conn.RemoteAddr().Network())
+
+	_, err := conn.Write([]byte("PING\n"))
+	require.NoError(t, err)
+	line, err := bufio.NewReader(conn).ReadString('\n')
+	require.NoError(t, err)
+	assert.Equal(t, "PING\n", line)
+
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(10*time.Millisecond)))
+	_, err = conn.Read(make([]byte, 1))
+	require.ErrorIs(t, err, os.ErrDeadlineExceeded)
+	require.NoError(t, conn.SetReadDeadline(time.Time{}))
+
+	// closing the stream for writing ends the remote, whose error is
+	// returned by reads instead of io.EOF
+	streamWriter.Close()
+	_, err = io.ReadAll(conn)
+	require.EqualError(t, err, "stream ended")
+
+	require.NoError(t, conn.Close())
+	<-closed
+}
+
+func TestSocatCommand(t *testing.T) {
+	testCases := []struct {
+		name     string
+		network  string
+		address  string
+		expected []string
+		err      string
+	}{
+		{
+			name:     "tcp",
+			network:  "tcp",
+			address:  "192.0.2.1:80",
+			expected: []string{"socat", "-", "TCP:192.0.2.1:80"},
+		},
+		{
+			name:     "udp over ipv6",
+			network:  "udp6",
+			address:  "[2001:db8::1]:53",
+			expected: []string{"socat", "-", "UDP6:[2001:db8::1]:53"},
+		},
+		{
+			name:    "unsupported network",
+			network: "unix",
+			address: "/tmp/socket:0",
+			err:     `exec dialer does not support network "unix"`,
+		},
+		{
+			name:    "missing port",
+			network: "tcp",
+			address: "192.0.2.1",
+			err:     "address 192.0.2.1: missing port in address",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			command, err := SocatCommand(tc.network, tc.address)
+			if tc.err != "" {
+				require.EqualError(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, command)
+		})
+	}
+}
+
+func TestPortForwardDialerResolve(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, gatewayv1.Install(scheme))
+
+	pod := func(namespace, name string, labels map[string]string, ready bool) *corev1.Pod {
+		status := corev1.ConditionFalse
+		if ready {
+			status = corev1.ConditionTrue
+		}
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, Labels: labels},
+			Spec: corev1.PodSpec{Containers: []corev1.Container{{
+				Name:  "proxy",
+				Ports: []corev1.ContainerPort{{Name: "http", ContainerPort: 8080}},
+			}}},
+			Status: corev1.PodStatus{
+				Phase:      corev1.PodRunning,
+				Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: status}},
+			},
+		}
+	}
+	objects := []runtime.Object{
+		&corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: "proxy", Namespace: "gateway-system"},
+			Spec: corev1.ServiceSpec{
+				Selector: map[string]string{"app": "proxy"},
+				Ports: []corev1.ServicePort{
+					{Port: 80, TargetPort: intstr.FromString("http")},
+					{Port: 443, TargetPort: intstr.FromInt32(8443)},
+					{Port: 8000},
+				},
+			},
+			Status: corev1.ServiceStatus{LoadBalancer: corev1.LoadBalancerStatus{
+				Ingress: []corev1.LoadBalancerIngress{{IP: "192.0.2.1"}},
+			}},
+		},
+		pod("gateway-system", "proxy-ready", map[string]string{"app": "proxy"}, true),
+		pod("gateway-system", "proxy-not-ready", map[string]string{"app": "proxy"}, false),
+		&gatewayv1.Gateway{
+			ObjectMeta: metav1.ObjectMeta{Name: "same-namespace", Namespace: "gateway-conformance-infra"},
+			Status: gatewayv1.GatewayStatus{Addresses: []gatewayv1.GatewayStatusAddress{
+				{Value: "192.0.2.2"},
+			}},
+		},
+		pod("gateway-conformance-infra", "same-namespace-proxy", map[string]string{GatewayNameLabel: "same-namespace"}, true),
+	}
+
+	d := &PortForwardDialer{
+		Client: fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(objects...).Build(),
+	}
+
+	testCases := []struct {
+		name        string
+		address     string
+		expectedPod string
+		expected    int32
+		err         string
+	}{
+		{
+			name:        "named target port of a Service",
+			address:     "192.0.2.1:80",
+			expectedPod: "proxy-ready",
+			expected:    8080,
+		},
+		{
+			name:        "numbered target port of a Service",
+			address:     "192.0.2.1:443",
+			expectedPod: "proxy-ready",
+			expected:    8443,
+		},
+		{
+			name:        "Service port without target port",
+			address:     "192.0.2.1:8000",
+			expectedPod: "proxy-ready",
+			expected:    8000,
+		},
+		{
+			name:        "pods labeled with the name of the Gateway",
+			address:     "192.0.2.2:80",
+			expectedPod: "same-namespace-proxy",
+			expected:    80,
+		},
+		{
+			name:    "unknown address",
+			address: "192.0.2.3:80",
+			err:     "no Service or Gateway exposes address 192.0.2.3:80",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			pod, port, err := d.resolve(t.Context(), tc.address)
+			if tc.err != "" {
+				require.EqualError(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedPod, pod.Name)
+			assert.Equal(t, tc.expected, port)
+		})
+	}
+}
@@ -0,0 +1,151 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dialer
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+	clientset "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/remotecommand"
+)
+
+// ExecDialer reaches Gateway addresses from inside the cluster, by running a
+// client in a helper pod through the API server and using its standard input
+// and output as the connection. By default the client is socat, which the
+// helper pod must provide.
+//
+// Both TCP and UDP are supported. For UDP, every write is sent as a datagram,
+// but datagrams received may be merged or split when read.
+type ExecDialer struct {
+	Clientset  clientset.Interface
+	RestConfig *rest.Config
+
+	// Namespace and Pod are the namespace and name of the helper pod.
+	Namespace string
+	Pod       string
+	// Container is the container of the helper pod to run the client in. It
+	// can be left empty if the pod has a single container.
+	Container string
+	// Command returns the command connecting its standard input and output to
+	// address over network. SocatCommand is used when nil.
+	Command func(network, address string) ([]string, error)
+}
+
+// SocatCommand returns the socat command connecting its standard input and
+// output to address over network.
+func SocatCommand(network, address string) ([]string, error) {
+	host, port, err := net.SplitHostPort(address)
+	if err != nil {
+		return nil, err
+	}
+	if strings.Contains(host, ":") {
+		host = "[" + host + "]"
+	}
+
+	switch network {
+	case "tcp", "tcp4", "tcp6", "udp", "udp4", "udp6":
+		return []string{"socat", "-", fmt.Sprintf("%s:%s:%s", strings.ToUpper(network), host, port)}, nil
+	default:
+		return nil, fmt.Errorf("exec dialer does not support network %q", network)
+	}
+}
+
+// DialContext runs a client connected to address in the helper pod. As the
+// client is started asynchronously, failing to connect to address surfaces as
+// an error when reading from the returned connection.
+func (d *ExecDialer) DialContext(ctx context.Context, network, address string) (net.Conn, error) {
+	command := d.Command
+	if command == nil {
+		command = SocatCommand
+	}
+	args, err := command(network, address)
+	if err != nil {
+		return nil, err
+	}
+
+	req := d.Clientset.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Namespace(d.Namespace).
+		Name(d.Pod).
+		SubResource("exec").
+		VersionedParams(&corev1.PodExecOptions{
+			Container: d.Container,
+			Command:   args,
+			Stdin:     true,
+			Stdout:    true,
+			Stderr:    true,
+		}, scheme.ParameterCodec)
+	exec, err := remotecommand.NewSPDYExecutor(d.RestConfig, "POST", req.URL())
+	if err != nil {
+		return nil, err
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	stdinReader, stdinWriter := io.Pipe()
+	stdoutReader, stdoutWriter := io.Pipe()
+	// the client runs for as long as the connection is open, which outlives
+	// the context of the dial
+	streamCtx, cancel := context.WithCancel(context.Background())
+	go func() {
+		stderr := &lockedBuffer{}
+		err := exec.StreamWithContext(streamCtx, remotecommand.StreamOptions{
+			Stdin:  stdinReader,
+			Stdout: stdoutWriter,
+			Stderr: stderr,
+		})
+		if err != nil {
+			err = fmt.Errorf("error running %q in pod %s/%s: %w: %s", strings.Join(args, " "), d.Namespace, d.Pod, err, stderr.String())
+		}
+		stdoutWriter.CloseWithError(err)
+	}()
+
+	closeStream := func() error {
+		cancel()
+		return stdinReader.Close()
+	}
+	return newStreamConn(stdoutReader, stdinWriter, closeStream, addr{network: network, address: address}), nil
+}
+
+// lockedBuffer is a bytes.Buffer safe for concurrent use, as the standard error
+// of the client is written while the connection is in use.
+type lockedBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *lockedBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *lockedBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
@@ -0,0 +1,250 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dialer
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"sync/atomic"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	clientset "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/portforward"
+	"k8s.io/client-go/transport/spdy"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// GatewayNameLabel is the label that implementations set on the resources
+// they deploy for a Gateway, with the name of the Gateway as value.
+const GatewayNameLabel = "gateway.networking.k8s.io/gateway-name"
+
+// PortForwardDialer reaches Gateway addresses by port-forwarding, through the
+// API server, to the data-plane pods serving them. The pods of an address are
+// those selected by the Service exposing it, with the Service target port, or
+// when no Service with a selector exposes it, the pods labeled with the name of
+// the Gateway that has it among its status addresses, with the same port.
+//
+// Only TCP is supported, so UDP needs the ExecDialer instead.
+type PortForwardDialer struct {
+	Client     client.Client
+	Clientset  clientset.Interface
+	RestConfig *rest.Config
+
+	// next is used to spread connections over the ready pods of an address.
+	next atomic.Uint64
+}
+
+// DialContext port-forwards to a data-plane pod serving address.
+func (d *PortForwardDialer) DialContext(ctx context.Context, network, address string) (net.Conn, error) {
+	if network != "tcp" && network != "tcp4" && network != "tcp6" {
+		return nil, fmt.Errorf("port-forward dialer does not support network %q", network)
+	}
+
+	pod, port, err := d.resolve(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+	return d.forward(pod, port, addr{network: network, address: address})
+}
+
+// resolve returns a ready data-plane pod serving address, and the port to
+// forward to on that pod. Several Services can share an address, e.g. load
+// balancers sharing an IP, so the Service exposing the port of address is
+// looked for.
+func (d *PortForwardDialer) resolve(ctx context.Context, address string) (*corev1.Pod, int32, error) {
+	host, portStr, err := net.SplitHostPort(address)
+	if err != nil {
+		return nil, 0, err
+	}
+	port, err := strconv.ParseInt(portStr, 10, 32)
+	if err != nil {
+		return nil, 0, fmt.Errorf("invalid port in address %s: %w", address, err)
+	}
+
+	services := &corev1.ServiceList{}
+	if err := d.Client.List(ctx, services); err != nil {
+		return nil, 0, fmt.Errorf("error listing Services: %w", err)
+	}
+	for _, svc := range services.Items {
+		if len(svc.Spec.Selector) == 0 || !serviceExposes(svc, host) {
+			continue
+		}
+		for _, svcPort := range svc.Spec.Ports {
+			if svcPort.Port != int32(port) || (svcPort.Protocol != "" && svcPort.Protocol != corev1.ProtocolTCP) {
+				continue
+			}
+			pod, err := d.readyPod(ctx, svc.Namespace, labels.SelectorFromSet(svc.Spec.Selector))
+			if err != nil {
+				return nil, 0, fmt.Errorf("error finding pods of Service %s/%s: %w", svc.Namespace, svc.Name, err)
+			}
+			targetPort, err := podTargetPort(pod, svcPort)
+			if err != nil {
+				return nil, 0, err
+			}
+			return pod, targetPort, nil
+		}
+	}
+
+	gateways := &gatewayv1.GatewayList{}
+	if err := d.Client.List(ctx, gateways); err != nil {
+		return nil, 0, fmt.Errorf("error listing Gateways: %w", err)
+	}
+	for _, gw := range gateways.Items {
+		for _, gwAddr := range gw.Status.Addresses {
+			if gwAddr.Value != host {
+				continue
+			}
+			pod, err := d.readyPod(ctx, gw.Namespace, labels.SelectorFromSet(labels.Set{GatewayNameLabel: gw.Name}))
+			if err != nil {
+				return nil, 0, fmt.Errorf("error finding pods of Gateway %s/%s: %w", gw.Namespace, gw.Name, err)
+			}
+			return pod, int32(port), nil
+		}
+	}
+
+	return nil, 0, fmt.Errorf("no Service or Gateway exposes address %s", address)
+}
+
+// readyPod returns one of the ready pods matching selector in namespace,
+// rotating over them across calls.
+func (d *PortForwardDialer) readyPod(ctx context.Context, namespace string, selector labels.Selector) (*corev1.Pod, error) {
+	pods := &corev1.PodList{}
+	if err := d.Client.List(ctx, pods, client.InNamespace(namespace), client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return nil, err
+	}
+
+	var ready []*corev1.Pod
+	for i := range pods.Items {
+		if podReady(&pods.Items[i]) {
+			ready = append(ready, &pods.Items[i])
+		}
+	}
+	if len(ready) == 0 {
+		return nil, fmt.Errorf("no ready pod matches %q", selector)
+	}
+	return ready[(d.next.Add(1)-1)%uint64(len(ready))], nil
+}
+
+// forward opens a port-forward stream to port on pod.
+func (d *PortForwardDialer) forward(pod *corev1.Pod, port int32, remoteAddr net.Addr) (net.Conn, error) {
+	transport, upgrader, err := spdy.RoundTripperFor(d.RestConfig)
+	if err != nil {
+		return nil, err
+	}
+	req := d.Clientset.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Namespace(pod.Namespace).
+		Name(pod.Name).
+		SubResource("portforward")
+	dialer := spdy.NewDialer(upgrader, &http.Client{Transport: transport}, http.MethodPost, req.URL())
+
+	conn, _, err := dialer.Dial(portforward.PortForwardProtocolV1Name)
+	if err != nil {
+		return nil, fmt.Errorf("error port-forwarding to pod %s/%s: %w", pod.Namespace, pod.Name, err)
+	}
+
+	headers := http.Header{}
+	headers.Set(corev1.StreamType, corev1.StreamTypeError)
+	headers.Set(corev1.PortHeader, strconv.Itoa(int(port)))
+	headers.Set(corev1.PortForwardRequestIDHeader, "0")
+	errorStream, err := conn.CreateStream(headers)
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("error creating port-forward error stream: %w", err)
+	}
+	// the error stream is only read from
+	errorStream.Close()
+
+	headers.Set(corev1.StreamType, corev1.StreamTypeData)
+	dataStream, err := conn.CreateStream(headers)
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("error creating port-forward data stream: %w", err)
+	}
+
+	c := newStreamConn(dataStream, dataStream, conn.Close, remoteAddr)
+	go func() {
+		message, err := io.ReadAll(errorStream)
+		if err == nil && len(message) > 0 {
+			c.setError(fmt.Errorf("error port-forwarding to port %d of pod %s/%s: %s", port, pod.Namespace, pod.Name, message))
+			conn.Close()
+		}
+	}()
+	return c, nil
+}
+
+// serviceExposes returns whether host is one of the addresses of svc.
+func serviceExposes(svc corev1.Service, host string) bool {
+	if svc.Spec.ClusterIP == host {
+		return true
+	}
+	for _, ip := range svc.Spec.ClusterIPs {
+		if ip == host {
+			return true
+		}
+	}
+	for _, ip := range svc.Spec.ExternalIPs {
+		if ip == host {
+			return true
+		}
+	}
+	for _, ingress := range svc.Status.LoadBalancer.Ingress {
+		if ingress.IP == host || ingress.Hostname == host {
+			return true
+		}
+	}
+	return false
+}
+
+// podTargetPort returns the port of pod that svcPort targets.
+func podTargetPort(pod *corev1.Pod, svcPort corev1.ServicePort) (int32, error) {
+	switch {
+	case svcPort.TargetPort.IntVal != 0:
+		return svcPort.TargetPort.IntVal, nil
+	case svcPort.TargetPort.StrVal == "":
+		return svcPort.Port, nil
+	}
+	for _, container := range pod.Spec.Containers {
+		for _, containerPort := range container.Ports {
+			if containerPort.Name == svcPort.TargetPort.StrVal {
+				return containerPort.ContainerPort, nil
+			}
+		}
+	}
+	return 0, fmt.Errorf("pod %s/%s has no port named %s", pod.Namespace, pod.Name, svcPort.TargetPort.StrVal)
+}
+
+// podReady returns whether pod is running, ready and not being deleted.
+func podReady(pod *corev1.Pod) bool {
+	if pod.DeletionTimestamp != nil || pod.Status.Phase != corev1.PodRunning {
+		return false
+	}
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == corev1.PodReady {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
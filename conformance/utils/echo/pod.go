@@ -116,6 +116,11 @@ func makeRequestWithCount(t *testing.T, exp *http.ExpectedResponse, count int) [
 	for k, v := range r.Headers {
 		args = append(args, "-H", fmt.Sprintf("%v:%v", k, v))
 	}
+	for k, values := range r.MultiValueHeaders {
+		for _, v := range values {
+			args = append(args, "-H", fmt.Sprintf("%v:%v", k, v))
+		}
+	}
 	if count > 0 {
 		args = append(args, fmt.Sprintf("--count=%d", count))
 	}
@@ -173,6 +178,11 @@ func compareRequest(exp http.ExpectedResponse, resp Response) error {
 			}
 		}
 	}
+	for name, expectedVals := range wantReq.MultiValueHeaders {
+		if actualVals := resp.RequestHeaders.Values(name); !slices.Equal(actualVals, expectedVals) {
+			return fmt.Errorf("expected %s header to have values %q, got %q", name, expectedVals, actualVals)
+		}
+	}
 	if len(wantReq.AbsentHeaders) > 0 {
 		for name, val := range resp.RequestHeaders {
 			resp.RequestHeaders[strings.ToLower(name)] = val
@@ -62,6 +62,14 @@ func registerBoolFlag(flagName string, defaultValue bool, usage string, apply fu
 	}
 }
 
+// registerIntFlag registers the override function for an integer flag.
+func registerIntFlag(flagName string, defaultValue int, usage string, apply func(*suite.ConfigurableOptions, int)) {
+	p := flag.Int(flagName, defaultValue, usage)
+	registry[flagName] = &flagSpec{
+		apply: func(o *suite.ConfigurableOptions) { apply(o, *p) },
+	}
+}
+
 var ConformanceOptionsFile = flag.String("conformance-options-file", "", "Path to a YAML file containing the conformance options. Command line flags will override the values in the file.")
 
 func init() {
@@ -103,12 +111,53 @@ func init() {
 	registerStringFlag("run-test", "", "Name of a single test to run, instead of the whole suite",
 		func(o *suite.ConfigurableOptions, v string) { o.RunTest = v },
 	)
+	registerStringFlag("run-tests-matching", "", "Regular expression that the names of the tests to run must match",
+		func(o *suite.ConfigurableOptions, v string) { o.RunTestsMatching = v },
+	)
+	registerStringFlag("feature-expression", "", "Boolean expression over feature names that the features of the tests to run must satisfy, e.g. 'HTTPRoute && !HTTPRouteCORS'",
+		func(o *suite.ConfigurableOptions, v string) { o.FeatureExpression = v },
+	)
+	registerStringFlag("select-profiles", "", "Comma-separated list of conformance profiles the tests to run must belong to",
+		func(o *suite.ConfigurableOptions, v string) {
+			o.SelectProfiles = suite.ParseConformanceProfilesSlice(v)
+		},
+	)
+	registerStringFlag("debug-bundle-dir", "", "Directory where to write a debug bundle for each failed test",
+		func(o *suite.ConfigurableOptions, v string) { o.DebugBundleDir = v },
+	)
+	registerBoolFlag("list", false, "Whether to only list which tests would be run or skipped and why, without contacting the cluster",
+		func(o *suite.ConfigurableOptions, v bool) { o.ListTests = v },
+	)
+	registerIntFlag("repeat", 1, "Number of times to run each selected test, reporting the pass rate of each test and flagging unstable ones",
+		func(o *suite.ConfigurableOptions, v int) { o.Repeat = v },
+	)
+	registerBoolFlag("benchmark", false, "Whether to run the scale and propagation-latency benchmark with TestBenchmark",
+		func(o *suite.ConfigurableOptions, v bool) { o.Benchmark = v },
+	)
+	registerIntFlag("benchmark-routes", conformanceconfig.DefaultBenchmarkConfig().Routes, "Number of HTTPRoutes created by the benchmark",
+		func(o *suite.ConfigurableOptions, v int) { o.BenchmarkConfig.Routes = v },
+	)
+	registerIntFlag("benchmark-namespaces", conformanceconfig.DefaultBenchmarkConfig().Namespaces, "Number of namespaces the HTTPRoutes created by the benchmark are spread across",
+		func(o *suite.ConfigurableOptions, v int) { o.BenchmarkConfig.Namespaces = v },
+	)
+	registerStringFlag("benchmark-report-output", "", "The file where to write the benchmark report",
+		func(o *suite.ConfigurableOptions, v string) { o.BenchmarkReportOutputPath = v },
+	)
 	registerStringFlag("mode", DefaultMode, "The operating mode of the implementation.",
 		func(o *suite.ConfigurableOptions, v string) { o.Mode = v },
 	)
 	registerBoolFlag("allow-crds-mismatch", false, "Flag to allow the suite not to fail in case there is a mismatch between CRDs versions and channels.",
 		func(o *suite.ConfigurableOptions, v bool) { o.AllowCRDsMismatch = v },
 	)
+	registerBoolFlag("crd-version-skew", false, "Whether to run the suite against the installed CRDs regardless of their bundle version and channel, skipping the tests whose manifests use resources or fields absent from them",
+		func(o *suite.ConfigurableOptions, v bool) { o.CRDVersionSkew = v },
+	)
+	registerStringFlag("gateway-dialer", "", "How to reach Gateway addresses: direct (the default), port-forward to the pods serving them, or exec a client in the pod given by --gateway-dialer-exec-pod",
+		func(o *suite.ConfigurableOptions, v string) { o.GatewayDialer = v },
+	)
+	registerStringFlag("gateway-dialer-exec-pod", "", "Helper pod, as namespace/name, running socat to reach Gateway addresses with the exec Gateway dialer",
+		func(o *suite.ConfigurableOptions, v string) { o.GatewayDialerExecPod = v },
+	)
 	registerStringFlag("conformance-profiles", "", "Comma-separated list of the conformance profiles to run",
 		func(o *suite.ConfigurableOptions, v string) {
 			o.ConformanceProfiles = suite.ParseConformanceProfilesSlice(v)
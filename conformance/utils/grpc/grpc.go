@@ -19,6 +19,7 @@ package grpc
 import (
 	"context"
 	"fmt"
+	"net"
 	"sort"
 	"strings"
 	"sync"
@@ -63,6 +64,10 @@ type DefaultClient struct {
 	// instead of short-circuiting onto an already-closed connection).
 	mu   sync.Mutex
 	Conn *grpc.ClientConn
+
+	// DialContext, if set, is used to open the connections to the Gateway
+	// instead of dialing its address directly.
+	DialContext func(ctx context.Context, network, address string) (net.Conn, error)
 }
 
 type Response struct {
@@ -170,8 +175,16 @@ func (c *DefaultClient) ensureConnection(address string, req *RequestMetadata) (
 	if req != nil && req.Authority != "" {
 		dialOpts = append(dialOpts, grpc.WithAuthority(req.Authority))
 	}
+	target := address
+	if c.DialContext != nil {
+		dialOpts = append(dialOpts, grpc.WithContextDialer(func(ctx context.Context, addr string) (net.Conn, error) {
+			return c.DialContext(ctx, "tcp", addr)
+		}))
+		// hand the address over to the dialer as is, without resolving it
+		target = "passthrough:///" + address
+	}
 
-	conn, err := grpc.NewClient(address, dialOpts...)
+	conn, err := grpc.NewClient(target, dialOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -79,6 +79,19 @@ type Request struct {
 	Body             string
 	SNI              string
 	ClientCert       string
+	// MultiValueHeaders are headers with several values, each value being
+	// sent on its own header line, in order. When verifying the request
+	// received by the echoserver, the header must have exactly these values,
+	// in this order.
+	MultiValueHeaders map[string][]string
+	// Trailers are sent after the body, which is then chunked. Multiple
+	// values can be provided, as a comma-separated value. They are not
+	// verified on the request received by the echoserver, which doesn't
+	// report request trailers.
+	Trailers map[string]string
+	// BodyChunks is sent, instead of Body, as a chunked body, each element
+	// being written as its own chunk.
+	BodyChunks []string
 }
 
 const requestIDQueryParam = "gateway-api-conformance-request-id"
@@ -146,6 +159,18 @@ type Response struct {
 	// IgnoreWhitespace will cause whitespace to be ignored when comparing the response
 	// header values.
 	IgnoreWhitespace bool
+	// MultiValueHeaders are headers expected to have exactly these values, in
+	// this order.
+	MultiValueHeaders map[string][]string
+	// Trailers are the trailers expected after the response body. Multiple
+	// values can be provided, as a comma-separated value.
+	Trailers map[string]string
+	// Body, if set, is the exact response body expected, whatever the status
+	// code.
+	Body string
+	// BodyContains, if set, is a string the response body is expected to
+	// contain, whatever the status code.
+	BodyContains string
 }
 
 type BackendRef struct {
@@ -223,6 +248,12 @@ func MakeRequest(t *testing.T, expected *ExpectedResponse, gwAddr, protocol, sch
 			req.Headers[name] = []string{value}
 		}
 	}
+	for name, values := range expected.Request.MultiValueHeaders {
+		req.Headers[name] = append(req.Headers[name], values...)
+	}
+
+	req.Trailers = expected.Request.Trailers
+	req.BodyChunks = expected.Request.BodyChunks
 
 	backendSetHeaders := []string{}
 	for name, val := range expected.BackendSetResponseHeaders {
@@ -364,6 +395,15 @@ func CompareRoundTrip(t *testing.T, req *roundtripper.Request, cReq *roundtrippe
 	if expected.Response.Protocol != "" && expected.Response.Protocol != cRes.Protocol {
 		return fmt.Errorf("expected protocol to be %s, got %s", expected.Response.Protocol, cRes.Protocol)
 	}
+	if expected.Response.Body != "" && expected.Response.Body != string(cRes.Body) {
+		return fmt.Errorf("expected body to be %q, got %q", expected.Response.Body, cRes.Body)
+	}
+	if expected.Response.BodyContains != "" && !strings.Contains(string(cRes.Body), expected.Response.BodyContains) {
+		return fmt.Errorf("expected body to contain %q, got %q", expected.Response.BodyContains, cRes.Body)
+	}
+	if err := compareTrailers(expected.Response.Trailers, cRes.Trailers); err != nil {
+		return fmt.Errorf("response: %w", err)
+	}
 
 	// 204 is a valid response for CORS requests.
 	if cRes.StatusCode == 200 || cRes.StatusCode == 204 {
@@ -408,6 +448,10 @@ func CompareRoundTrip(t *testing.T, req *roundtripper.Request, cReq *roundtrippe
 			}
 		}
 
+		if err := compareMultiValueHeaders(expected.ExpectedRequest.MultiValueHeaders, cReq.Headers); err != nil {
+			return fmt.Errorf("request: %w", err)
+		}
+
 		if expected.ExpectedRequest.HTTPPort != "" && expected.ExpectedRequest.HTTPPort != cReq.HTTPPort {
 			return fmt.Errorf("expected httpPort %q, got %q", expected.ExpectedRequest.HTTPPort, cReq.HTTPPort)
 		}
@@ -458,6 +502,10 @@ func CompareRoundTrip(t *testing.T, req *roundtripper.Request, cReq *roundtrippe
 			}
 		}
 
+		if err := compareMultiValueHeaders(expected.Response.MultiValueHeaders, cRes.Headers); err != nil {
+			return fmt.Errorf("response: %w", err)
+		}
+
 		if len(expected.Response.AbsentHeaders) > 0 {
 			for name, val := range cRes.Headers {
 				cRes.Headers[strings.ToLower(name)] = val
@@ -541,6 +589,47 @@ func CompareRoundTrip(t *testing.T, req *roundtripper.Request, cReq *roundtrippe
 	return nil
 }
 
+// compareMultiValueHeaders checks that each expected header has exactly the
+// expected values, in order. Header names are case-insensitive.
+func compareMultiValueHeaders(expected, actual map[string][]string) error {
+	for name, expectedVals := range expected {
+		actualVals, ok := headerValues(actual, name)
+		if !ok {
+			return fmt.Errorf("expected %s header to be set, actual headers: %v", name, actual)
+		}
+		if !slices.Equal(expectedVals, actualVals) {
+			return fmt.Errorf("expected %s header to have values %q, got %q", name, expectedVals, actualVals)
+		}
+	}
+	return nil
+}
+
+// compareTrailers checks that each expected trailer is set to the expected
+// comma-separated value. Trailer names are case-insensitive.
+func compareTrailers(expected map[string]string, actual map[string][]string) error {
+	for name, expectedVal := range expected {
+		actualVals, ok := headerValues(actual, name)
+		if !ok {
+			return fmt.Errorf("expected %s trailer to be set, actual trailers: %v", name, actual)
+		}
+		if actualVal := strings.Join(actualVals, ","); actualVal != expectedVal {
+			return fmt.Errorf("expected %s trailer to be set to %s, got %s", name, expectedVal, actualVal)
+		}
+	}
+	return nil
+}
+
+// headerValues returns the values of the named header, looked up
+// case-insensitively.
+func headerValues(headers map[string][]string, name string) ([]string, bool) {
+	for n, values := range headers {
+		if strings.EqualFold(n, name) {
+			return values, true
+		}
+	}
+	return nil, false
+}
+
 // GetTestCaseName gets the user-defined test case name or generates one from expected response to a given request.
 func (er *ExpectedResponse) GetTestCaseName(i int) string {
 	// If TestCase name is provided then use that or else generate one.
@@ -551,7 +640,7 @@ func (er *ExpectedResponse) GetTestCaseName(i int) string {
 	headerStr := ""
 	reqStr := ""
 
-	if er.Request.Headers != nil {
+	if er.Request.Headers != nil || er.Request.MultiValueHeaders != nil {
 		headerStr = " with headers"
 	}
 
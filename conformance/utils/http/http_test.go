@@ -0,0 +1,93 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http //nolint:revive
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"sigs.k8s.io/gateway-api/conformance/utils/roundtripper"
+)
+
+func TestCompareRoundTripHeadersTrailersAndBodies(t *testing.T) {
+	cReq := &roundtripper.CapturedRequest{
+		Path:    "/",
+		Method:  "GET",
+		Headers: map[string][]string{"X-Multi": {"one", "two"}},
+	}
+	cRes := &roundtripper.CapturedResponse{
+		StatusCode: 200,
+		Headers:    map[string][]string{"Set-Cookie": {"a=1", "b=2"}},
+		Trailers:   map[string][]string{"Grpc-Status": {"0"}},
+		Body:       []byte(`{"path":"/"}`),
+	}
+
+	testCases := []struct {
+		name        string
+		expected    ExpectedResponse
+		expectedErr string
+	}{
+		{
+			name: "all expectations met",
+			expected: ExpectedResponse{
+				Request: Request{Path: "/", MultiValueHeaders: map[string][]string{"x-multi": {"one", "two"}}},
+				Response: Response{
+					MultiValueHeaders: map[string][]string{"Set-Cookie": {"a=1", "b=2"}},
+					Trailers:          map[string]string{"grpc-status": "0"},
+					Body:              `{"path":"/"}`,
+					BodyContains:      `"path"`,
+				},
+			},
+		},
+		{
+			name: "request header values in another order",
+			expected: ExpectedResponse{
+				Request: Request{Path: "/", MultiValueHeaders: map[string][]string{"X-Multi": {"two", "one"}}},
+			},
+			expectedErr: `request: expected X-Multi header to have values ["two" "one"], got ["one" "two"]`,
+		},
+		{
+			name: "unexpected response trailer value",
+			expected: ExpectedResponse{
+				Request:  Request{Path: "/"},
+				Response: Response{Trailers: map[string]string{"Grpc-Status": "2"}},
+			},
+			expectedErr: "response: expected Grpc-Status trailer to be set to 2, got 0",
+		},
+		{
+			name: "unexpected body",
+			expected: ExpectedResponse{
+				Request:  Request{Path: "/"},
+				Response: Response{BodyContains: "denied"},
+			},
+			expectedErr: `expected body to contain "denied", got "{\"path\":\"/\"}"`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.expected.Response.StatusCodes = []int{200}
+			err := CompareRoundTrip(t, &roundtripper.Request{}, cReq, cRes, tc.expected)
+			if tc.expectedErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tc.expectedErr)
+		})
+	}
+}
@@ -19,6 +19,10 @@ package kubernetes
 import (
 	"bytes"
 	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
 	"crypto/rand"
 	"crypto/rsa"
 	"crypto/x509"
@@ -161,6 +165,213 @@ func generateRSACert(hosts []string, keyOut, certOut io.Writer, extKeyUsage []x5
 	return nil
 }
 
+// KeyAlgorithm is the algorithm of the key of a certificate generated by
+// GenerateCertificate.
+type KeyAlgorithm string
+
+const (
+	// RSAKeyAlgorithm generates 2048 bits RSA keys.
+	RSAKeyAlgorithm KeyAlgorithm = "RSA"
+	// ECDSAP256KeyAlgorithm generates ECDSA keys on the P-256 curve.
+	ECDSAP256KeyAlgorithm KeyAlgorithm = "ECDSA-P256"
+	// ECDSAP384KeyAlgorithm generates ECDSA keys on the P-384 curve.
+	ECDSAP384KeyAlgorithm KeyAlgorithm = "ECDSA-P384"
+	// Ed25519KeyAlgorithm generates Ed25519 keys.
+	Ed25519KeyAlgorithm KeyAlgorithm = "Ed25519"
+)
+
+// CertificateOptions configures the certificates generated by
+// GenerateCertificate.
+type CertificateOptions struct {
+	// Hosts are the Subject Alternate Names of the certificate, each being an
+	// IP address, a DNS name or a URI.
+	Hosts []string
+	// KeyAlgorithm is the algorithm of the key of the certificate, RSA when
+	// empty.
+	KeyAlgorithm KeyAlgorithm
+	// IsCA makes the certificate a CA, able to issue other certificates.
+	IsCA bool
+	// ExtKeyUsage is the extended key usage of the certificate, server
+	// authentication when empty unless the certificate is a CA.
+	ExtKeyUsage []x509.ExtKeyUsage
+	// NotBefore is the start of the validity period of the certificate, now
+	// when zero.
+	NotBefore time.Time
+	// NotAfter is the end of the validity period of the certificate, a year
+	// after NotBefore when zero.
+	NotAfter time.Time
+	// Issuer is the CA issuing the certificate, which is self-signed when
+	// nil.
+	Issuer *Certificate
+}
+
+// Expired returns the options with a validity period that ended a day ago.
+func (o CertificateOptions) Expired() CertificateOptions {
+	o.NotBefore = time.Now().Add(-validFor)
+	o.NotAfter = time.Now().Add(-24 * time.Hour)
+	return o
+}
+
+// NotYetValid returns the options with a validity period starting in a day.
+func (o CertificateOptions) NotYetValid() CertificateOptions {
+	o.NotBefore = time.Now().Add(24 * time.Hour)
+	o.NotAfter = o.NotBefore.Add(validFor)
+	return o
+}
+
+// Certificate is a certificate generated by GenerateCertificate, along with
+// its private key.
+type Certificate struct {
+	Certificate *x509.Certificate
+	PrivateKey  crypto.Signer
+
+	// CertificatePEM is the PEM encoded certificate, followed by the
+	// intermediate CAs that issued it, if any. The root CA is not included.
+	CertificatePEM []byte
+	// KeyPEM is the PEM encoded private key, in the PKCS #1 format for RSA
+	// keys and in the PKCS #8 format otherwise.
+	KeyPEM []byte
+
+	selfSigned bool
+}
+
+// GenerateCertificate generates a certificate and its private key as
+// configured by opts.
+func GenerateCertificate(opts CertificateOptions) (*Certificate, error) {
+	priv, err := generateKey(opts.KeyAlgorithm)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate key: %w", err)
+	}
+
+	notBefore := opts.NotBefore
+	if notBefore.IsZero() {
+		notBefore = time.Now()
+	}
+	notAfter := opts.NotAfter
+	if notAfter.IsZero() {
+		notAfter = notBefore.Add(validFor)
+	}
+
+	serialNumberLimit := new(big.Int).Lsh(big.NewInt(1), 128)
+	serialNumber, err := rand.Int(rand.Reader, serialNumberLimit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate serial number: %w", err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: serialNumber,
+		Subject: pkix.Name{
+			CommonName:   "default",
+			Organization: []string{"Acme Co"},
+		},
+		NotBefore:             notBefore,
+		NotAfter:              notAfter,
+		KeyUsage:              x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:           opts.ExtKeyUsage,
+		BasicConstraintsValid: true,
+		IsCA:                  opts.IsCA,
+	}
+	if opts.IsCA {
+		template.Subject.CommonName = fmt.Sprintf("gatewayapi-ca-%x", serialNumber)
+		template.KeyUsage |= x509.KeyUsageCertSign | x509.KeyUsageCRLSign
+	} else if len(template.ExtKeyUsage) == 0 {
+		template.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth}
+	}
+	// only RSA keys can be used for key encipherment
+	if _, ok := priv.(*rsa.PrivateKey); ok && !opts.IsCA {
+		template.KeyUsage |= x509.KeyUsageKeyEncipherment
+	}
+
+	for _, h := range opts.Hosts {
+		if ip := net.ParseIP(h); ip != nil {
+			template.IPAddresses = append(template.IPAddresses, ip)
+		} else if err = validateHost(h); err == nil {
+			template.DNSNames = append(template.DNSNames, h)
+		} else if u, parseErr := url.Parse(h); parseErr == nil {
+			template.URIs = append(template.URIs, u)
+		}
+	}
+
+	parent, parentKey := template, priv
+	if opts.Issuer != nil {
+		parent, parentKey = opts.Issuer.Certificate, opts.Issuer.PrivateKey
+	}
+	derBytes, err := x509.CreateCertificate(rand.Reader, template, parent, priv.Public(), parentKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create certificate: %w", err)
+	}
+	cert, err := x509.ParseCertificate(derBytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse certificate: %w", err)
+	}
+
+	keyBlock := &pem.Block{Type: "PRIVATE KEY"}
+	if rsaKey, ok := priv.(*rsa.PrivateKey); ok {
+		keyBlock = &pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(rsaKey)}
+	} else if keyBlock.Bytes, err = x509.MarshalPKCS8PrivateKey(priv); err != nil {
+		return nil, fmt.Errorf("failed to marshal key: %w", err)
+	}
+
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: derBytes})
+	if opts.Issuer != nil && !opts.Issuer.selfSigned {
+		certPEM = append(certPEM, opts.Issuer.CertificatePEM...)
+	}
+
+	return &Certificate{
+		Certificate:    cert,
+		PrivateKey:     priv,
+		CertificatePEM: certPEM,
+		KeyPEM:         pem.EncodeToMemory(keyBlock),
+		selfSigned:     opts.Issuer == nil,
+	}, nil
+}
+
+// MustGenerateCertificate generates a certificate and its private key as
+// configured by opts, failing the test on errors.
+func MustGenerateCertificate(t *testing.T, opts CertificateOptions) *Certificate {
+	t.Helper()
+	cert, err := GenerateCertificate(opts)
+	require.NoError(t, err, "failed to generate certificate")
+	return cert
+}
+
+// Secret returns a TLS Secret holding the certificate, with its intermediate
+// CAs, and its private key.
+func (c *Certificate) Secret(namespace, secretName string) *corev1.Secret {
+	return formatSecret(*bytes.NewBuffer(c.CertificatePEM), *bytes.NewBuffer(c.KeyPEM), namespace, secretName)
+}
+
+// CACertConfigMap returns a ConfigMap holding the certificate as a trusted
+// CA certificate, in the format expected by BackendTLSPolicy and frontend
+// client certificate validation.
+func (c *Certificate) CACertConfigMap(namespace, configMapName string) *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      configMapName,
+		},
+		Data: map[string]string{
+			"ca.crt": string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: c.Certificate.Raw})),
+		},
+	}
+}
+
+func generateKey(algorithm KeyAlgorithm) (crypto.Signer, error) {
+	switch algorithm {
+	case "", RSAKeyAlgorithm:
+		return rsa.GenerateKey(rand.Reader, rsaBits)
+	case ECDSAP256KeyAlgorithm:
+		return ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	case ECDSAP384KeyAlgorithm:
+		return ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	case Ed25519KeyAlgorithm:
+		_, priv, err := ed25519.GenerateKey(rand.Reader)
+		return priv, err
+	default:
+		return nil, fmt.Errorf("unsupported key algorithm %q", algorithm)
+	}
+}
+
 // MustCreateCACertConfigMap will create a ConfigMap containing a CA Certificate, given a TLS Secret
 // for that CA certificate.  Also returns the CA certificate.
 func MustCreateCACertConfigMap(t *testing.T, namespace, configMapName string) (*corev1.ConfigMap, *x509.Certificate, *rsa.PrivateKey) {
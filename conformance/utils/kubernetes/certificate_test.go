@@ -0,0 +1,111 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestGenerateCertificate(t *testing.T) {
+	testCases := []struct {
+		algorithm KeyAlgorithm
+		want      x509.PublicKeyAlgorithm
+	}{
+		{algorithm: "", want: x509.RSA},
+		{algorithm: RSAKeyAlgorithm, want: x509.RSA},
+		{algorithm: ECDSAP256KeyAlgorithm, want: x509.ECDSA},
+		{algorithm: ECDSAP384KeyAlgorithm, want: x509.ECDSA},
+		{algorithm: Ed25519KeyAlgorithm, want: x509.Ed25519},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.want.String()+"/"+string(tc.algorithm), func(t *testing.T) {
+			ca := MustGenerateCertificate(t, CertificateOptions{KeyAlgorithm: tc.algorithm, IsCA: true})
+			cert := MustGenerateCertificate(t, CertificateOptions{KeyAlgorithm: tc.algorithm, Hosts: []string{"example.com"}, Issuer: ca})
+
+			assert.Equal(t, tc.want, cert.Certificate.PublicKeyAlgorithm)
+			_, err := tls.X509KeyPair(cert.CertificatePEM, cert.KeyPEM)
+			require.NoError(t, err, "certificate and key do not form a key pair")
+
+			roots := x509.NewCertPool()
+			roots.AddCert(ca.Certificate)
+			_, err = cert.Certificate.Verify(x509.VerifyOptions{DNSName: "example.com", Roots: roots})
+			require.NoError(t, err)
+
+			secret := cert.Secret("ns", "name")
+			assert.Equal(t, corev1.SecretTypeTLS, secret.Type)
+			assert.Equal(t, cert.CertificatePEM, secret.Data[corev1.TLSCertKey])
+			assert.Equal(t, cert.KeyPEM, secret.Data[corev1.TLSPrivateKeyKey])
+		})
+	}
+}
+
+func TestGenerateCertificateChain(t *testing.T) {
+	root := MustGenerateCertificate(t, CertificateOptions{IsCA: true})
+	intermediate := MustGenerateCertificate(t, CertificateOptions{KeyAlgorithm: ECDSAP256KeyAlgorithm, IsCA: true, Issuer: root})
+	leaf := MustGenerateCertificate(t, CertificateOptions{Hosts: []string{"example.com"}, Issuer: intermediate})
+
+	// the chain holds the leaf and the intermediate, but not the root
+	var chain []*x509.Certificate
+	for rest := leaf.CertificatePEM; ; {
+		var block *pem.Block
+		if block, rest = pem.Decode(rest); block == nil {
+			break
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		require.NoError(t, err)
+		chain = append(chain, cert)
+	}
+	require.Len(t, chain, 2)
+	assert.True(t, chain[0].Equal(leaf.Certificate))
+	assert.True(t, chain[1].Equal(intermediate.Certificate))
+
+	roots := x509.NewCertPool()
+	require.True(t, roots.AppendCertsFromPEM([]byte(root.CACertConfigMap("ns", "name").Data["ca.crt"])))
+	intermediates := x509.NewCertPool()
+	intermediates.AddCert(chain[1])
+	_, err := leaf.Certificate.Verify(x509.VerifyOptions{DNSName: "example.com", Roots: roots})
+	require.Error(t, err, "leaf certificate verified without its intermediate")
+	_, err = leaf.Certificate.Verify(x509.VerifyOptions{DNSName: "example.com", Roots: roots, Intermediates: intermediates})
+	require.NoError(t, err)
+}
+
+func TestGenerateCertificateValidity(t *testing.T) {
+	ca := MustGenerateCertificate(t, CertificateOptions{IsCA: true})
+	roots := x509.NewCertPool()
+	roots.AddCert(ca.Certificate)
+
+	for name, opts := range map[string]CertificateOptions{
+		"expired":       CertificateOptions{Hosts: []string{"example.com"}, Issuer: ca}.Expired(),
+		"not yet valid": CertificateOptions{Hosts: []string{"example.com"}, Issuer: ca}.NotYetValid(),
+	} {
+		t.Run(name, func(t *testing.T) {
+			cert := MustGenerateCertificate(t, opts)
+			_, err := cert.Certificate.Verify(x509.VerifyOptions{DNSName: "example.com", Roots: roots})
+			var invalidErr x509.CertificateInvalidError
+			require.ErrorAs(t, err, &invalidErr)
+			assert.Equal(t, x509.Expired, invalidErr.Reason)
+		})
+	}
+}
@@ -0,0 +1,110 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"sigs.k8s.io/gateway-api/conformance/utils/config"
+	"sigs.k8s.io/gateway-api/pkg/client/capabilities"
+)
+
+// GatewayAPIResources returns every namespaced Gateway API object, including
+// its status, in the given namespace. The kinds to list are discovered from
+// the installed Gateway API CRDs, using their storage version.
+func GatewayAPIResources(ctx context.Context, c client.Client, namespace string) ([]unstructured.Unstructured, error) {
+	bundle, err := capabilities.Discover(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+
+	var resources []unstructured.Unstructured
+	var errs []error
+	for _, crd := range bundle.CRDs() {
+		gk := schema.GroupKind{Group: crd.Spec.Group, Kind: crd.Spec.Names.Kind}
+		version := bundle.StorageVersion(gk)
+		if !bundle.Namespaced(gk) || version == "" {
+			continue
+		}
+
+		list := &unstructured.UnstructuredList{}
+		list.SetGroupVersionKind(schema.GroupVersionKind{
+			Group:   crd.Spec.Group,
+			Version: version,
+			Kind:    crd.Spec.Names.ListKind,
+		})
+		if err := c.List(ctx, list, client.InNamespace(namespace)); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		resources = append(resources, list.Items...)
+	}
+	return resources, errors.Join(errs...)
+}
+
+// ManifestResources returns the resources defined in the provided YAML file,
+// as written in the file.
+func (a Applier) ManifestResources(location string, timeoutConfig config.TimeoutConfig) ([]unstructured.Unstructured, error) {
+	data, err := getContentsFromPathOrURL(a.ManifestFS, location, timeoutConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	var resources []unstructured.Unstructured
+	decoder := yaml.NewYAMLOrJSONDecoder(data, 4096)
+	for {
+		uObj := unstructured.Unstructured{}
+		if err := decoder.Decode(&uObj); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, err
+		}
+		if len(uObj.Object) == 0 {
+			continue
+		}
+		resources = append(resources, uObj)
+	}
+	return resources, nil
+}
+
+// ManifestNamespaces returns the namespaces used by the resources defined in
+// the provided YAML file, including the namespaces it defines.
+func (a Applier) ManifestNamespaces(location string, timeoutConfig config.TimeoutConfig) ([]string, error) {
+	resources, err := a.ManifestResources(location, timeoutConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	namespaces := sets.New[string]()
+	for _, uObj := range resources {
+		if uObj.GetKind() == "Namespace" && uObj.GroupVersionKind().Group == "" {
+			namespaces.Insert(uObj.GetName())
+		} else if uObj.GetNamespace() != "" {
+			namespaces.Insert(uObj.GetNamespace())
+		}
+	}
+	return sets.List(namespaces), nil
+}
@@ -465,6 +465,46 @@ func MeshNamespacesMustBeReady(t *testing.T, c client.Client, timeoutConfig conf
 	require.NoErrorf(t, waitErr, "error waiting for %s namespaces to be ready", strings.Join(namespaces, ", "))
 }
 
+// NamespacesMustBeActive waits until all the specified namespaces exist and
+// are Active. Unlike NamespacesMustBeReady, it does not require any Pods to be
+// deployed in them. This will cause the test to halt if the specified timeout
+// is exceeded.
+func NamespacesMustBeActive(t *testing.T, c client.Client, timeoutConfig config.TimeoutConfig, namespaces []string) {
+	t.Helper()
+
+	waitErr := wait.PollUntilContextTimeout(context.Background(), timeoutConfig.DefaultPollInterval, timeoutConfig.NamespacesMustBeReady, true, func(ctx context.Context) (bool, error) {
+		for _, ns := range namespaces {
+			namespace := &v1.Namespace{}
+			if err := c.Get(ctx, types.NamespacedName{Name: ns}, namespace); err != nil {
+				tlog.Logf(t, "Error getting namespace %s: %v", ns, err)
+				return false, nil
+			}
+			if namespace.Status.Phase != v1.NamespaceActive {
+				tlog.Logf(t, "Namespace %s not active yet", ns)
+				return false, nil
+			}
+		}
+		tlog.Logf(t, "Namespaces %s active", strings.Join(namespaces, ", "))
+		return true, nil
+	})
+	require.NoErrorf(t, waitErr, "error waiting for %s namespaces to be active", strings.Join(namespaces, ", "))
+}
+
+// NamespacesMustBeDeleted deletes the specified namespace(s), and waits until
+// they are gone. This will cause the test to halt if timeoutConfig.DeleteTimeout
+// is exceeded.
+func NamespacesMustBeDeleted(t *testing.T, c client.Client, timeoutConfig config.TimeoutConfig, namespaces []string) {
+	t.Helper()
+
+	for _, ns := range namespaces {
+		namespace := &v1.Namespace{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Namespace"},
+			ObjectMeta: metav1.ObjectMeta{Name: ns},
+		}
+		deleteAndWait(t, c, namespace, timeoutConfig)
+	}
+}
+
 // GatewayAndRoutesMustBeAccepted waits until:
 //  1. The specified Gateway has an IP address assigned to it.
 //  2. The route has a ParentRef referring to the Gateway.
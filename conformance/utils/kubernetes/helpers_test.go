@@ -195,6 +195,53 @@ func TestHTTPRouteMustBeAcceptedAndResolved(t *testing.T) {
 	assert.True(t, apierrors.IsNotFound(err))
 }
 
+func TestNamespacesMustBeActive(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		&corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{Name: "a"},
+			Status:     corev1.NamespaceStatus{Phase: corev1.NamespaceActive},
+		},
+		&corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{Name: "b"},
+			Status:     corev1.NamespaceStatus{Phase: corev1.NamespaceActive},
+		},
+	).Build()
+
+	timeoutConfig := config.TimeoutConfig{
+		NamespacesMustBeReady: 5 * time.Second,
+		DefaultPollInterval:   100 * time.Millisecond,
+	}
+
+	// Namespaces without any Pod are active as soon as they are created.
+	NamespacesMustBeActive(t, c, timeoutConfig, []string{"a", "b"})
+}
+
+func TestNamespacesMustBeDeleted(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "a"}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "b"}},
+	).Build()
+
+	timeoutConfig := config.TimeoutConfig{
+		DeleteTimeout:       5 * time.Second,
+		DefaultPollInterval: 100 * time.Millisecond,
+	}
+
+	// Namespaces which are already gone are ignored.
+	NamespacesMustBeDeleted(t, c, timeoutConfig, []string{"a", "b", "missing"})
+
+	for _, ns := range []string{"a", "b"} {
+		err := c.Get(context.TODO(), types.NamespacedName{Name: ns}, &corev1.Namespace{})
+		assert.True(t, apierrors.IsNotFound(err), "namespace %s was not deleted", ns)
+	}
+}
+
 // -----------------------------------------------------------------------------
 // Test - Private Functions
 // -----------------------------------------------------------------------------
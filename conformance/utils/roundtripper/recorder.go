@@ -0,0 +1,88 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package roundtripper
+
+import (
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// RecordedRoundTrip is a round trip made by a test, as recorded by a
+// RecordingRoundTripper.
+type RecordedRoundTrip struct {
+	Time             time.Time
+	Request          Request
+	CapturedRequest  *CapturedRequest
+	CapturedResponse *CapturedResponse
+	Err              error
+}
+
+// RecordingRoundTripper is a RoundTripper which delegates to another
+// RoundTripper and keeps the last round trip made by each test, so that it can
+// be inspected once a test failed. Round trips are attributed to the test set
+// in the Request; requests without a test are not recorded.
+type RecordingRoundTripper struct {
+	RoundTripper RoundTripper
+
+	lock sync.Mutex
+	last map[string]RecordedRoundTrip
+}
+
+// CaptureRoundTrip makes a request with the wrapped RoundTripper and records
+// the captured request and response, or the error, for the requesting test.
+func (r *RecordingRoundTripper) CaptureRoundTrip(request Request) (*CapturedRequest, *CapturedResponse, error) {
+	cReq, cRes, err := r.RoundTripper.CaptureRoundTrip(request)
+	if request.T == nil {
+		return cReq, cRes, err
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if r.last == nil {
+		r.last = make(map[string]RecordedRoundTrip)
+	}
+	r.last[request.T.Name()] = RecordedRoundTrip{
+		Time:             time.Now(),
+		Request:          request,
+		CapturedRequest:  cReq,
+		CapturedResponse: cRes,
+		Err:              err,
+	}
+	return cReq, cRes, err
+}
+
+// LastRoundTrip returns the most recent round trip made by the given test or
+// any of its subtests, if any.
+func (r *RecordingRoundTripper) LastRoundTrip(t *testing.T) (RecordedRoundTrip, bool) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	var last RecordedRoundTrip
+	found := false
+	for name, roundTrip := range r.last {
+		if name != t.Name() && !strings.HasPrefix(name, t.Name()+"/") {
+			continue
+		}
+		if !found || roundTrip.Time.After(last.Time) {
+			last = roundTrip
+			found = true
+		}
+	}
+	return last, found
+}
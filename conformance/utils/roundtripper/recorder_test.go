@@ -0,0 +1,79 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package roundtripper
+
+import (
+	"errors"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubRoundTripper struct {
+	statusCode int
+	err        error
+}
+
+func (s *stubRoundTripper) CaptureRoundTrip(request Request) (*CapturedRequest, *CapturedResponse, error) {
+	if s.err != nil {
+		return nil, nil, s.err
+	}
+	return &CapturedRequest{Path: request.URL.Path}, &CapturedResponse{StatusCode: s.statusCode}, nil
+}
+
+func TestRecordingRoundTripper(t *testing.T) {
+	stub := &stubRoundTripper{statusCode: 200}
+	recorder := &RecordingRoundTripper{RoundTripper: stub}
+
+	_, found := recorder.LastRoundTrip(t)
+	require.False(t, found, "no round trip should have been recorded yet")
+
+	_, _, err := recorder.CaptureRoundTrip(Request{URL: url.URL{Path: "/untracked"}})
+	require.NoError(t, err)
+	_, found = recorder.LastRoundTrip(t)
+	require.False(t, found, "round trips without a test should not be recorded")
+
+	_, _, err = recorder.CaptureRoundTrip(Request{T: t, URL: url.URL{Path: "/first"}})
+	require.NoError(t, err)
+
+	t.Run("subtest", func(subT *testing.T) {
+		stub.statusCode = 404
+		_, _, subErr := recorder.CaptureRoundTrip(Request{T: subT, URL: url.URL{Path: "/second"}})
+		require.NoError(subT, subErr)
+
+		subRoundTrip, subFound := recorder.LastRoundTrip(subT)
+		require.True(subT, subFound)
+		assert.Equal(subT, "/second", subRoundTrip.CapturedRequest.Path)
+	})
+
+	roundTrip, found := recorder.LastRoundTrip(t)
+	require.True(t, found, "round trips of subtests should be attributed to the parent test")
+	assert.Equal(t, "/second", roundTrip.CapturedRequest.Path)
+	assert.Equal(t, 404, roundTrip.CapturedResponse.StatusCode)
+
+	stub.err = errors.New("connection refused")
+	_, _, err = recorder.CaptureRoundTrip(Request{T: t, URL: url.URL{Path: "/third"}})
+	require.Error(t, err)
+
+	roundTrip, found = recorder.LastRoundTrip(t)
+	require.True(t, found)
+	assert.Equal(t, "/third", roundTrip.Request.URL.Path)
+	assert.Nil(t, roundTrip.CapturedRequest)
+	assert.EqualError(t, roundTrip.Err, "connection refused")
+}
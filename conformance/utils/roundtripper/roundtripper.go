@@ -31,7 +31,9 @@ import (
 	"regexp"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/quic-go/quic-go/http3"
 	"golang.org/x/net/http2"
 
 	"sigs.k8s.io/gateway-api/conformance/utils/config"
@@ -42,6 +44,7 @@ const (
 	H2CPriorKnowledgeProtocol = "H2C_PRIOR_KNOWLEDGE"
 	HTTPSProtocol             = "HTTPS"
 	H2Protocol                = "H2"
+	H3Protocol                = "H3"
 )
 
 // RoundTripper is an interface used to make requests within conformance tests.
@@ -50,7 +53,8 @@ type RoundTripper interface {
 	CaptureRoundTrip(Request) (*CapturedRequest, *CapturedResponse, error)
 }
 
-// Request is the primary input for making a request.
+// Request is the primary input for making a request. Every value of a
+// header is sent on its own header line, in order.
 type Request struct {
 	T                        *testing.T
 	URL                      url.URL
@@ -63,17 +67,27 @@ type Request struct {
 	ServerName               string
 	Body                     string
 	GetClientCertificateHook func(*tls.CertificateRequestInfo) (*tls.Certificate, error)
+	// Trailers are sent after the request body, which is then chunked.
+	// Multiple values can be provided, as a comma-separated value.
+	Trailers map[string]string
+	// BodyChunks is sent, instead of Body, as a chunked body, each element
+	// being written as its own chunk.
+	BodyChunks []string
+	// ChunkInterval is the delay between the writes of two consecutive
+	// BodyChunks.
+	ChunkInterval time.Duration
 }
 
 // String returns a printable version of Request for logging. Note that the
 // ServerCertificate, ClientCertificate, and ClientCertificateKey are truncated.
 func (r Request) String() string {
-	return fmt.Sprintf("{URL: %+v, Host: %v, Protocol: %v, Method: %v, Headers: %v, UnfollowRedirect: %v, ServerName: %v, ServerCertificate: <truncated>, ClientCertificate: <truncated>, ClientCertificateKey: <truncated>}",
+	return fmt.Sprintf("{URL: %+v, Host: %v, Protocol: %v, Method: %v, Headers: %v, Trailers: %v, UnfollowRedirect: %v, ServerName: %v, ServerCertificate: <truncated>, ClientCertificate: <truncated>, ClientCertificateKey: <truncated>}",
 		r.URL,
 		r.Host,
 		r.Protocol,
 		r.Method,
 		r.Headers,
+		r.Trailers,
 		r.UnfollowRedirect,
 		r.ServerName,
 	)
@@ -117,6 +131,8 @@ type CapturedResponse struct {
 	ContentLength    int64
 	Protocol         string
 	Headers          map[string][]string
+	Trailers         map[string][]string
+	Body             []byte
 	RedirectRequest  *RedirectRequest
 	PeerCertificates []*x509.Certificate
 }
@@ -160,10 +176,37 @@ func (d *DefaultRoundTripper) h2Transport(request Request) (http.RoundTripper, e
 		return nil, err
 	}
 	transport.TLSClientConfig = tlsConfig
+	if d.CustomDialContext != nil {
+		transport.DialTLSContext = func(ctx context.Context, network, addr string, cfg *tls.Config) (net.Conn, error) {
+			conn, err := d.CustomDialContext(ctx, network, addr)
+			if err != nil {
+				return nil, err
+			}
+			tlsConn := tls.Client(conn, cfg)
+			if err := tlsConn.HandshakeContext(ctx); err != nil {
+				conn.Close()
+				return nil, err
+			}
+			return tlsConn, nil
+		}
+	}
 
 	return transport, nil
 }
 
+// h3Transport returns a transport making HTTP/3 requests over QUIC. Unlike
+// the other transports, it doesn't use CustomDialContext, as QUIC runs over
+// UDP, so the conformance suite skips HTTP/3 tests when one is set. It must be
+// closed once the request is done to release its UDP socket.
+func (d *DefaultRoundTripper) h3Transport(request Request) (*http3.Transport, error) {
+	tlsConfig, err := createTLSClientConfig(request)
+	if err != nil {
+		return nil, err
+	}
+
+	return &http3.Transport{TLSClientConfig: tlsConfig}, nil
+}
+
 func (d *DefaultRoundTripper) h2cPriorKnowledgeTransport(request Request) (http.RoundTripper, error) {
 	if request.ServerName != "" && len(request.ServerCertificate) > 0 {
 		return nil, errors.New("request has configured trusted CA certificates but h2 prior knowledge is not encrypted")
@@ -172,8 +215,11 @@ func (d *DefaultRoundTripper) h2cPriorKnowledgeTransport(request Request) (http.
 	transport := &http2.Transport{
 		AllowHTTP: true,
 		DialTLSContext: func(ctx context.Context, network, addr string, _ *tls.Config) (net.Conn, error) {
-			var d net.Dialer
-			return d.DialContext(ctx, network, addr)
+			if d.CustomDialContext != nil {
+				return d.CustomDialContext(ctx, network, addr)
+			}
+			var dialer net.Dialer
+			return dialer.DialContext(ctx, network, addr)
 		},
 	}
 
@@ -193,6 +239,13 @@ func (d *DefaultRoundTripper) CaptureRoundTrip(request Request) (*CapturedReques
 		transport, err = d.h2cPriorKnowledgeTransport(request)
 	case H2Protocol:
 		transport, err = d.h2Transport(request)
+	case H3Protocol:
+		var h3Transport *http3.Transport
+		h3Transport, err = d.h3Transport(request)
+		if err == nil {
+			defer h3Transport.Close()
+			transport = h3Transport
+		}
 	default:
 		transport, err = d.httpTransport(request)
 	}
@@ -224,7 +277,16 @@ func (d *DefaultRoundTripper) defaultRoundTrip(request Request, transport http.R
 	ctx = withT(ctx, request.T)
 
 	var reqBody io.Reader
-	if request.Body != "" {
+	switch {
+	case request.Body != "" && len(request.BodyChunks) > 0:
+		return nil, nil, errors.New("request can't have both a body and body chunks")
+	case len(request.BodyChunks) > 0:
+		// the body is read from a pipe, so its length is unknown and it is
+		// sent chunked
+		pr, pw := io.Pipe()
+		go writeBodyChunks(ctx, pw, request.BodyChunks, request.ChunkInterval)
+		reqBody = pr
+	case request.Body != "":
 		reqBody = strings.NewReader(request.Body)
 	}
 	req, err := http.NewRequestWithContext(ctx, method, request.URL.String(), reqBody)
@@ -236,9 +298,21 @@ func (d *DefaultRoundTripper) defaultRoundTrip(request Request, transport http.R
 		req.Host = request.Host
 	}
 
-	if request.Headers != nil {
-		for name, value := range request.Headers {
-			req.Header.Set(name, value[0])
+	for name, values := range request.Headers {
+		for _, value := range values {
+			req.Header.Add(name, value)
+		}
+	}
+
+	if len(request.Trailers) > 0 {
+		req.Trailer = http.Header{}
+		for name, value := range request.Trailers {
+			req.Trailer.Set(name, value)
+		}
+		// trailers are only sent with chunked bodies
+		req.ContentLength = -1
+		if req.Body == nil || req.Body == http.NoBody {
+			req.Body = io.NopCloser(strings.NewReader(request.Body))
 		}
 	}
 
@@ -302,6 +376,11 @@ func (d *DefaultRoundTripper) defaultRoundTrip(request Request, transport http.R
 		ContentLength: resp.ContentLength,
 		Protocol:      resp.Proto,
 		Headers:       resp.Header,
+		Body:          body,
+	}
+	// trailers are only available once the body has been read
+	if len(resp.Trailer) > 0 {
+		cRes.Trailers = resp.Trailer
 	}
 
 	if resp.TLS != nil {
@@ -324,6 +403,26 @@ func (d *DefaultRoundTripper) defaultRoundTrip(request Request, transport http.R
 	return cReq, cRes, nil
 }
 
+// writeBodyChunks writes each chunk to the pipe, waiting for the interval
+// between two consecutive chunks, and closes it.
+func writeBodyChunks(ctx context.Context, pw *io.PipeWriter, chunks []string, interval time.Duration) {
+	for i, chunk := range chunks {
+		if i > 0 && interval > 0 {
+			select {
+			case <-ctx.Done():
+				pw.CloseWithError(ctx.Err())
+				return
+			case <-time.After(interval):
+			}
+		}
+		if _, err := io.WriteString(pw, chunk); err != nil {
+			pw.CloseWithError(err)
+			return
+		}
+	}
+	pw.Close()
+}
+
 func createTLSClientConfig(request Request) (*tls.Config, error) {
 	if request.ServerName == "" {
 		return nil, errors.New("https request has no server name configured")
@@ -0,0 +1,216 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package roundtripper
+
+import (
+	"crypto/tls"
+	"encoding/json"
+	"encoding/pem"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/quic-go/quic-go/http3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"sigs.k8s.io/gateway-api/conformance/utils/config"
+)
+
+// echoHandler responds with the request metadata, like the echoserver. The
+// request trailers are echoed as X-Request-Trailer-* response headers, and the
+// length of the request body is sent as a response trailer.
+var echoHandler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Trailer", "Body-Length")
+	w.Header().Set("X-Request-Transfer-Encoding", strings.Join(r.TransferEncoding, ","))
+	for name, values := range r.Trailer {
+		w.Header()["X-Request-Trailer-"+name] = values
+	}
+	_ = json.NewEncoder(w).Encode(CapturedRequest{
+		Path:     r.URL.Path,
+		Host:     r.Host,
+		Method:   r.Method,
+		Protocol: r.Proto,
+		Headers:  r.Header,
+	})
+	w.Header().Set("Body-Length", strconv.Itoa(len(body)))
+})
+
+func TestDefaultRoundTripperProtocols(t *testing.T) {
+	server := httptest.NewUnstartedServer(echoHandler)
+	server.EnableHTTP2 = true
+	server.StartTLS()
+	t.Cleanup(server.Close)
+
+	// serve HTTP/3 on a UDP socket, with the certificate of the TLS server
+	udpConn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	h3Server := &http3.Server{
+		Handler:   echoHandler,
+		TLSConfig: http3.ConfigureTLSConfig(&tls.Config{Certificates: server.TLS.Certificates}),
+	}
+	go func() { _ = h3Server.Serve(udpConn) }()
+	t.Cleanup(func() {
+		h3Server.Close()
+		udpConn.Close()
+	})
+
+	serverCertificate := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	tcpAddr := server.Listener.Addr().String()
+
+	testCases := []struct {
+		name             string
+		protocol         string
+		addr             string
+		expectedProtocol string
+	}{
+		{name: "HTTPS", protocol: HTTPSProtocol, addr: tcpAddr, expectedProtocol: "HTTP/1.1"},
+		{name: "H2", protocol: H2Protocol, addr: tcpAddr, expectedProtocol: "HTTP/2.0"},
+		{name: "H3", protocol: H3Protocol, addr: udpConn.LocalAddr().String(), expectedProtocol: "HTTP/3.0"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rt := &DefaultRoundTripper{TimeoutConfig: config.DefaultTimeoutConfig()}
+			cReq, cRes, err := rt.CaptureRoundTrip(Request{
+				T:                 t,
+				URL:               url.URL{Scheme: "https", Host: tc.addr, Path: "/protocol"},
+				Host:              "example.com",
+				Protocol:          tc.protocol,
+				Headers:           map[string][]string{"Version": {"one"}},
+				ServerName:        "example.com",
+				ServerCertificate: serverCertificate,
+			})
+			require.NoError(t, err)
+
+			assert.Equal(t, 200, cRes.StatusCode)
+			assert.Equal(t, tc.expectedProtocol, cRes.Protocol)
+			assert.Equal(t, tc.expectedProtocol, cReq.Protocol)
+			assert.Equal(t, "/protocol", cReq.Path)
+			assert.Equal(t, "example.com", cReq.Host)
+			assert.Equal(t, []string{"one"}, cReq.Headers["Version"])
+		})
+	}
+
+	t.Run("H3 without trusted certificates", func(t *testing.T) {
+		rt := &DefaultRoundTripper{TimeoutConfig: config.DefaultTimeoutConfig()}
+		_, _, err := rt.CaptureRoundTrip(Request{
+			T:          t,
+			URL:        url.URL{Scheme: "https", Host: udpConn.LocalAddr().String(), Path: "/"},
+			Protocol:   H3Protocol,
+			ServerName: "example.com",
+		})
+		require.EqualError(t, err, "https request has no trusted certificates configured")
+	})
+}
+
+func TestDefaultRoundTripperHeadersTrailersAndBodies(t *testing.T) {
+	server := httptest.NewServer(echoHandler)
+	t.Cleanup(server.Close)
+	serverURL, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name                     string
+		request                  Request
+		expectedHeaders          map[string][]string
+		expectedTrailers         map[string][]string
+		expectedBodyLength       string
+		expectedTransferEncoding string
+		expectedErr              string
+	}{
+		{
+			name: "multi-valued headers",
+			request: Request{
+				Method:  "GET",
+				Headers: map[string][]string{"X-Multi": {"one", "two", "three"}},
+			},
+			expectedHeaders:    map[string][]string{"X-Multi": {"one", "two", "three"}},
+			expectedBodyLength: "0",
+		},
+		{
+			name: "body",
+			request: Request{
+				Method: "POST",
+				Body:   "hello world",
+			},
+			expectedBodyLength: "11",
+		},
+		{
+			name: "streamed body",
+			request: Request{
+				Method:        "POST",
+				BodyChunks:    []string{"hello", " ", "world"},
+				ChunkInterval: 10 * time.Millisecond,
+			},
+			expectedBodyLength:       "11",
+			expectedTransferEncoding: "chunked",
+		},
+		{
+			name: "trailers",
+			request: Request{
+				Method:   "POST",
+				Body:     "hello world",
+				Trailers: map[string]string{"X-Checksum": "abc,def"},
+			},
+			expectedTrailers:         map[string][]string{"X-Request-Trailer-X-Checksum": {"abc,def"}},
+			expectedBodyLength:       "11",
+			expectedTransferEncoding: "chunked",
+		},
+		{
+			name: "body and body chunks",
+			request: Request{
+				Method:     "POST",
+				Body:       "hello",
+				BodyChunks: []string{"world"},
+			},
+			expectedErr: "request can't have both a body and body chunks",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rt := &DefaultRoundTripper{TimeoutConfig: config.DefaultTimeoutConfig()}
+			tc.request.T = t
+			tc.request.URL = url.URL{Scheme: "http", Host: serverURL.Host, Path: "/"}
+			cReq, cRes, err := rt.CaptureRoundTrip(tc.request)
+			if tc.expectedErr != "" {
+				require.EqualError(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+
+			for name, values := range tc.expectedHeaders {
+				assert.Equal(t, values, cReq.Headers[name])
+			}
+			for name, values := range tc.expectedTrailers {
+				assert.Equal(t, values, cRes.Headers[name])
+			}
+			assert.Equal(t, []string{tc.expectedTransferEncoding}, cRes.Headers["X-Request-Transfer-Encoding"])
+			assert.Equal(t, map[string][]string{"Body-Length": {tc.expectedBodyLength}}, cRes.Trailers)
+			assert.Contains(t, string(cRes.Body), `"path":"/"`)
+		})
+	}
+}
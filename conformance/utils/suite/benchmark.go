@@ -0,0 +1,463 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package suite
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sync/errgroup"
+	corev1 "k8s.io/api/core/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	confv1 "sigs.k8s.io/gateway-api/conformance/apis/v1"
+	"sigs.k8s.io/gateway-api/conformance/utils/http"
+	"sigs.k8s.io/gateway-api/conformance/utils/kubernetes"
+	"sigs.k8s.io/gateway-api/conformance/utils/roundtripper"
+	"sigs.k8s.io/gateway-api/conformance/utils/tlog"
+)
+
+const (
+	// benchmarkName is the name of the Gateway created by the benchmark in
+	// the infrastructure namespace, and the prefix of the namespaces of its
+	// HTTPRoutes.
+	benchmarkName = "gateway-conformance-benchmark"
+
+	// Names of the latencies reported by the benchmark.
+	routeAcceptedLatency               = "RouteAccepted"
+	routeFirstSuccessfulRequestLatency = "RouteFirstSuccessfulRequest"
+	routeUpdateLatency                 = "RouteUpdate"
+	routeDeletionLatency               = "RouteDeletion"
+)
+
+// benchmarkRoute holds an HTTPRoute created by the benchmark and the
+// latencies measured for it.
+type benchmarkRoute struct {
+	route     *gatewayv1.HTTPRoute
+	request   roundtripper.Request
+	expected  http.ExpectedResponse
+	createdAt time.Time
+
+	// accepted and served are the times it took for the HTTPRoute to be
+	// accepted by the Gateway and to serve its first successful request
+	// after it was created, or zero if that didn't happen (yet).
+	accepted time.Duration
+	served   time.Duration
+}
+
+// Benchmark runs the scale and propagation-latency benchmark configured by
+// BenchmarkConfig. It creates BenchmarkConfig.Routes HTTPRoutes spread across
+// BenchmarkConfig.Namespaces namespaces and attached to a single Gateway, and
+// measures how long each of them takes to be accepted and to serve its first
+// successful request. It then updates, and finally deletes, a sample of the
+// HTTPRoutes one at a time to measure how long the changes take to be served.
+//
+// Latencies are measured by polling, so they are accurate to about
+// TimeoutConfig.DefaultPollInterval. Setup must be called before Benchmark.
+func (suite *ConformanceTestSuite) Benchmark(t *testing.T) *confv1.BenchmarkReport {
+	cfg := suite.BenchmarkConfig
+	gwNN := types.NamespacedName{Name: benchmarkName, Namespace: InfrastructureNamespace}
+
+	namespaces := make([]string, cfg.Namespaces)
+	objects := make([]client.Object, 0, 2*cfg.Namespaces+1)
+	for i := range namespaces {
+		namespaces[i] = fmt.Sprintf("%s-%d", benchmarkName, i)
+		objects = append(objects, &corev1.Namespace{
+			ObjectMeta: v1.ObjectMeta{
+				Name:        namespaces[i],
+				Labels:      suite.Applier.NamespaceLabels,
+				Annotations: suite.Applier.NamespaceAnnotations,
+			},
+		}, &gatewayv1.ReferenceGrant{
+			ObjectMeta: v1.ObjectMeta{
+				Name:      namespaces[i],
+				Namespace: InfrastructureNamespace,
+			},
+			Spec: gatewayv1.ReferenceGrantSpec{
+				From: []gatewayv1.ReferenceGrantFrom{{
+					Group:     gatewayv1.Group(gatewayv1.GroupName),
+					Kind:      "HTTPRoute",
+					Namespace: gatewayv1.Namespace(namespaces[i]),
+				}},
+				To: []gatewayv1.ReferenceGrantTo{{Group: "", Kind: "Service"}},
+			},
+		})
+	}
+	objects = append(objects, &gatewayv1.Gateway{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      gwNN.Name,
+			Namespace: gwNN.Namespace,
+		},
+		Spec: gatewayv1.GatewaySpec{
+			GatewayClassName: gatewayv1.ObjectName(suite.GatewayClassName),
+			Listeners: []gatewayv1.Listener{{
+				Name:     "http",
+				Port:     80,
+				Protocol: gatewayv1.HTTPProtocolType,
+				AllowedRoutes: &gatewayv1.AllowedRoutes{
+					Namespaces: &gatewayv1.RouteNamespaces{From: ptr.To(gatewayv1.NamespacesFromAll)},
+				},
+			}},
+		},
+	})
+
+	tlog.Logf(t, "Benchmark: creating the Gateway and %d namespaces", cfg.Namespaces)
+	suite.Applier.MustApplyObjectsWithCleanup(t, suite.Client, suite.TimeoutConfig, objects, suite.CleanupTestResources)
+	kubernetes.NamespacesMustBeReady(t, suite.Client, suite.TimeoutConfig, []string{InfrastructureNamespace})
+	// The benchmark namespaces only hold HTTPRoutes, backed by the Services
+	// of the infrastructure namespace, so there are no Pods to wait for.
+	kubernetes.NamespacesMustBeActive(t, suite.Client, suite.TimeoutConfig, namespaces)
+	gwAddr, err := kubernetes.WaitForGatewayAddress(t, suite.Client, suite.TimeoutConfig, kubernetes.NewGatewayRef(gwNN))
+	require.NoError(t, err, "timed out waiting for Gateway address to be assigned")
+
+	// The HTTPRoutes of a previous run are left over when test resources
+	// aren't cleaned up, and must be deleted for all the HTTPRoutes to be
+	// created, and measured, by this run.
+	suite.deleteBenchmarkRoutes(t, namespaces)
+
+	routes := make([]*benchmarkRoute, cfg.Routes)
+	for i := range routes {
+		routes[i] = newBenchmarkRoute(t, gwNN, gwAddr, namespaces[i%len(namespaces)], i)
+	}
+
+	tlog.Logf(t, "Benchmark: creating %d HTTPRoutes", cfg.Routes)
+	created := make(chan *benchmarkRoute, len(routes))
+	var (
+		creators  errgroup.Group
+		createErr error
+	)
+	creators.SetLimit(cfg.ConcurrentRequests)
+	go func() {
+		for _, r := range routes {
+			creators.Go(func() error {
+				ctx, cancel := context.WithTimeout(context.Background(), suite.TimeoutConfig.CreateTimeout)
+				defer cancel()
+				r.createdAt = time.Now()
+				if err := suite.Client.Create(ctx, r.route); err != nil {
+					return fmt.Errorf("error creating HTTPRoute %s: %w", client.ObjectKeyFromObject(r.route), err)
+				}
+				created <- r
+				return nil
+			})
+		}
+		createErr = creators.Wait()
+		close(created)
+	}()
+
+	suite.observeBenchmarkRoutes(t, gwNN, created)
+	// Wait for all the HTTPRoutes to be created, even if observing them
+	// timed out, before reporting creation errors.
+	for range created {
+	}
+	require.NoError(t, createErr, "error creating HTTPRoutes")
+
+	var accepted, served []time.Duration
+	var samples []*benchmarkRoute
+	for _, r := range routes {
+		if r.accepted != 0 {
+			accepted = append(accepted, r.accepted)
+		}
+		if r.served != 0 {
+			served = append(served, r.served)
+			samples = append(samples, r)
+		}
+	}
+	latencies := []confv1.LatencyReport{
+		summarizeLatencies(routeAcceptedLatency, accepted, len(routes)-len(accepted)),
+		summarizeLatencies(routeFirstSuccessfulRequestLatency, served, len(routes)-len(served)),
+	}
+
+	// Sample HTTPRoutes evenly, so that those created first and last are
+	// both represented.
+	if len(samples) > cfg.Samples {
+		step := float64(len(samples)) / float64(cfg.Samples)
+		for i := range cfg.Samples {
+			samples[i] = samples[int(float64(i)*step)]
+		}
+		samples = samples[:cfg.Samples]
+	}
+	latencies = append(latencies,
+		suite.measureBenchmarkChange(t, routeUpdateLatency, samples, func(t *testing.T, r *benchmarkRoute) {
+			original := r.route.DeepCopy()
+			r.route.Spec.Rules[0].BackendRefs[0].Name = InfraBackendServiceNameV2
+			require.NoError(t, suite.Client.Patch(t.Context(), r.route, client.MergeFrom(original)), "error patching HTTPRoute")
+			r.expected.Backend = InfraBackendServiceNameV2
+		}),
+		suite.measureBenchmarkChange(t, routeDeletionLatency, samples, func(t *testing.T, r *benchmarkRoute) {
+			require.NoError(t, suite.Client.Delete(t.Context(), r.route), "error deleting HTTPRoute")
+			r.expected = http.ExpectedResponse{
+				Request:  r.expected.Request,
+				Response: http.Response{StatusCodes: []int{404}},
+			}
+		}),
+	)
+
+	for _, l := range latencies {
+		tlog.Logf(t, "Benchmark: %s latency over %d HTTPRoutes (%d failures): p50=%v p90=%v p99=%v max=%v",
+			l.Name, l.Samples, l.Failures, l.P50.Duration, l.P90.Duration, l.P99.Duration, l.Max.Duration)
+	}
+
+	return &confv1.BenchmarkReport{
+		TypeMeta: v1.TypeMeta{
+			APIVersion: confv1.GroupVersion.String(),
+			Kind:       "BenchmarkReport",
+		},
+		Date:              time.Now().Format(time.RFC3339),
+		Mode:              suite.mode,
+		Implementation:    suite.implementation,
+		GatewayAPIVersion: suite.apiVersion,
+		GatewayAPIChannel: suite.apiChannel,
+		Routes:            cfg.Routes,
+		Namespaces:        cfg.Namespaces,
+		Latencies:         latencies,
+	}
+}
+
+// deleteBenchmarkRoutes deletes the HTTPRoutes of the namespaces and waits for
+// them to be gone.
+func (suite *ConformanceTestSuite) deleteBenchmarkRoutes(t *testing.T, namespaces []string) {
+	t.Helper()
+
+	for _, ns := range namespaces {
+		err := suite.Client.DeleteAllOf(t.Context(), &gatewayv1.HTTPRoute{}, client.InNamespace(ns))
+		require.NoError(t, err, "error deleting the HTTPRoutes of namespace %s", ns)
+	}
+	err := wait.PollUntilContextTimeout(t.Context(), suite.TimeoutConfig.DefaultPollInterval, suite.TimeoutConfig.DeleteTimeout, true, func(ctx context.Context) (bool, error) {
+		for _, ns := range namespaces {
+			routes := &gatewayv1.HTTPRouteList{}
+			if err := suite.Client.List(ctx, routes, client.InNamespace(ns)); err != nil {
+				tlog.Logf(t, "Benchmark: error listing the HTTPRoutes of namespace %s: %v", ns, err)
+				return false, nil
+			}
+			if len(routes.Items) > 0 {
+				return false, nil
+			}
+		}
+		return true, nil
+	})
+	require.NoError(t, err, "timed out waiting for the HTTPRoutes of a previous run to be deleted")
+}
+
+// newBenchmarkRoute returns the i-th HTTPRoute of the benchmark, with a
+// hostname of its own, and the request expected to be served by it.
+func newBenchmarkRoute(t *testing.T, gwNN types.NamespacedName, gwAddr, namespace string, i int) *benchmarkRoute {
+	hostname := fmt.Sprintf("route-%d.benchmark.example.com", i)
+	r := &benchmarkRoute{
+		route: &gatewayv1.HTTPRoute{
+			ObjectMeta: v1.ObjectMeta{
+				Name:      fmt.Sprintf("route-%d", i),
+				Namespace: namespace,
+			},
+			Spec: gatewayv1.HTTPRouteSpec{
+				CommonRouteSpec: gatewayv1.CommonRouteSpec{
+					ParentRefs: []gatewayv1.ParentReference{{
+						Name:      gatewayv1.ObjectName(gwNN.Name),
+						Namespace: ptr.To(gatewayv1.Namespace(gwNN.Namespace)),
+					}},
+				},
+				Hostnames: []gatewayv1.Hostname{gatewayv1.Hostname(hostname)},
+				Rules: []gatewayv1.HTTPRouteRule{{
+					BackendRefs: []gatewayv1.HTTPBackendRef{{
+						BackendRef: gatewayv1.BackendRef{
+							BackendObjectReference: gatewayv1.BackendObjectReference{
+								Name:      InfraBackendServiceNameV1,
+								Namespace: ptr.To(gatewayv1.Namespace(InfrastructureNamespace)),
+								Port:      ptr.To(gatewayv1.PortNumber(8080)),
+							},
+						},
+					}},
+				}},
+			},
+		},
+		expected: http.ExpectedResponse{
+			Request:   http.Request{Host: hostname, Path: "/"},
+			Backend:   InfraBackendServiceNameV1,
+			Namespace: InfrastructureNamespace,
+		},
+	}
+	r.request = http.MakeRequest(t, &r.expected, gwAddr, "HTTP", "http")
+	return r
+}
+
+// observeBenchmarkRoutes polls the HTTPRoutes as they are created, recording
+// when each of them is accepted by the Gateway and serves its first
+// successful request, until all of them did or BenchmarkConfig.Timeout
+// elapses.
+func (suite *ConformanceTestSuite) observeBenchmarkRoutes(t *testing.T, gwNN types.NamespacedName, created <-chan *benchmarkRoute) {
+	deadline := time.Now().Add(suite.BenchmarkConfig.Timeout)
+	var pending []*benchmarkRoute
+	for creating := true; ; {
+	collect:
+		for {
+			select {
+			case r, ok := <-created:
+				if !ok {
+					creating = false
+					break collect
+				}
+				pending = append(pending, r)
+			default:
+				break collect
+			}
+		}
+
+		suite.recordAcceptedBenchmarkRoutes(t, gwNN, pending)
+		suite.recordServedBenchmarkRoutes(pending)
+		pending = slices.DeleteFunc(pending, func(r *benchmarkRoute) bool {
+			return r.accepted != 0 && r.served != 0
+		})
+		if !creating && len(pending) == 0 {
+			return
+		}
+		if time.Now().After(deadline) {
+			tlog.Logf(t, "Benchmark: timed out after %v with %d HTTPRoutes not accepted or served yet", suite.BenchmarkConfig.Timeout, len(pending))
+			return
+		}
+		time.Sleep(suite.TimeoutConfig.DefaultPollInterval)
+	}
+}
+
+// recordAcceptedBenchmarkRoutes records the HTTPRoutes that have just been
+// accepted by the Gateway.
+func (suite *ConformanceTestSuite) recordAcceptedBenchmarkRoutes(t *testing.T, gwNN types.NamespacedName, routes []*benchmarkRoute) {
+	byNamespace := map[string][]*benchmarkRoute{}
+	for _, r := range routes {
+		if r.accepted == 0 {
+			byNamespace[r.route.Namespace] = append(byNamespace[r.route.Namespace], r)
+		}
+	}
+
+	for namespace, nsRoutes := range byNamespace {
+		ctx, cancel := context.WithTimeout(context.Background(), suite.TimeoutConfig.GetTimeout)
+		list := &gatewayv1.HTTPRouteList{}
+		err := suite.Client.List(ctx, list, client.InNamespace(namespace))
+		cancel()
+		if err != nil {
+			tlog.Logf(t, "Benchmark: error listing HTTPRoutes in namespace %s: %v", namespace, err)
+			continue
+		}
+		now := time.Now()
+		for _, r := range nsRoutes {
+			idx := slices.IndexFunc(list.Items, func(route gatewayv1.HTTPRoute) bool {
+				return route.Name == r.route.Name
+			})
+			if idx != -1 && benchmarkRouteAccepted(&list.Items[idx], gwNN) {
+				r.accepted = now.Sub(r.createdAt)
+			}
+		}
+	}
+}
+
+// benchmarkRouteAccepted returns whether the latest generation of the
+// HTTPRoute has been accepted by the Gateway.
+func benchmarkRouteAccepted(route *gatewayv1.HTTPRoute, gwNN types.NamespacedName) bool {
+	for _, parent := range route.Status.Parents {
+		if string(parent.ParentRef.Name) != gwNN.Name || parent.ParentRef.Namespace == nil || string(*parent.ParentRef.Namespace) != gwNN.Namespace {
+			continue
+		}
+		cond := apimeta.FindStatusCondition(parent.Conditions, string(gatewayv1.RouteConditionAccepted))
+		return cond != nil && cond.Status == v1.ConditionTrue && cond.ObservedGeneration >= route.Generation
+	}
+	return false
+}
+
+// recordServedBenchmarkRoutes sends a request to each of the HTTPRoutes that
+// haven't served a successful request yet, and records those that now do.
+func (suite *ConformanceTestSuite) recordServedBenchmarkRoutes(routes []*benchmarkRoute) {
+	var g errgroup.Group
+	g.SetLimit(suite.BenchmarkConfig.ConcurrentRequests)
+	for _, r := range routes {
+		if r.served != 0 {
+			continue
+		}
+		g.Go(func() error {
+			cReq, cRes, err := suite.RoundTripper.CaptureRoundTrip(r.request)
+			if err == nil && http.CompareRoundTrip(r.request.T, &r.request, cReq, cRes, r.expected) == nil {
+				r.served = time.Since(r.createdAt)
+			}
+			return nil
+		})
+	}
+	_ = g.Wait()
+}
+
+// measureBenchmarkChange applies change to each of the sampled HTTPRoutes,
+// one at a time, and measures how long it takes for the changed expected
+// response to be served, using the usual convergence criteria.
+func (suite *ConformanceTestSuite) measureBenchmarkChange(t *testing.T, name string, samples []*benchmarkRoute, change func(t *testing.T, r *benchmarkRoute)) confv1.LatencyReport {
+	var (
+		latencies []time.Duration
+		failures  int
+	)
+	for _, r := range samples {
+		ok := t.Run(fmt.Sprintf("%s %s", name, client.ObjectKeyFromObject(r.route)), func(t *testing.T) {
+			change(t, r)
+			changedAt := time.Now()
+
+			var latency time.Duration
+			http.AwaitConvergence(t, suite.TimeoutConfig, func(elapsed time.Duration) bool {
+				cReq, cRes, err := suite.RoundTripper.CaptureRoundTrip(r.request)
+				if err != nil || http.CompareRoundTrip(t, &r.request, cReq, cRes, r.expected) != nil {
+					latency = 0
+					return false
+				}
+				if latency == 0 {
+					latency = time.Since(changedAt)
+				}
+				return true
+			})
+			latencies = append(latencies, latency)
+		})
+		if !ok {
+			failures++
+		}
+	}
+	return summarizeLatencies(name, latencies, failures)
+}
+
+// summarizeLatencies computes the percentiles of the latencies with the
+// nearest-rank method.
+func summarizeLatencies(name string, latencies []time.Duration, failures int) confv1.LatencyReport {
+	report := confv1.LatencyReport{
+		Name:     name,
+		Samples:  len(latencies),
+		Failures: failures,
+	}
+	if len(latencies) == 0 {
+		return report
+	}
+
+	sorted := slices.Sorted(slices.Values(latencies))
+	percentile := func(p int) v1.Duration {
+		return v1.Duration{Duration: sorted[(len(sorted)*p+99)/100-1]}
+	}
+	report.P50 = percentile(50)
+	report.P90 = percentile(90)
+	report.P99 = percentile(99)
+	report.Max = v1.Duration{Duration: sorted[len(sorted)-1]}
+	return report
+}
@@ -0,0 +1,106 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package suite
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	confv1 "sigs.k8s.io/gateway-api/conformance/apis/v1"
+)
+
+func TestSummarizeLatencies(t *testing.T) {
+	ms := func(n int) metav1.Duration {
+		return metav1.Duration{Duration: time.Duration(n) * time.Millisecond}
+	}
+
+	testCases := []struct {
+		name      string
+		latencies []time.Duration
+		failures  int
+		expected  confv1.LatencyReport
+	}{
+		{
+			name:     "no samples",
+			failures: 3,
+			expected: confv1.LatencyReport{Name: "test", Failures: 3},
+		},
+		{
+			name:      "single sample",
+			latencies: []time.Duration{5 * time.Millisecond},
+			expected:  confv1.LatencyReport{Name: "test", Samples: 1, P50: ms(5), P90: ms(5), P99: ms(5), Max: ms(5)},
+		},
+		{
+			name: "unsorted samples",
+			latencies: func() []time.Duration {
+				var latencies []time.Duration
+				for i := 100; i > 0; i-- {
+					latencies = append(latencies, time.Duration(i)*time.Millisecond)
+				}
+				return latencies
+			}(),
+			failures: 1,
+			expected: confv1.LatencyReport{Name: "test", Samples: 100, Failures: 1, P50: ms(50), P90: ms(90), P99: ms(99), Max: ms(100)},
+		},
+		{
+			name:      "percentiles round up to the nearest rank",
+			latencies: []time.Duration{1 * time.Millisecond, 2 * time.Millisecond, 3 * time.Millisecond},
+			expected:  confv1.LatencyReport{Name: "test", Samples: 3, P50: ms(2), P90: ms(3), P99: ms(3), Max: ms(3)},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, summarizeLatencies("test", tc.latencies, tc.failures))
+		})
+	}
+}
+
+func TestBenchmarkRouteAccepted(t *testing.T) {
+	gwNN := types.NamespacedName{Name: benchmarkName, Namespace: InfrastructureNamespace}
+	route := func(gwName string, status metav1.ConditionStatus, observedGeneration int64) *gatewayv1.HTTPRoute {
+		return &gatewayv1.HTTPRoute{
+			ObjectMeta: metav1.ObjectMeta{Generation: 2},
+			Status: gatewayv1.HTTPRouteStatus{
+				RouteStatus: gatewayv1.RouteStatus{
+					Parents: []gatewayv1.RouteParentStatus{{
+						ParentRef: gatewayv1.ParentReference{
+							Name:      gatewayv1.ObjectName(gwName),
+							Namespace: ptr.To(gatewayv1.Namespace(InfrastructureNamespace)),
+						},
+						Conditions: []metav1.Condition{{
+							Type:               string(gatewayv1.RouteConditionAccepted),
+							Status:             status,
+							ObservedGeneration: observedGeneration,
+						}},
+					}},
+				},
+			},
+		}
+	}
+
+	require.True(t, benchmarkRouteAccepted(route(benchmarkName, metav1.ConditionTrue, 2), gwNN))
+	require.False(t, benchmarkRouteAccepted(route(benchmarkName, metav1.ConditionFalse, 2), gwNN), "route not accepted")
+	require.False(t, benchmarkRouteAccepted(route(benchmarkName, metav1.ConditionTrue, 1), gwNN), "stale condition")
+	require.False(t, benchmarkRouteAccepted(route("other", metav1.ConditionTrue, 2), gwNN), "other parent")
+}
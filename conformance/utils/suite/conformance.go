@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"k8s.io/apimachinery/pkg/util/sets"
 
@@ -45,34 +46,55 @@ func (test *ConformanceTest) Run(t *testing.T, suite *ConformanceTestSuite) {
 	if test.Parallel && !suite.DisableParallelTests {
 		t.Parallel()
 	}
+	test.run(t, suite)
+}
+
+// run contains the logic of Run, except for marking the test as parallel,
+// so that it can be called for every run of a repeated test.
+func (test *ConformanceTest) run(t *testing.T, suite *ConformanceTestSuite) {
+	// check that all features exercised by the test have been opted into by
+	// the suite and that the test has not been filtered out
+	if reason := suite.skipReason(*test); reason != "" {
+		t.Skipf("Skipping %s: %s", test.ShortName, reason)
+	}
+	// check that the manifests of the test are compatible with the installed
+	// CRDs when running against CRDs of another version
+	if reason := suite.crdVersionSkewSkipReason(*test); reason != "" {
+		t.Skipf("Skipping %s: %s", test.ShortName, reason)
+	}
 
 	var featuresInfo string
-	// Test against features if the user hasn't focused on a single test
 	if suite.RunTest == "" {
-		// Check that all features exercised by the test have been opted into by
-		// the suite.
 		for i, featureName := range test.Features {
-			if !suite.SupportedFeatures.Has(featureName) {
-				t.Skipf("Skipping %s: suite does not support %s", test.ShortName, featureName)
-			}
 			feature := features.GetFeature(featureName)
-			featuresInfo = fmt.Sprintf("%s%s-%s", featuresInfo, feature.Name, feature.Channel)
+			if feature.Name == "" {
+				// implementation-specific features don't belong to a channel
+				featuresInfo = fmt.Sprintf("%s%s", featuresInfo, featureName)
+			} else {
+				featuresInfo = fmt.Sprintf("%s%s-%s", featuresInfo, feature.Name, feature.Channel)
+			}
 			if i < len(test.Features)-1 {
 				featuresInfo += ", "
 			}
 		}
 	}
 
-	// check that the test should not be skipped
-	if suite.SkipTests.Has(test.ShortName) || suite.RunTest != "" && suite.RunTest != test.ShortName {
-		t.Skipf("Skipping %s: test explicitly skipped", test.ShortName)
-	}
-
+	start := time.Now()
 	for _, manifestLocation := range test.Manifests {
 		tlog.Logf(t, "Applying %s", manifestLocation)
 		suite.Applier.MustApplyWithCleanup(t, suite.Client, suite.TimeoutConfig, manifestLocation, suite.CleanupTestResources)
 	}
 
+	// Cleanup functions run in reverse order, so the debug bundle is written
+	// before the test resources are deleted.
+	if suite.DebugBundleDir != "" {
+		t.Cleanup(func() {
+			if t.Failed() {
+				suite.writeDebugBundle(t, *test, start)
+			}
+		})
+	}
+
 	if featuresInfo != "" {
 		tlog.Logf(t, "Running %s, relying on the following features: %s", test.ShortName, featuresInfo)
 	}
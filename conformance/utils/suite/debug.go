@@ -0,0 +1,210 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package suite
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/conformance/utils/kubernetes"
+	"sigs.k8s.io/gateway-api/conformance/utils/roundtripper"
+	"sigs.k8s.io/gateway-api/conformance/utils/tlog"
+	"sigs.k8s.io/gateway-api/pkg/features"
+)
+
+// debugBundleRoundTrip is the representation of the last round trip made by a
+// failed test in its debug bundle.
+type debugBundleRoundTrip struct {
+	Time             time.Time                     `json:"time"`
+	Request          string                        `json:"request"`
+	Error            string                        `json:"error,omitempty"`
+	CapturedRequest  *roundtripper.CapturedRequest `json:"capturedRequest,omitempty"`
+	CapturedResponse *debugBundleResponse          `json:"capturedResponse,omitempty"`
+}
+
+// debugBundleResponse holds the fields of a roundtripper.CapturedResponse
+// which can be serialized.
+type debugBundleResponse struct {
+	StatusCode      int                           `json:"statusCode"`
+	ContentLength   int64                         `json:"contentLength"`
+	Protocol        string                        `json:"protocol"`
+	Headers         http.Header                   `json:"headers,omitempty"`
+	Trailers        http.Header                   `json:"trailers,omitempty"`
+	Body            string                        `json:"body,omitempty"`
+	RedirectRequest *roundtripper.RedirectRequest `json:"redirectRequest,omitempty"`
+}
+
+// debugBundleNamespaces returns the namespaces to collect in the debug bundle
+// of the given test: the base namespaces of the features it relies on and
+// the namespaces used by its manifests.
+func (suite *ConformanceTestSuite) debugBundleNamespaces(test ConformanceTest) ([]string, error) {
+	namespaces := sets.New[string]()
+	if suite.SupportedFeatures.Has(features.SupportGateway) || sets.New(test.Features...).Has(features.SupportGateway) {
+		namespaces.Insert(InfrastructureNamespace, AppBackendNamespace, WebBackendNamespace)
+	}
+	if suite.SupportedFeatures.Has(features.SupportMesh) || sets.New(test.Features...).Has(features.SupportMesh) {
+		namespaces.Insert(MeshNamespace, MeshConsumerNamespace)
+	}
+
+	var errs []error
+	for _, manifestLocation := range test.Manifests {
+		manifestNamespaces, err := suite.Applier.ManifestNamespaces(manifestLocation, suite.TimeoutConfig)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		namespaces.Insert(manifestNamespaces...)
+	}
+	return sets.List(namespaces), errors.Join(errs...)
+}
+
+// writeDebugBundle writes the state relevant to debug a failed test to a
+// directory named after the test in suite.DebugBundleDir. Errors are logged
+// and don't prevent the rest of the bundle from being written.
+func (suite *ConformanceTestSuite) writeDebugBundle(t *testing.T, test ConformanceTest, since time.Time) {
+	dir := filepath.Join(suite.DebugBundleDir, filepath.FromSlash(t.Name()))
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		tlog.Logf(t, "Unable to create debug bundle directory %s: %v", dir, err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), suite.TimeoutConfig.DefaultTestTimeout)
+	defer cancel()
+
+	var errs []error
+	if suite.GatewayClassName != "" {
+		gwc := &gatewayv1.GatewayClass{}
+		if err := suite.Client.Get(ctx, types.NamespacedName{Name: suite.GatewayClassName}, gwc); err != nil {
+			errs = append(errs, fmt.Errorf("getting GatewayClass %s: %w", suite.GatewayClassName, err))
+		} else {
+			gwc.SetGroupVersionKind(gatewayv1.SchemeGroupVersion.WithKind("GatewayClass"))
+			errs = append(errs, writeDebugBundleYAML(dir, "gatewayclass.yaml", gwc))
+		}
+	}
+
+	namespaces, err := suite.debugBundleNamespaces(test)
+	errs = append(errs, err)
+	for _, ns := range namespaces {
+		resources, err := kubernetes.GatewayAPIResources(ctx, suite.Client, ns)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("listing Gateway API resources in namespace %s: %w", ns, err))
+		}
+		if len(resources) > 0 {
+			errs = append(errs, writeDebugBundleYAML(dir, fmt.Sprintf("resources-%s.yaml", ns), resources))
+		}
+
+		events := &corev1.EventList{}
+		if err := suite.Client.List(ctx, events, client.InNamespace(ns)); err != nil {
+			errs = append(errs, fmt.Errorf("listing events in namespace %s: %w", ns, err))
+		} else if len(events.Items) > 0 {
+			errs = append(errs, writeDebugBundleYAML(dir, fmt.Sprintf("events-%s.yaml", ns), events.Items))
+		}
+
+		errs = append(errs, suite.writeDebugBundleEchoLogs(ctx, dir, ns, since))
+	}
+
+	if recorder, ok := suite.RoundTripper.(*roundtripper.RecordingRoundTripper); ok {
+		if roundTrip, found := recorder.LastRoundTrip(t); found {
+			errs = append(errs, writeDebugBundleYAML(dir, "roundtrip.yaml", newDebugBundleRoundTrip(roundTrip)))
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		tlog.Logf(t, "Debug bundle written to %s with errors: %v", dir, err)
+		return
+	}
+	tlog.Logf(t, "Debug bundle written to %s", dir)
+}
+
+// writeDebugBundleEchoLogs writes the logs of the echo pods in the given
+// namespace, one file per app, from the given time onwards.
+func (suite *ConformanceTestSuite) writeDebugBundleEchoLogs(ctx context.Context, dir, ns string, since time.Time) error {
+	if suite.Clientset == nil {
+		return nil
+	}
+	pods := &corev1.PodList{}
+	if err := suite.Client.List(ctx, pods, client.InNamespace(ns), client.HasLabels{"app"}); err != nil {
+		return fmt.Errorf("listing pods in namespace %s: %w", ns, err)
+	}
+
+	apps := sets.New[string]()
+	for _, pod := range pods.Items {
+		apps.Insert(pod.Labels["app"])
+	}
+
+	var errs []error
+	for _, app := range sets.List(apps) {
+		logs, err := kubernetes.DumpEchoLogs(ctx, ns, app, suite.Client, suite.Clientset, since)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("dumping logs of %s in namespace %s: %w", app, ns, err))
+			continue
+		}
+		if len(logs) == 0 {
+			continue
+		}
+		path := filepath.Join(dir, fmt.Sprintf("logs-%s-%s.log", ns, app))
+		if err := os.WriteFile(path, []byte(strings.Join(logs, "\n")), 0o600); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func newDebugBundleRoundTrip(roundTrip roundtripper.RecordedRoundTrip) debugBundleRoundTrip {
+	res := debugBundleRoundTrip{
+		Time:            roundTrip.Time,
+		Request:         roundTrip.Request.String(),
+		CapturedRequest: roundTrip.CapturedRequest,
+	}
+	if roundTrip.Err != nil {
+		res.Error = roundTrip.Err.Error()
+	}
+	if cRes := roundTrip.CapturedResponse; cRes != nil {
+		res.CapturedResponse = &debugBundleResponse{
+			StatusCode:      cRes.StatusCode,
+			ContentLength:   cRes.ContentLength,
+			Protocol:        cRes.Protocol,
+			Headers:         cRes.Headers,
+			Trailers:        cRes.Trailers,
+			Body:            string(cRes.Body),
+			RedirectRequest: cRes.RedirectRequest,
+		}
+	}
+	return res
+}
+
+func writeDebugBundleYAML(dir, name string, obj any) error {
+	data, err := yaml.Marshal(obj)
+	if err != nil {
+		return fmt.Errorf("marshaling %s: %w", name, err)
+	}
+	return os.WriteFile(filepath.Join(dir, name), data, 0o600)
+}
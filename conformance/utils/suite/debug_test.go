@@ -0,0 +1,163 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package suite
+
+import (
+	"io/fs"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	fakeclientset "k8s.io/client-go/kubernetes/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/conformance/utils/config"
+	"sigs.k8s.io/gateway-api/conformance/utils/kubernetes"
+	"sigs.k8s.io/gateway-api/conformance/utils/roundtripper"
+	"sigs.k8s.io/gateway-api/pkg/features"
+)
+
+type staticRoundTripper struct{}
+
+func (staticRoundTripper) CaptureRoundTrip(request roundtripper.Request) (*roundtripper.CapturedRequest, *roundtripper.CapturedResponse, error) {
+	return &roundtripper.CapturedRequest{Path: request.URL.Path, Namespace: InfrastructureNamespace},
+		&roundtripper.CapturedResponse{StatusCode: 503, Protocol: "HTTP/1.1"}, nil
+}
+
+func TestWriteDebugBundle(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, apiextensionsv1.AddToScheme(scheme))
+	require.NoError(t, gatewayv1.Install(scheme))
+
+	httpRouteCRD := &apiextensionsv1.CustomResourceDefinition{
+		ObjectMeta: metav1.ObjectMeta{Name: "httproutes.gateway.networking.k8s.io"},
+		Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+			Group: gatewayv1.GroupName,
+			Scope: apiextensionsv1.NamespaceScoped,
+			Names: apiextensionsv1.CustomResourceDefinitionNames{
+				Kind:     "HTTPRoute",
+				ListKind: "HTTPRouteList",
+			},
+			Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+				{Name: "v1", Served: true, Storage: true},
+			},
+		},
+	}
+	gwc := &gatewayv1.GatewayClass{
+		ObjectMeta: metav1.ObjectMeta{Name: "gateway-conformance"},
+		Spec:       gatewayv1.GatewayClassSpec{ControllerName: "example.com/controller"},
+	}
+	routes := []client.Object{
+		&gatewayv1.HTTPRoute{ObjectMeta: metav1.ObjectMeta{Name: "infra-route", Namespace: InfrastructureNamespace}},
+		&gatewayv1.HTTPRoute{ObjectMeta: metav1.ObjectMeta{Name: "test-route", Namespace: "test-ns"}},
+		&gatewayv1.HTTPRoute{ObjectMeta: metav1.ObjectMeta{Name: "other-route", Namespace: "other-ns"}},
+	}
+	event := &corev1.Event{
+		ObjectMeta: metav1.ObjectMeta{Name: "infra-route.1", Namespace: InfrastructureNamespace},
+		Reason:     "Reconciled",
+	}
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "infra-backend-v1-abc",
+			Namespace: InfrastructureNamespace,
+			Labels:    map[string]string{"app": "infra-backend-v1"},
+		},
+	}
+
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(httpRouteCRD, gwc, event, pod).
+		WithObjects(routes...).
+		Build()
+
+	recorder := &roundtripper.RecordingRoundTripper{RoundTripper: staticRoundTripper{}}
+	dir := t.TempDir()
+	suite := &ConformanceTestSuite{
+		Client:            c,
+		Clientset:         fakeclientset.NewClientset(pod),
+		RoundTripper:      recorder,
+		GatewayClassName:  gwc.Name,
+		SupportedFeatures: sets.New(features.SupportGateway),
+		TimeoutConfig:     config.DefaultTimeoutConfig(),
+		DebugBundleDir:    dir,
+		Applier: kubernetes.Applier{
+			ManifestFS: []fs.FS{fstest.MapFS{
+				"test.yaml": &fstest.MapFile{Data: []byte(`
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: test-ns
+---
+apiVersion: gateway.networking.k8s.io/v1
+kind: HTTPRoute
+metadata:
+  name: test-route
+  namespace: test-ns
+`)},
+			}},
+		},
+	}
+	test := ConformanceTest{
+		ShortName: "DebugBundle",
+		Features:  []features.FeatureName{features.SupportGateway, features.SupportHTTPRoute},
+		Manifests: []string{"test.yaml"},
+	}
+
+	_, _, err := recorder.CaptureRoundTrip(roundtripper.Request{T: t, URL: url.URL{Path: "/failing"}})
+	require.NoError(t, err)
+
+	suite.writeDebugBundle(t, test, time.Now())
+
+	bundleDir := filepath.Join(dir, t.Name())
+	files, err := os.ReadDir(bundleDir)
+	require.NoError(t, err)
+	var names []string
+	for _, f := range files {
+		names = append(names, f.Name())
+	}
+	assert.ElementsMatch(t, []string{
+		"gatewayclass.yaml",
+		"resources-gateway-conformance-infra.yaml",
+		"resources-test-ns.yaml",
+		"events-gateway-conformance-infra.yaml",
+		"logs-gateway-conformance-infra-infra-backend-v1.log",
+		"roundtrip.yaml",
+	}, names)
+
+	data, err := os.ReadFile(filepath.Join(bundleDir, "resources-test-ns.yaml"))
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "name: test-route")
+	assert.NotContains(t, string(data), "other-route")
+
+	data, err = os.ReadFile(filepath.Join(bundleDir, "roundtrip.yaml"))
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "path: /failing")
+	assert.Contains(t, string(data), "statusCode: 503")
+}
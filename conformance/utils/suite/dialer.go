@@ -0,0 +1,81 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package suite
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	"sigs.k8s.io/gateway-api/conformance/utils/dialer"
+)
+
+// The values of the GatewayDialer option.
+const (
+	// DirectGatewayDialer dials Gateway addresses from where the tests run.
+	DirectGatewayDialer = "direct"
+	// PortForwardGatewayDialer port-forwards to the pods serving Gateway
+	// addresses through the API server.
+	PortForwardGatewayDialer = "port-forward"
+	// ExecGatewayDialer runs a client connecting to Gateway addresses in a
+	// helper pod through the API server.
+	ExecGatewayDialer = "exec"
+)
+
+// gatewayDialContext returns the function opening connections to Gateways
+// selected by the GatewayDialer option, or nil when they are dialed directly.
+func gatewayDialContext(options ConformanceOptions) (func(ctx context.Context, network, address string) (net.Conn, error), error) {
+	switch options.GatewayDialer {
+	case "", DirectGatewayDialer:
+		return nil, nil
+	case PortForwardGatewayDialer:
+		d := &dialer.PortForwardDialer{
+			Client:     options.Client,
+			Clientset:  options.Clientset,
+			RestConfig: options.RestConfig,
+		}
+		return d.DialContext, nil
+	case ExecGatewayDialer:
+		namespace, name, ok := strings.Cut(options.GatewayDialerExecPod, "/")
+		if !ok || namespace == "" || name == "" {
+			return nil, fmt.Errorf("the %s Gateway dialer requires a helper pod as namespace/name, got %q", ExecGatewayDialer, options.GatewayDialerExecPod)
+		}
+		d := &dialer.ExecDialer{
+			Clientset:  options.Clientset,
+			RestConfig: options.RestConfig,
+			Namespace:  namespace,
+			Pod:        name,
+		}
+		return d.DialContext, nil
+	default:
+		return nil, fmt.Errorf("unknown Gateway dialer %q, must be one of %s, %s or %s", options.GatewayDialer, DirectGatewayDialer, PortForwardGatewayDialer, ExecGatewayDialer)
+	}
+}
+
+// gatewaysDialedDirectly returns whether Gateway addresses are dialed from
+// where the tests run, rather than with DialContext or a Gateway dialer.
+func gatewaysDialedDirectly(options ConformanceOptions) bool {
+	return options.DialContext == nil && (options.GatewayDialer == "" || options.GatewayDialer == DirectGatewayDialer)
+}
+
+// http3Unavailable returns whether the default RoundTripper is used while
+// Gateways aren't dialed directly: its HTTP/3 requests can't be sent with the
+// dial context, as QUIC runs over UDP, so HTTP/3 tests are skipped.
+func http3Unavailable(options ConformanceOptions) bool {
+	return options.RoundTripper == nil && !gatewaysDialedDirectly(options)
+}
@@ -0,0 +1,172 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package suite
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	confv1 "sigs.k8s.io/gateway-api/conformance/apis/v1"
+	"sigs.k8s.io/gateway-api/pkg/features"
+)
+
+// -----------------------------------------------------------------------------
+// Implementation-Specific Tests - Public Functions
+// -----------------------------------------------------------------------------
+
+// RegisterImplementationSpecificTests allows implementations to register
+// their own tests, to be run by the suite alongside the upstream conformance
+// tests. The tests are registered under an implementation-defined feature
+// namespace (e.g. "example.com"): each test must rely on at least one feature
+// named "<featureNamespace>/<name>", and may additionally rely on upstream
+// features. Implementation-specific features are enabled like any other
+// feature, and the results of these tests are reported in the
+// implementationSpecific section of the ConformanceReport, rather than in the
+// conformance profiles.
+func RegisterImplementationSpecificTests(featureNamespace string, tests ...ConformanceTest) {
+	if featureNamespace == "" || strings.Contains(featureNamespace, "/") {
+		panic(fmt.Sprintf("invalid implementation-specific feature namespace %q", featureNamespace))
+	}
+	prefix := featureNamespace + "/"
+	for _, test := range tests {
+		if test.ShortName == "" {
+			panic("implementation-specific test must have a ShortName")
+		}
+		if _, ok := implementationSpecificTestNamespaces[test.ShortName]; ok {
+			panic(fmt.Sprintf("implementation-specific test named %q is already registered", test.ShortName))
+		}
+		hasNamespacedFeature := false
+		for _, feature := range test.Features {
+			switch {
+			case strings.HasPrefix(string(feature), prefix) && len(feature) > len(prefix):
+				hasNamespacedFeature = true
+			case features.GetFeature(feature).Name == "":
+				panic(fmt.Sprintf("implementation-specific test %q relies on feature %q, which is neither an upstream feature nor in namespace %q", test.ShortName, feature, featureNamespace))
+			}
+		}
+		if !hasNamespacedFeature {
+			panic(fmt.Sprintf("implementation-specific test %q must rely on at least one feature in namespace %q", test.ShortName, featureNamespace))
+		}
+	}
+
+	for _, test := range tests {
+		implementationSpecificTestNamespaces[test.ShortName] = featureNamespace
+		implementationSpecificTests = append(implementationSpecificTests, test)
+	}
+}
+
+// ImplementationSpecificTests returns all the registered
+// implementation-specific tests, in registration order.
+func ImplementationSpecificTests() []ConformanceTest {
+	return append([]ConformanceTest(nil), implementationSpecificTests...)
+}
+
+// -----------------------------------------------------------------------------
+// Implementation-Specific Tests - Private Registry
+// -----------------------------------------------------------------------------
+
+var (
+	// implementationSpecificTests contains the registered
+	// implementation-specific tests, in registration order.
+	implementationSpecificTests []ConformanceTest
+
+	// implementationSpecificTestNamespaces maps the short name of the
+	// registered implementation-specific tests to their feature namespace.
+	implementationSpecificTestNamespaces = map[string]string{}
+)
+
+// implementationSpecificFeatures returns the implementation-specific features
+// the registered tests rely on.
+func implementationSpecificFeatures() FeaturesSet {
+	res := FeaturesSet{}
+	for _, test := range implementationSpecificTests {
+		prefix := implementationSpecificTestNamespaces[test.ShortName] + "/"
+		for _, feature := range test.Features {
+			if strings.HasPrefix(string(feature), prefix) {
+				res.Insert(feature)
+			}
+		}
+	}
+	return res
+}
+
+// buildImplementationSpecificReports creates a report for each feature
+// namespace with results, sorted by namespace.
+func buildImplementationSpecificReports(results map[string]testResult, supportedFeatures FeaturesSet) []confv1.ImplementationSpecificReport {
+	reports := map[string]*confv1.ImplementationSpecificReport{}
+	namespaceFeatures := map[string]FeaturesSet{}
+	for _, tr := range results {
+		ns, ok := implementationSpecificTestNamespaces[tr.test.ShortName]
+		if !ok || tr.result == testProvisionalSkipped {
+			continue
+		}
+		report, ok := reports[ns]
+		if !ok {
+			report = &confv1.ImplementationSpecificReport{Namespace: ns}
+			reports[ns] = report
+			namespaceFeatures[ns] = FeaturesSet{}
+		}
+		for _, feature := range tr.test.Features {
+			if strings.HasPrefix(string(feature), ns+"/") {
+				namespaceFeatures[ns].Insert(feature)
+			}
+		}
+
+		switch tr.result {
+		case testSucceeded:
+			report.Passed++
+		case testFailed:
+			report.Failed++
+			report.FailedTests = append(report.FailedTests, tr.test.ShortName)
+		case testSkipped:
+			report.Skipped++
+			report.SkippedTests = append(report.SkippedTests, tr.test.ShortName)
+		}
+	}
+
+	if len(reports) == 0 {
+		return nil
+	}
+	res := make([]confv1.ImplementationSpecificReport, 0, len(reports))
+	for ns, report := range reports {
+		switch {
+		case report.Failed > 0:
+			report.Result = confv1.Failure
+		case report.Skipped > 0:
+			report.Result = confv1.Partial
+		default:
+			report.Result = confv1.Success
+		}
+		sort.Strings(report.FailedTests)
+		sort.Strings(report.SkippedTests)
+		for _, f := range sets.List(namespaceFeatures[ns]) {
+			if supportedFeatures.Has(f) {
+				report.SupportedFeatures = append(report.SupportedFeatures, string(f))
+			} else {
+				report.UnsupportedFeatures = append(report.UnsupportedFeatures, string(f))
+			}
+		}
+		res = append(res, *report)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Namespace < res[j].Namespace
+	})
+	return res
+}
@@ -0,0 +1,145 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package suite
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	confv1 "sigs.k8s.io/gateway-api/conformance/apis/v1"
+	"sigs.k8s.io/gateway-api/pkg/features"
+)
+
+// resetImplementationSpecificTests clears the registry of
+// implementation-specific tests for the duration of the test.
+func resetImplementationSpecificTests(t *testing.T) {
+	tests, namespaces := implementationSpecificTests, implementationSpecificTestNamespaces
+	implementationSpecificTests, implementationSpecificTestNamespaces = nil, map[string]string{}
+	t.Cleanup(func() {
+		implementationSpecificTests, implementationSpecificTestNamespaces = tests, namespaces
+	})
+}
+
+func TestRegisterImplementationSpecificTests(t *testing.T) {
+	testCases := []struct {
+		name          string
+		namespace     string
+		test          ConformanceTest
+		expectedPanic string
+	}{
+		{
+			name:      "valid test",
+			namespace: "example.com",
+			test: ConformanceTest{
+				ShortName: "ExampleRetries",
+				Features:  []features.FeatureName{features.SupportGateway, "example.com/Retries"},
+			},
+		},
+		{
+			name:          "invalid namespace",
+			namespace:     "example.com/foo",
+			test:          ConformanceTest{ShortName: "ExampleRetries"},
+			expectedPanic: `invalid implementation-specific feature namespace "example.com/foo"`,
+		},
+		{
+			name:      "feature in another namespace",
+			namespace: "example.com",
+			test: ConformanceTest{
+				ShortName: "ExampleRetries",
+				Features:  []features.FeatureName{"example.com/Retries", "other.io/Retries"},
+			},
+			expectedPanic: `implementation-specific test "ExampleRetries" relies on feature "other.io/Retries", which is neither an upstream feature nor in namespace "example.com"`,
+		},
+		{
+			name:      "only upstream features",
+			namespace: "example.com",
+			test: ConformanceTest{
+				ShortName: "ExampleRetries",
+				Features:  []features.FeatureName{features.SupportGateway, features.SupportHTTPRoute},
+			},
+			expectedPanic: `implementation-specific test "ExampleRetries" must rely on at least one feature in namespace "example.com"`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resetImplementationSpecificTests(t)
+			register := func() { RegisterImplementationSpecificTests(tc.namespace, tc.test) }
+			if tc.expectedPanic != "" {
+				require.PanicsWithValue(t, tc.expectedPanic, register)
+				assert.Empty(t, ImplementationSpecificTests())
+				return
+			}
+			require.NotPanics(t, register)
+			assert.Equal(t, []ConformanceTest{tc.test}, ImplementationSpecificTests())
+			require.PanicsWithValue(t, `implementation-specific test named "ExampleRetries" is already registered`, register)
+		})
+	}
+}
+
+func TestSuiteReportImplementationSpecific(t *testing.T) {
+	resetImplementationSpecificTests(t)
+	conformanceProfileMap[testProfileName] = testProfile
+
+	retriesTest := ConformanceTest{
+		ShortName: "ExampleRetries",
+		Features:  []features.FeatureName{features.SupportGateway, "example.com/Retries"},
+	}
+	timeoutsTest := ConformanceTest{
+		ShortName: "ExampleTimeouts",
+		Features:  []features.FeatureName{features.SupportGateway, "example.com/Timeouts"},
+	}
+	cachingTest := ConformanceTest{
+		ShortName: "ExampleCaching",
+		Features:  []features.FeatureName{"example.com/Caching"},
+	}
+	RegisterImplementationSpecificTests("example.com", retriesTest, timeoutsTest, cachingTest)
+
+	suite := ConformanceTestSuite{
+		conformanceProfiles: sets.New(testProfileName),
+		SupportedFeatures:   sets.New[features.FeatureName](coreFeature, features.SupportGateway, "example.com/Retries", "example.com/Timeouts"),
+		results: map[string]testResult{
+			coreTest.ShortName:     {test: coreTest, result: testSucceeded},
+			retriesTest.ShortName:  {test: retriesTest, result: testSucceeded},
+			timeoutsTest.ShortName: {test: timeoutsTest, result: testFailed},
+			cachingTest.ShortName:  {test: cachingTest, result: testNotSupported},
+		},
+	}
+
+	report, err := suite.Report()
+	require.NoError(t, err)
+
+	require.Len(t, report.ProfileReports, 1)
+	assert.Equal(t, confv1.Statistics{Passed: 1}, report.ProfileReports[0].Core.Statistics,
+		"implementation-specific tests must not be counted in conformance profiles")
+
+	assert.Equal(t, []confv1.ImplementationSpecificReport{
+		{
+			Namespace: "example.com",
+			Status: confv1.Status{
+				Result:      confv1.Failure,
+				Statistics:  confv1.Statistics{Passed: 1, Failed: 1},
+				FailedTests: []string{timeoutsTest.ShortName},
+			},
+			SupportedFeatures:   []string{"example.com/Retries", "example.com/Timeouts"},
+			UnsupportedFeatures: []string{"example.com/Caching"},
+		},
+	}, report.ImplementationSpecific)
+}
@@ -139,6 +139,7 @@ var (
 		ExtendedFeatures: features.SetsToNamesSet(
 			features.GatewayExtendedFeatures,
 			features.UDPRouteFeatures,
+			features.UDPRouteExtendedFeatures,
 		),
 	}
 
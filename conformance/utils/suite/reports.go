@@ -18,6 +18,7 @@ package suite
 
 import (
 	"fmt"
+	"sort"
 
 	"k8s.io/apimachinery/pkg/util/sets"
 
@@ -202,3 +203,28 @@ func buildReportSummary(status confv1.Status) string {
 	}
 	return message
 }
+
+// buildRepeatedTestReports creates a report for each repeated test, sorted by
+// test name, including its pass rate and whether its outcome was stable.
+func buildRepeatedTestReports(results map[string]confv1.Statistics) []confv1.RepeatedTestReport {
+	if len(results) == 0 {
+		return nil
+	}
+	reports := make([]confv1.RepeatedTestReport, 0, len(results))
+	for name, stats := range results {
+		passRate := "N/A"
+		if executed := stats.Passed + stats.Failed; executed > 0 {
+			passRate = fmt.Sprintf("%.1f%%", float64(stats.Passed)*100/float64(executed))
+		}
+		reports = append(reports, confv1.RepeatedTestReport{
+			Name:       name,
+			Statistics: stats,
+			PassRate:   passRate,
+			Stable:     stats.Passed == 0 || stats.Failed == 0,
+		})
+	}
+	sort.Slice(reports, func(i, j int) bool {
+		return reports[i].Name < reports[j].Name
+	})
+	return reports
+}
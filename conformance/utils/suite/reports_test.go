@@ -119,3 +119,74 @@ func TestBuildSummary(t *testing.T) {
 		})
 	}
 }
+
+func TestBuildRepeatedTestReports(t *testing.T) {
+	testCases := []struct {
+		name            string
+		results         map[string]confv1.Statistics
+		expectedReports []confv1.RepeatedTestReport
+	}{
+		{
+			name: "no repeated tests",
+		},
+		{
+			name: "stable and unstable tests",
+			results: map[string]confv1.Statistics{
+				"flaky-test": {
+					Passed: 4,
+					Failed: 1,
+				},
+				"always-failing-test": {
+					Failed: 5,
+				},
+				"passing-test": {
+					Passed:  3,
+					Skipped: 2,
+				},
+			},
+			expectedReports: []confv1.RepeatedTestReport{
+				{
+					Name:       "always-failing-test",
+					Statistics: confv1.Statistics{Failed: 5},
+					PassRate:   "0.0%",
+					Stable:     true,
+				},
+				{
+					Name:       "flaky-test",
+					Statistics: confv1.Statistics{Passed: 4, Failed: 1},
+					PassRate:   "80.0%",
+					Stable:     false,
+				},
+				{
+					Name:       "passing-test",
+					Statistics: confv1.Statistics{Passed: 3, Skipped: 2},
+					PassRate:   "100.0%",
+					Stable:     true,
+				},
+			},
+		},
+		{
+			name: "all runs skipped",
+			results: map[string]confv1.Statistics{
+				"skipped-test": {
+					Skipped: 3,
+				},
+			},
+			expectedReports: []confv1.RepeatedTestReport{
+				{
+					Name:       "skipped-test",
+					Statistics: confv1.Statistics{Skipped: 3},
+					PassRate:   "N/A",
+					Stable:     true,
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			reports := buildRepeatedTestReports(tc.results)
+			require.Equal(t, tc.expectedReports, reports)
+		})
+	}
+}
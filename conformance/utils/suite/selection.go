@@ -0,0 +1,395 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package suite
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"sigs.k8s.io/gateway-api/pkg/features"
+)
+
+// -----------------------------------------------------------------------------
+// Test Selection - Feature Expressions
+// -----------------------------------------------------------------------------
+
+// FeatureExpression is a boolean expression over feature names, used to
+// select tests by the features they exercise. Feature names evaluate to true
+// when the test relies on the named feature, and can be combined with the
+// "!", "&&" and "||" operators and grouped with parentheses, e.g.
+// "HTTPRoute && !(HTTPRouteCORS || HTTPRouteExternalAuth)".
+type FeatureExpression struct {
+	raw  string
+	root featureExpressionNode
+}
+
+type featureExpressionNode interface {
+	eval(testFeatures sets.Set[features.FeatureName]) bool
+}
+
+type featureNameNode features.FeatureName
+
+func (n featureNameNode) eval(testFeatures sets.Set[features.FeatureName]) bool {
+	return testFeatures.Has(features.FeatureName(n))
+}
+
+type notNode struct {
+	operand featureExpressionNode
+}
+
+func (n notNode) eval(testFeatures sets.Set[features.FeatureName]) bool {
+	return !n.operand.eval(testFeatures)
+}
+
+type andNode struct {
+	left, right featureExpressionNode
+}
+
+func (n andNode) eval(testFeatures sets.Set[features.FeatureName]) bool {
+	return n.left.eval(testFeatures) && n.right.eval(testFeatures)
+}
+
+type orNode struct {
+	left, right featureExpressionNode
+}
+
+func (n orNode) eval(testFeatures sets.Set[features.FeatureName]) bool {
+	return n.left.eval(testFeatures) || n.right.eval(testFeatures)
+}
+
+// ParseFeatureExpression parses a boolean expression over feature names. An
+// empty expression is not valid.
+func ParseFeatureExpression(expr string) (*FeatureExpression, error) {
+	tokens, err := tokenizeFeatureExpression(expr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid feature expression %q: %w", expr, err)
+	}
+	if len(tokens) == 0 {
+		return nil, fmt.Errorf("invalid feature expression %q: expression is empty", expr)
+	}
+	p := &featureExpressionParser{tokens: tokens}
+	root, err := p.parseOr()
+	if err == nil && p.pos < len(p.tokens) {
+		err = fmt.Errorf("unexpected %q", p.tokens[p.pos])
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid feature expression %q: %w", expr, err)
+	}
+	return &FeatureExpression{raw: expr, root: root}, nil
+}
+
+// Matches reports whether the expression is true for a test relying on the
+// given features.
+func (e *FeatureExpression) Matches(testFeatures []features.FeatureName) bool {
+	return e.root.eval(sets.New(testFeatures...))
+}
+
+// String returns the expression as it was parsed.
+func (e *FeatureExpression) String() string {
+	return e.raw
+}
+
+func tokenizeFeatureExpression(expr string) ([]string, error) {
+	var tokens []string
+	runes := []rune(expr)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '(' || r == ')' || r == '!':
+			tokens = append(tokens, string(r))
+			i++
+		case r == '&' || r == '|':
+			if i+1 >= len(runes) || runes[i+1] != r {
+				return nil, fmt.Errorf("unexpected %q, expected %q", string(r), string([]rune{r, r}))
+			}
+			tokens = append(tokens, string([]rune{r, r}))
+			i += 2
+		case isFeatureNameRune(r):
+			start := i
+			for i < len(runes) && isFeatureNameRune(runes[i]) {
+				i++
+			}
+			tokens = append(tokens, string(runes[start:i]))
+		default:
+			return nil, fmt.Errorf("unexpected character %q", string(r))
+		}
+	}
+	return tokens, nil
+}
+
+func isFeatureNameRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '-' || r == '_' || r == '.' || r == '/'
+}
+
+// featureExpressionParser is a recursive descent parser for the grammar:
+//
+//	or   = and { "||" and }
+//	and  = not { "&&" not }
+//	not  = "!" not | atom
+//	atom = "(" or ")" | featureName
+type featureExpressionParser struct {
+	tokens []string
+	pos    int
+}
+
+func (p *featureExpressionParser) peek() string {
+	if p.pos < len(p.tokens) {
+		return p.tokens[p.pos]
+	}
+	return ""
+}
+
+func (p *featureExpressionParser) parseOr() (featureExpressionNode, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek() == "||" {
+		p.pos++
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = orNode{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *featureExpressionParser) parseAnd() (featureExpressionNode, error) {
+	left, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek() == "&&" {
+		p.pos++
+		right, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		left = andNode{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *featureExpressionParser) parseNot() (featureExpressionNode, error) {
+	if p.peek() == "!" {
+		p.pos++
+		operand, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		return notNode{operand: operand}, nil
+	}
+	return p.parseAtom()
+}
+
+func (p *featureExpressionParser) parseAtom() (featureExpressionNode, error) {
+	token := p.peek()
+	switch token {
+	case "":
+		return nil, errors.New("unexpected end of expression")
+	case "(":
+		p.pos++
+		node, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if p.peek() != ")" {
+			return nil, errors.New("missing closing parenthesis")
+		}
+		p.pos++
+		return node, nil
+	case ")", "!", "&&", "||":
+		return nil, fmt.Errorf("unexpected %q", token)
+	}
+	p.pos++
+	return featureNameNode(token), nil
+}
+
+// -----------------------------------------------------------------------------
+// Test Selection - Suite Helpers
+// -----------------------------------------------------------------------------
+
+// TestPlanEntry describes what the suite would do with a test, without
+// running it.
+type TestPlanEntry struct {
+	// ShortName is the short name of the test.
+	ShortName string
+	// Run indicates whether the test would be run.
+	Run bool
+	// Reason explains why the test would be skipped, or which outcome would be
+	// reported regardless of the test result. It is empty for tests that are
+	// run and reported normally.
+	Reason string
+}
+
+// String formats the entry as a single line for listing purposes.
+func (e TestPlanEntry) String() string {
+	action := "RUN "
+	if !e.Run {
+		action = "SKIP"
+	}
+	if e.Reason == "" {
+		return fmt.Sprintf("%s %s", action, e.ShortName)
+	}
+	return fmt.Sprintf("%s %s: %s", action, e.ShortName, e.Reason)
+}
+
+// PlanConformanceTests computes which of the provided tests a suite created
+// with the given options would run or skip and why, without contacting the
+// cluster. Since the supported features cannot be inferred from the
+// GatewayClass or XMesh status without a cluster, they must be provided
+// explicitly.
+func PlanConformanceTests(options ConformanceOptions, tests []ConformanceTest) ([]TestPlanEntry, error) {
+	if shouldInferSupportedFeatures(&options) {
+		return nil, errors.New("supported features must be provided explicitly to plan tests, as they cannot be inferred without a cluster")
+	}
+
+	supportedFeatures := manualSupportedFeatures(&options)
+	for _, conformanceProfileName := range options.ConformanceProfiles {
+		conformanceProfile, err := getConformanceProfileForName(conformanceProfileName)
+		if err != nil {
+			return nil, fmt.Errorf("failed to retrieve conformance profile: %w", err)
+		}
+		supportedFeatures = supportedFeatures.Union(conformanceProfile.CoreFeatures)
+	}
+
+	suite := &ConformanceTestSuite{
+		SupportedFeatures:    supportedFeatures,
+		SkipTests:            sets.New(options.SkipTests...),
+		SkipProvisionalTests: options.SkipProvisionalTests,
+		RunTest:              options.RunTest,
+		http3Unavailable:     http3Unavailable(options),
+	}
+	if err := suite.setTestSelection(&options); err != nil {
+		return nil, err
+	}
+
+	if suite.RunTest != "" && !slices.ContainsFunc(tests, func(t ConformanceTest) bool { return t.ShortName == suite.RunTest }) {
+		return nil, fmt.Errorf("test %q does not exist", suite.RunTest)
+	}
+
+	plan := make([]TestPlanEntry, 0, len(tests))
+	for _, test := range tests {
+		entry := TestPlanEntry{ShortName: test.ShortName, Run: true}
+		if reason := suite.skipReason(test); reason != "" {
+			entry.Run = false
+			entry.Reason = reason
+		} else if res := suite.initialTestResult(test); res != testSucceeded {
+			entry.Reason = fmt.Sprintf("test is run but reported as %s", res)
+		}
+		plan = append(plan, entry)
+	}
+	return plan, nil
+}
+
+// setTestSelection parses the test selection options and sets them on the
+// suite.
+func (suite *ConformanceTestSuite) setTestSelection(options *ConformanceOptions) error {
+	if options.RunTestsMatching != "" {
+		re, err := regexp.Compile(options.RunTestsMatching)
+		if err != nil {
+			return fmt.Errorf("invalid test name regular expression: %w", err)
+		}
+		suite.RunTestsMatching = re
+	}
+	if options.FeatureExpression != "" {
+		expr, err := ParseFeatureExpression(options.FeatureExpression)
+		if err != nil {
+			return err
+		}
+		suite.FeatureExpression = expr
+	}
+	for _, name := range options.SelectProfiles {
+		if _, err := getConformanceProfileForName(name); err != nil {
+			return fmt.Errorf("failed to retrieve conformance profile: %w", err)
+		}
+	}
+	if len(options.SelectProfiles) > 0 {
+		suite.SelectProfiles = sets.New(options.SelectProfiles...)
+	}
+	return nil
+}
+
+// deselectionReason returns why the test is filtered out by the test name,
+// regular expression, feature expression or profile selection of the suite,
+// or an empty string if the test is selected.
+func (suite *ConformanceTestSuite) deselectionReason(test ConformanceTest) string {
+	if suite.SkipTests.Has(test.ShortName) || suite.RunTest != "" && suite.RunTest != test.ShortName {
+		return "test explicitly skipped"
+	}
+	if suite.RunTestsMatching != nil && !suite.RunTestsMatching.MatchString(test.ShortName) {
+		return fmt.Sprintf("test name does not match %q", suite.RunTestsMatching)
+	}
+	if suite.FeatureExpression != nil && !suite.FeatureExpression.Matches(test.Features) {
+		return fmt.Sprintf("test features do not match %q", suite.FeatureExpression)
+	}
+	if suite.SelectProfiles.Len() > 0 && getConformanceProfilesForTest(test, suite.SelectProfiles).Len() == 0 {
+		return fmt.Sprintf("test does not belong to profiles %s", strings.Join(profileNames(suite.SelectProfiles), ","))
+	}
+	if suite.http3Unavailable && slices.Contains(test.Features, features.SupportGatewayHTTP3) {
+		return "HTTP/3 requests cannot be sent through the Gateway dialer"
+	}
+	return ""
+}
+
+// skipReason returns why ConformanceTest.Run skips the test, or an empty
+// string if the test is run.
+func (suite *ConformanceTestSuite) skipReason(test ConformanceTest) string {
+	// Test against features if the user hasn't focused on a single test
+	if suite.RunTest == "" {
+		for _, featureName := range test.Features {
+			if !suite.SupportedFeatures.Has(featureName) {
+				return fmt.Sprintf("suite does not support %s", featureName)
+			}
+		}
+	}
+	return suite.deselectionReason(test)
+}
+
+// initialTestResult returns the result that is recorded for the test before
+// it is run, which is kept unless the test fails or is skipped.
+func (suite *ConformanceTestSuite) initialTestResult(test ConformanceTest) resultType {
+	res := testSucceeded
+	if suite.deselectionReason(test) != "" {
+		res = testSkipped
+	}
+	if suite.SkipProvisionalTests && test.Provisional {
+		res = testProvisionalSkipped
+	}
+	if !suite.SupportedFeatures.HasAll(test.Features...) {
+		res = testNotSupported
+	}
+	return res
+}
+
+func profileNames(profiles sets.Set[ConformanceProfileName]) []string {
+	names := make([]string, 0, profiles.Len())
+	for _, p := range profiles.UnsortedList() {
+		names = append(names, string(p))
+	}
+	slices.Sort(names)
+	return names
+}
@@ -0,0 +1,294 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package suite
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"sigs.k8s.io/gateway-api/pkg/features"
+)
+
+func TestFeatureExpression(t *testing.T) {
+	testCases := []struct {
+		name         string
+		expr         string
+		testFeatures []features.FeatureName
+		expected     bool
+		expectedErr  string
+	}{
+		{
+			name:         "single feature present",
+			expr:         "HTTPRoute",
+			testFeatures: []features.FeatureName{features.SupportGateway, features.SupportHTTPRoute},
+			expected:     true,
+		},
+		{
+			name:         "single feature missing",
+			expr:         "GRPCRoute",
+			testFeatures: []features.FeatureName{features.SupportGateway, features.SupportHTTPRoute},
+			expected:     false,
+		},
+		{
+			name:         "negated feature",
+			expr:         "HTTPRoute && !HTTPRouteCORS",
+			testFeatures: []features.FeatureName{features.SupportHTTPRoute, features.SupportHTTPRouteCORS},
+			expected:     false,
+		},
+		{
+			name:         "and binds tighter than or",
+			expr:         "GRPCRoute || HTTPRoute && Gateway",
+			testFeatures: []features.FeatureName{features.SupportGateway, features.SupportHTTPRoute},
+			expected:     true,
+		},
+		{
+			name:         "parentheses",
+			expr:         "!(GRPCRoute || HTTPRoute) && Gateway",
+			testFeatures: []features.FeatureName{features.SupportGateway, features.SupportHTTPRoute},
+			expected:     false,
+		},
+		{
+			name:        "empty expression",
+			expr:        "  ",
+			expectedErr: `invalid feature expression "  ": expression is empty`,
+		},
+		{
+			name:        "single ampersand",
+			expr:        "HTTPRoute & Gateway",
+			expectedErr: `invalid feature expression "HTTPRoute & Gateway": unexpected "&", expected "&&"`,
+		},
+		{
+			name:        "missing closing parenthesis",
+			expr:        "(HTTPRoute || Gateway",
+			expectedErr: `invalid feature expression "(HTTPRoute || Gateway": missing closing parenthesis`,
+		},
+		{
+			name:        "dangling operator",
+			expr:        "HTTPRoute &&",
+			expectedErr: `invalid feature expression "HTTPRoute &&": unexpected end of expression`,
+		},
+		{
+			name:        "missing operator",
+			expr:        "HTTPRoute Gateway",
+			expectedErr: `invalid feature expression "HTTPRoute Gateway": unexpected "Gateway"`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			expr, err := ParseFeatureExpression(tc.expr)
+			if tc.expectedErr != "" {
+				require.EqualError(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, expr.Matches(tc.testFeatures))
+			assert.Equal(t, tc.expr, expr.String())
+		})
+	}
+}
+
+func TestPlanConformanceTests(t *testing.T) {
+	httpTest := ConformanceTest{
+		ShortName: "HTTPRouteSimple",
+		Features:  []features.FeatureName{features.SupportGateway, features.SupportHTTPRoute},
+	}
+	corsTest := ConformanceTest{
+		ShortName: "HTTPRouteCORS",
+		Features:  []features.FeatureName{features.SupportGateway, features.SupportHTTPRoute, features.SupportHTTPRouteCORS},
+	}
+	grpcTest := ConformanceTest{
+		ShortName: "GRPCRouteSimple",
+		Features:  []features.FeatureName{features.SupportGateway, features.SupportGRPCRoute},
+	}
+	provisionalTest := ConformanceTest{
+		ShortName:   "HTTPRouteProvisional",
+		Features:    []features.FeatureName{features.SupportGateway, features.SupportHTTPRoute},
+		Provisional: true,
+	}
+	tests := []ConformanceTest{httpTest, corsTest, grpcTest, provisionalTest}
+
+	testCases := []struct {
+		name         string
+		options      ConfigurableOptions
+		expectedPlan []TestPlanEntry
+		expectedErr  string
+	}{
+		{
+			name: "supported features",
+			options: ConfigurableOptions{
+				SupportedFeatures: []features.FeatureName{features.SupportGateway, features.SupportHTTPRoute},
+			},
+			expectedPlan: []TestPlanEntry{
+				{ShortName: "HTTPRouteSimple", Run: true},
+				{ShortName: "HTTPRouteCORS", Reason: "suite does not support HTTPRouteCORS"},
+				{ShortName: "GRPCRouteSimple", Reason: "suite does not support GRPCRoute"},
+				{ShortName: "HTTPRouteProvisional", Run: true},
+			},
+		},
+		{
+			name: "regular expression, feature expression and skipped provisional tests",
+			options: ConfigurableOptions{
+				EnableAllSupportedFeatures: true,
+				RunTestsMatching:           "^HTTPRoute",
+				FeatureExpression:          "HTTPRoute && !HTTPRouteCORS",
+				SkipProvisionalTests:       true,
+			},
+			expectedPlan: []TestPlanEntry{
+				{ShortName: "HTTPRouteSimple", Run: true},
+				{ShortName: "HTTPRouteCORS", Reason: `test features do not match "HTTPRoute && !HTTPRouteCORS"`},
+				{ShortName: "GRPCRouteSimple", Reason: `test name does not match "^HTTPRoute"`},
+				{ShortName: "HTTPRouteProvisional", Run: true, Reason: "test is run but reported as PROVISIONAL_SKIPPED"},
+			},
+		},
+		{
+			name: "profile selection",
+			options: ConfigurableOptions{
+				EnableAllSupportedFeatures: true,
+				SelectProfiles:             []ConformanceProfileName{GatewayGRPCConformanceProfileName},
+				SkipTests:                  []string{"HTTPRouteProvisional"},
+			},
+			expectedPlan: []TestPlanEntry{
+				{ShortName: "HTTPRouteSimple", Reason: "test does not belong to profiles GATEWAY-GRPC"},
+				{ShortName: "HTTPRouteCORS", Reason: "test does not belong to profiles GATEWAY-GRPC"},
+				{ShortName: "GRPCRouteSimple", Run: true},
+				{ShortName: "HTTPRouteProvisional", Reason: "test explicitly skipped"},
+			},
+		},
+		{
+			name: "conformance profile enables core features",
+			options: ConfigurableOptions{
+				ConformanceProfiles: []ConformanceProfileName{GatewayGRPCConformanceProfileName},
+				ExemptFeatures:      []features.FeatureName{features.SupportHTTPRoute},
+			},
+			expectedPlan: []TestPlanEntry{
+				{ShortName: "HTTPRouteSimple", Reason: "suite does not support HTTPRoute"},
+				{ShortName: "HTTPRouteCORS", Reason: "suite does not support HTTPRoute"},
+				{ShortName: "GRPCRouteSimple", Run: true},
+				{ShortName: "HTTPRouteProvisional", Reason: "suite does not support HTTPRoute"},
+			},
+		},
+		{
+			name:        "features must be provided",
+			options:     ConfigurableOptions{},
+			expectedErr: "supported features must be provided explicitly to plan tests, as they cannot be inferred without a cluster",
+		},
+		{
+			name: "unknown test",
+			options: ConfigurableOptions{
+				RunTest: "Unknown",
+			},
+			expectedErr: `test "Unknown" does not exist`,
+		},
+		{
+			name: "invalid regular expression",
+			options: ConfigurableOptions{
+				EnableAllSupportedFeatures: true,
+				RunTestsMatching:           "(",
+			},
+			expectedErr: "invalid test name regular expression: error parsing regexp: missing closing ): `(`",
+		},
+		{
+			name: "unknown profile",
+			options: ConfigurableOptions{
+				EnableAllSupportedFeatures: true,
+				SelectProfiles:             []ConformanceProfileName{"UNKNOWN"},
+			},
+			expectedErr: "failed to retrieve conformance profile: UNKNOWN is not a valid conformance profile",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			plan, err := PlanConformanceTests(ConformanceOptions{ConfigurableOptions: tc.options}, tests)
+			if tc.expectedErr != "" {
+				require.EqualError(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedPlan, plan)
+		})
+	}
+}
+
+func TestPlanConformanceTestsHTTP3(t *testing.T) {
+	tests := []ConformanceTest{
+		{
+			ShortName: "HTTPRouteSimple",
+			Features:  []features.FeatureName{features.SupportGateway, features.SupportHTTPRoute},
+		},
+		{
+			ShortName: "HTTPRouteHTTP3",
+			Features:  []features.FeatureName{features.SupportGateway, features.SupportHTTPRoute, features.SupportGatewayHTTP3},
+		},
+	}
+
+	testCases := []struct {
+		name         string
+		options      ConformanceOptions
+		expectedPlan []TestPlanEntry
+	}{
+		{
+			name: "direct Gateway dialer",
+			options: ConformanceOptions{ConfigurableOptions: ConfigurableOptions{
+				EnableAllSupportedFeatures: true,
+				GatewayDialer:              DirectGatewayDialer,
+			}},
+			expectedPlan: []TestPlanEntry{
+				{ShortName: "HTTPRouteSimple", Run: true},
+				{ShortName: "HTTPRouteHTTP3", Run: true},
+			},
+		},
+		{
+			name: "port-forward Gateway dialer",
+			options: ConformanceOptions{ConfigurableOptions: ConfigurableOptions{
+				EnableAllSupportedFeatures: true,
+				GatewayDialer:              PortForwardGatewayDialer,
+			}},
+			expectedPlan: []TestPlanEntry{
+				{ShortName: "HTTPRouteSimple", Run: true},
+				{ShortName: "HTTPRouteHTTP3", Reason: "HTTP/3 requests cannot be sent through the Gateway dialer"},
+			},
+		},
+		{
+			name: "custom dial context",
+			options: ConformanceOptions{
+				ConfigurableOptions: ConfigurableOptions{EnableAllSupportedFeatures: true},
+				DialContext: func(context.Context, string, string) (net.Conn, error) {
+					return nil, errors.New("not dialing")
+				},
+			},
+			expectedPlan: []TestPlanEntry{
+				{ShortName: "HTTPRouteSimple", Run: true},
+				{ShortName: "HTTPRouteHTTP3", Reason: "HTTP/3 requests cannot be sent through the Gateway dialer"},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			plan, err := PlanConformanceTests(tc.options, tests)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedPlan, plan)
+		})
+	}
+}
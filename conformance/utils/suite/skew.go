@@ -0,0 +1,217 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package suite
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
+
+	confv1 "sigs.k8s.io/gateway-api/conformance/apis/v1"
+	"sigs.k8s.io/gateway-api/pkg/client/capabilities"
+	"sigs.k8s.io/gateway-api/pkg/consts"
+)
+
+// getSkewedAPIVersionAndChannel returns the version and channel of the
+// installed Gateway API CRDs. Unlike getAPIVersionAndChannel, CRDs are not
+// required to match the suite version, and undefinedKeyword is returned for
+// the version or the channel when they differ between CRDs. An error is
+// returned only if no CRDs with the proper annotations are found.
+func getSkewedAPIVersionAndChannel(crds []apiextensionsv1.CustomResourceDefinition) (version string, channel string, err error) {
+	versions, channels := sets.New[string](), sets.New[string]()
+	for _, crd := range crds {
+		if v, ok := crd.Annotations[consts.BundleVersionAnnotation]; ok {
+			versions.Insert(v)
+		}
+		if c, ok := crd.Annotations[consts.ChannelAnnotation]; ok {
+			channels.Insert(c)
+		}
+	}
+	if versions.Len() == 0 || channels.Len() == 0 {
+		return "", "", errors.New("no Gateway API CRDs with the proper annotations found in the cluster")
+	}
+
+	version, channel = undefinedKeyword, undefinedKeyword
+	if versions.Len() == 1 {
+		version = versions.UnsortedList()[0]
+	}
+	if channels.Len() == 1 {
+		channel = channels.UnsortedList()[0]
+	}
+	return version, channel, nil
+}
+
+// crdVersionSkewSkipReason returns why the test must be skipped because its
+// manifests are not compatible with the installed CRDs, or an empty string if
+// the test can be run. It always returns an empty string when the suite is
+// not in CRD version-skew mode.
+func (suite *ConformanceTestSuite) crdVersionSkewSkipReason(test ConformanceTest) string {
+	if !suite.CRDVersionSkew {
+		return ""
+	}
+
+	suite.lock.RLock()
+	reason, ok := suite.crdVersionSkewReasons[test.ShortName]
+	suite.lock.RUnlock()
+	if ok {
+		return reason
+	}
+
+	for _, manifestLocation := range test.Manifests {
+		// manifests which can't be read are reported when they are applied
+		resources, err := suite.Applier.ManifestResources(manifestLocation, suite.TimeoutConfig)
+		if err != nil {
+			continue
+		}
+		if reason = suite.incompatibleResourcesReason(resources); reason != "" {
+			reason = fmt.Sprintf("manifest %s: %s", manifestLocation, reason)
+			break
+		}
+	}
+
+	suite.lock.Lock()
+	suite.crdVersionSkewReasons[test.ShortName] = reason
+	suite.lock.Unlock()
+	return reason
+}
+
+// incompatibleResourcesReason returns why the first Gateway API resource
+// among the given ones can't be created with the installed CRDs, or an empty
+// string if all of them can.
+func (suite *ConformanceTestSuite) incompatibleResourcesReason(resources []unstructured.Unstructured) string {
+	for _, resource := range resources {
+		gvk := resource.GroupVersionKind()
+		if !capabilities.Groups.Has(gvk.Group) {
+			continue
+		}
+		crd, ok := suite.installedCRDs.CRD(gvk.GroupKind())
+		if !ok {
+			return fmt.Sprintf("%s is not installed", gvk.GroupKind())
+		}
+
+		if !suite.installedCRDs.Served(gvk) {
+			return fmt.Sprintf("%s %s is not served by the installed CRD (bundle version %s, channel %s)",
+				gvk.Kind, gvk.Version, crd.Annotations[consts.BundleVersionAnnotation], crd.Annotations[consts.ChannelAnnotation])
+		}
+		if field := unknownSchemaField(resource.Object, suite.installedCRDs.Schema(gvk), ""); field != "" {
+			return fmt.Sprintf("%s %s uses %s, absent from the installed %s schema (bundle version %s, channel %s)",
+				gvk.Kind, resource.GetName(), field, gvk.Version,
+				crd.Annotations[consts.BundleVersionAnnotation], crd.Annotations[consts.ChannelAnnotation])
+		}
+	}
+	return ""
+}
+
+// unknownSchemaField walks the given value and returns a description of the
+// first field, or enum value, which is not allowed by the given schema, or an
+// empty string if there is none. Objects with neither properties nor
+// additionalProperties, such as the metadata of resources, are not checked.
+func unknownSchemaField(value any, s *apiextensionsv1.JSONSchemaProps, path string) string {
+	if s == nil || ptr.Deref(s.XPreserveUnknownFields, false) {
+		return ""
+	}
+
+	switch v := value.(type) {
+	case map[string]any:
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			fieldPath := key
+			if path != "" {
+				fieldPath = path + "." + key
+			}
+			var fieldSchema *apiextensionsv1.JSONSchemaProps
+			if prop, ok := s.Properties[key]; ok {
+				fieldSchema = &prop
+			} else if s.AdditionalProperties != nil {
+				if !s.AdditionalProperties.Allows {
+					return fmt.Sprintf("field %s", fieldPath)
+				}
+				fieldSchema = s.AdditionalProperties.Schema
+			} else if len(s.Properties) > 0 {
+				return fmt.Sprintf("field %s", fieldPath)
+			}
+			if res := unknownSchemaField(v[key], fieldSchema, fieldPath); res != "" {
+				return res
+			}
+		}
+	case []any:
+		if s.Items == nil {
+			return ""
+		}
+		for i, item := range v {
+			if res := unknownSchemaField(item, s.Items.Schema, fmt.Sprintf("%s[%d]", path, i)); res != "" {
+				return res
+			}
+		}
+	default:
+		if len(s.Enum) == 0 {
+			return ""
+		}
+		raw, err := json.Marshal(v)
+		if err != nil {
+			return ""
+		}
+		if !slices.ContainsFunc(s.Enum, func(e apiextensionsv1.JSON) bool {
+			return bytes.Equal(bytes.TrimSpace(e.Raw), raw)
+		}) {
+			return fmt.Sprintf("value %s of field %s", raw, path)
+		}
+	}
+	return ""
+}
+
+// buildCRDVersionSkew describes the installed Gateway API CRDs and the tests
+// skipped because of them. It returns nil when the suite is not in CRD
+// version-skew mode. The caller must hold the suite lock.
+func (suite *ConformanceTestSuite) buildCRDVersionSkew() *confv1.CRDVersionSkew {
+	if !suite.CRDVersionSkew {
+		return nil
+	}
+
+	res := &confv1.CRDVersionSkew{SuiteVersion: consts.BundleVersion}
+	for _, crd := range suite.installedCRDs.CRDs() {
+		res.InstalledCRDs = append(res.InstalledCRDs, confv1.InstalledCRD{
+			Name:           crd.Name,
+			BundleVersion:  crd.Annotations[consts.BundleVersionAnnotation],
+			Channel:        crd.Annotations[consts.ChannelAnnotation],
+			ServedVersions: suite.installedCRDs.ServedVersions(schema.GroupKind{Group: crd.Spec.Group, Kind: crd.Spec.Names.Kind}),
+		})
+	}
+
+	for name, reason := range suite.crdVersionSkewReasons {
+		if reason != "" {
+			res.SkippedTests = append(res.SkippedTests, confv1.SkewSkippedTest{Name: name, Reason: reason})
+		}
+	}
+	sort.Slice(res.SkippedTests, func(i, j int) bool {
+		return res.SkippedTests[i].Name < res.SkippedTests[j].Name
+	})
+	return res
+}
@@ -0,0 +1,296 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package suite
+
+import (
+	"io/fs"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	confv1 "sigs.k8s.io/gateway-api/conformance/apis/v1"
+	"sigs.k8s.io/gateway-api/conformance/utils/config"
+	"sigs.k8s.io/gateway-api/conformance/utils/kubernetes"
+	"sigs.k8s.io/gateway-api/pkg/client/capabilities"
+	"sigs.k8s.io/gateway-api/pkg/consts"
+)
+
+// skewedHTTPRouteCRD is an HTTPRoute CRD from an older bundle, which only
+// serves v1 and whose rules can only match paths.
+var skewedHTTPRouteCRD = apiextensionsv1.CustomResourceDefinition{
+	ObjectMeta: metav1.ObjectMeta{
+		Name: "httproutes.gateway.networking.k8s.io",
+		Annotations: map[string]string{
+			consts.BundleVersionAnnotation: "v1.0.0",
+			consts.ChannelAnnotation:       "standard",
+		},
+	},
+	Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+		Group: "gateway.networking.k8s.io",
+		Names: apiextensionsv1.CustomResourceDefinitionNames{Kind: "HTTPRoute"},
+		Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+			{
+				Name:   "v1",
+				Served: true,
+				Schema: &apiextensionsv1.CustomResourceValidation{OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+					Type: "object",
+					Properties: map[string]apiextensionsv1.JSONSchemaProps{
+						"apiVersion": {Type: "string"},
+						"kind":       {Type: "string"},
+						"metadata":   {Type: "object"},
+						"spec": {
+							Type: "object",
+							Properties: map[string]apiextensionsv1.JSONSchemaProps{
+								"rules": {
+									Type: "array",
+									Items: &apiextensionsv1.JSONSchemaPropsOrArray{Schema: &apiextensionsv1.JSONSchemaProps{
+										Type: "object",
+										Properties: map[string]apiextensionsv1.JSONSchemaProps{
+											"matches": {
+												Type: "array",
+												Items: &apiextensionsv1.JSONSchemaPropsOrArray{Schema: &apiextensionsv1.JSONSchemaProps{
+													Type: "object",
+													Properties: map[string]apiextensionsv1.JSONSchemaProps{
+														"path": {
+															Type: "object",
+															Properties: map[string]apiextensionsv1.JSONSchemaProps{
+																"type": {
+																	Type: "string",
+																	Enum: []apiextensionsv1.JSON{{Raw: []byte(`"Exact"`)}, {Raw: []byte(`"PathPrefix"`)}},
+																},
+																"value": {Type: "string"},
+															},
+														},
+													},
+												}},
+											},
+										},
+									}},
+								},
+							},
+						},
+					},
+				}},
+			},
+			{Name: "v1beta1", Served: false},
+		},
+	},
+}
+
+func TestUnknownSchemaField(t *testing.T) {
+	schema := skewedHTTPRouteCRD.Spec.Versions[0].Schema.OpenAPIV3Schema
+
+	testCases := []struct {
+		name     string
+		object   map[string]any
+		expected string
+	}{
+		{
+			name: "known fields",
+			object: map[string]any{
+				"kind":     "HTTPRoute",
+				"metadata": map[string]any{"name": "route", "labels": map[string]any{"app": "foo"}},
+				"spec": map[string]any{"rules": []any{
+					map[string]any{"matches": []any{
+						map[string]any{"path": map[string]any{"type": "Exact", "value": "/"}},
+					}},
+				}},
+			},
+		},
+		{
+			name: "unknown field",
+			object: map[string]any{
+				"spec": map[string]any{"rules": []any{
+					map[string]any{"matches": []any{
+						map[string]any{"path": map[string]any{"type": "Exact", "value": "/"}},
+					}},
+					map[string]any{"timeouts": map[string]any{"request": "1s"}},
+				}},
+			},
+			expected: "field spec.rules[1].timeouts",
+		},
+		{
+			name: "unknown enum value",
+			object: map[string]any{
+				"spec": map[string]any{"rules": []any{
+					map[string]any{"matches": []any{
+						map[string]any{"path": map[string]any{"type": "RegularExpression"}},
+					}},
+				}},
+			},
+			expected: `value "RegularExpression" of field spec.rules[0].matches[0].path.type`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, unknownSchemaField(tc.object, schema, ""))
+		})
+	}
+}
+
+func TestGetSkewedAPIVersionAndChannel(t *testing.T) {
+	crd := func(version, channel string) apiextensionsv1.CustomResourceDefinition {
+		return apiextensionsv1.CustomResourceDefinition{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{
+			consts.BundleVersionAnnotation: version,
+			consts.ChannelAnnotation:       channel,
+		}}}
+	}
+
+	testCases := []struct {
+		name            string
+		crds            []apiextensionsv1.CustomResourceDefinition
+		expectedVersion string
+		expectedChannel string
+		expectedErr     bool
+	}{
+		{
+			name:            "older version",
+			crds:            []apiextensionsv1.CustomResourceDefinition{crd("v1.0.0", "standard"), crd("v1.0.0", "standard")},
+			expectedVersion: "v1.0.0",
+			expectedChannel: "standard",
+		},
+		{
+			name:            "mixed versions",
+			crds:            []apiextensionsv1.CustomResourceDefinition{crd("v1.0.0", "experimental"), crd("v1.1.0", "experimental")},
+			expectedVersion: undefinedKeyword,
+			expectedChannel: "experimental",
+		},
+		{
+			name:        "no annotated CRDs",
+			crds:        []apiextensionsv1.CustomResourceDefinition{{}},
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			version, channel, err := getSkewedAPIVersionAndChannel(tc.crds)
+			if tc.expectedErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedVersion, version)
+			assert.Equal(t, tc.expectedChannel, channel)
+		})
+	}
+}
+
+func TestCRDVersionSkewSkipReason(t *testing.T) {
+	suite := &ConformanceTestSuite{
+		CRDVersionSkew:        true,
+		TimeoutConfig:         config.DefaultTimeoutConfig(),
+		installedCRDs:         capabilities.NewBundle(&skewedHTTPRouteCRD),
+		crdVersionSkewReasons: map[string]string{},
+		results:               map[string]testResult{},
+		Applier: kubernetes.Applier{ManifestFS: []fs.FS{fstest.MapFS{
+			"compatible.yaml": &fstest.MapFile{Data: []byte(`
+apiVersion: v1
+kind: Service
+metadata:
+  name: backend
+spec:
+  ports:
+  - port: 8080
+---
+apiVersion: gateway.networking.k8s.io/v1
+kind: HTTPRoute
+metadata:
+  name: route
+spec:
+  rules:
+  - matches:
+    - path:
+        type: PathPrefix
+        value: /
+`)},
+			"timeouts.yaml": &fstest.MapFile{Data: []byte(`
+apiVersion: gateway.networking.k8s.io/v1
+kind: HTTPRoute
+metadata:
+  name: route-timeouts
+spec:
+  rules:
+  - timeouts:
+      request: 1s
+`)},
+			"v1beta1.yaml": &fstest.MapFile{Data: []byte(`
+apiVersion: gateway.networking.k8s.io/v1beta1
+kind: HTTPRoute
+metadata:
+  name: route
+`)},
+			"grpcroute.yaml": &fstest.MapFile{Data: []byte(`
+apiVersion: gateway.networking.k8s.io/v1
+kind: GRPCRoute
+metadata:
+  name: route
+`)},
+		}}},
+	}
+
+	testCases := []struct {
+		test     ConformanceTest
+		expected string
+	}{
+		{
+			test: ConformanceTest{ShortName: "Compatible", Manifests: []string{"compatible.yaml"}},
+		},
+		{
+			test:     ConformanceTest{ShortName: "Timeouts", Manifests: []string{"compatible.yaml", "timeouts.yaml"}},
+			expected: "manifest timeouts.yaml: HTTPRoute route-timeouts uses field spec.rules[0].timeouts, absent from the installed v1 schema (bundle version v1.0.0, channel standard)",
+		},
+		{
+			test:     ConformanceTest{ShortName: "V1beta1", Manifests: []string{"v1beta1.yaml"}},
+			expected: "manifest v1beta1.yaml: HTTPRoute v1beta1 is not served by the installed CRD (bundle version v1.0.0, channel standard)",
+		},
+		{
+			test:     ConformanceTest{ShortName: "GRPCRoute", Manifests: []string{"grpcroute.yaml"}},
+			expected: "manifest grpcroute.yaml: GRPCRoute.gateway.networking.k8s.io is not installed",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.test.ShortName, func(t *testing.T) {
+			assert.Equal(t, tc.expected, suite.crdVersionSkewSkipReason(tc.test))
+		})
+	}
+
+	report, err := suite.Report()
+	require.NoError(t, err)
+	assert.Equal(t, &confv1.CRDVersionSkew{
+		SuiteVersion: consts.BundleVersion,
+		InstalledCRDs: []confv1.InstalledCRD{
+			{
+				Name:           "httproutes.gateway.networking.k8s.io",
+				BundleVersion:  "v1.0.0",
+				Channel:        "standard",
+				ServedVersions: []string{"v1"},
+			},
+		},
+		SkippedTests: []confv1.SkewSkippedTest{
+			{Name: "GRPCRoute", Reason: testCases[3].expected},
+			{Name: "Timeouts", Reason: testCases[1].expected},
+			{Name: "V1beta1", Reason: testCases[2].expected},
+		},
+	}, report.CRDVersionSkew)
+}
@@ -21,6 +21,8 @@ import (
 	"errors"
 	"fmt"
 	"io/fs"
+	"net"
+	"regexp"
 	"slices"
 	"sort"
 	"strings"
@@ -44,8 +46,11 @@ import (
 	"sigs.k8s.io/gateway-api/conformance/utils/grpc"
 	"sigs.k8s.io/gateway-api/conformance/utils/kubernetes"
 	"sigs.k8s.io/gateway-api/conformance/utils/roundtripper"
+	"sigs.k8s.io/gateway-api/conformance/utils/tcp"
 	"sigs.k8s.io/gateway-api/conformance/utils/tlog"
+	"sigs.k8s.io/gateway-api/conformance/utils/udp"
 	"sigs.k8s.io/gateway-api/conformance/utils/websocket"
+	"sigs.k8s.io/gateway-api/pkg/client/capabilities"
 	"sigs.k8s.io/gateway-api/pkg/consts"
 	"sigs.k8s.io/gateway-api/pkg/features"
 )
@@ -65,6 +70,8 @@ type ConformanceTestSuite struct {
 	RoundTripper             roundtripper.RoundTripper
 	GRPCClient               grpc.Client
 	WebSocketDialer          websocket.Dialer
+	TCPClient                tcp.Client
+	UDPClient                udp.Client
 	GatewayClassName         string
 	MeshName                 string
 	ControllerName           string
@@ -76,10 +83,18 @@ type ConformanceTestSuite struct {
 	Applier                  kubernetes.Applier
 	SupportedFeatures        FeaturesSet
 	TimeoutConfig            config.TimeoutConfig
+	WeightConfig             config.WeightConfig
+	BenchmarkConfig          config.BenchmarkConfig
 	SkipTests                sets.Set[string]
 	SkipProvisionalTests     bool
 	DisableParallelTests     bool
 	RunTest                  string
+	RunTestsMatching         *regexp.Regexp
+	FeatureExpression        *FeatureExpression
+	SelectProfiles           sets.Set[ConformanceProfileName]
+	Repeat                   int
+	DebugBundleDir           string
+	CRDVersionSkew           bool
 	Hook                     func(t *testing.T, test ConformanceTest, suite *ConformanceTestSuite)
 	ManifestFS               []fs.FS
 	UsableNetworkAddresses   []gatewayv1.GatewaySpecAddress
@@ -108,6 +123,15 @@ type ConformanceTestSuite struct {
 	// in the Gateway API CRDs.
 	apiChannel string
 
+	// installedCRDs describes the Gateway API CRDs installed in the cluster.
+	// It is only populated in CRD version-skew mode.
+	installedCRDs *capabilities.Bundle
+
+	// crdVersionSkewReasons stores, for each test checked against the
+	// installed CRDs, the reason why it is skipped, or an empty string if its
+	// manifests are compatible with them.
+	crdVersionSkewReasons map[string]string
+
 	// conformanceProfiles is a compiled list of profiles to check
 	// conformance against.
 	conformanceProfiles sets.Set[ConformanceProfileName]
@@ -121,6 +145,20 @@ type ConformanceTestSuite struct {
 	// the test suite, organized by the tests unique name.
 	results map[string]testResult
 
+	// repeatedResults stores the outcomes of every run of each test when the
+	// suite is configured to repeat tests, organized by the tests unique name.
+	repeatedResults map[string]confv1.Statistics
+
+	// http3Unavailable is set when HTTP/3 requests can't reach Gateways, in
+	// which case the tests of the GatewayHTTP3 feature are skipped.
+	http3Unavailable bool
+
+	// baseSetUp and meshSetUp record whether Setup applied the base resources
+	// of the Gateway and Mesh tests, whose namespaces are recreated before
+	// every run of a repeated test.
+	baseSetUp bool
+	meshSetUp bool
+
 	// extendedSupportedFeatures is a compiled list of named features that were
 	// marked as supported, and is used for reporting the test results.
 	extendedSupportedFeatures map[ConformanceProfileName]sets.Set[features.FeatureName]
@@ -153,6 +191,14 @@ type ConfigurableOptions struct {
 	ExemptFeatures             []features.FeatureName `json:"exemptFeatures"`
 	EnableAllSupportedFeatures bool                   `json:"enableAllSupportedFeatures"`
 	TimeoutConfig              config.TimeoutConfig   `json:"timeoutConfig"`
+	WeightConfig               config.WeightConfig    `json:"weightConfig"`
+	// Benchmark enables the opt-in scale and propagation-latency benchmark,
+	// configured by BenchmarkConfig.
+	Benchmark       bool                   `json:"benchmark"`
+	BenchmarkConfig config.BenchmarkConfig `json:"benchmarkConfig"`
+	// BenchmarkReportOutputPath is the file where the benchmark report is
+	// written.
+	BenchmarkReportOutputPath string `json:"benchmarkReportOutputPath"`
 	// SkipTests contains all the tests not to be run and can be used to opt out
 	// of specific tests
 	SkipTests []string `json:"skipTests"`
@@ -164,13 +210,51 @@ type ConfigurableOptions struct {
 	// with limited resources.
 	DisableParallelTests bool `json:"disableParallelTests"`
 	// RunTest is a single test to run, mostly for development/debugging convenience.
-	RunTest             string                   `json:"runTest"`
-	Mode                string                   `json:"mode"`
-	AllowCRDsMismatch   bool                     `json:"allowCrdsMismatch"`
+	RunTest string `json:"runTest"`
+	// RunTestsMatching is a regular expression that the short name of tests
+	// must match for them to be run.
+	RunTestsMatching string `json:"runTestsMatching"`
+	// FeatureExpression is a boolean expression over feature names that the
+	// features of tests must satisfy for them to be run, e.g.
+	// "HTTPRoute && !HTTPRouteCORS". See FeatureExpression for the syntax.
+	FeatureExpression string `json:"featureExpression"`
+	// SelectProfiles restricts the tests to run to those belonging to at least
+	// one of the given conformance profiles.
+	SelectProfiles []ConformanceProfileName `json:"selectProfiles"`
+	// ListTests prints which tests would be run or skipped, and why, without
+	// running them or contacting the cluster.
+	ListTests bool `json:"listTests"`
+	// Repeat is the number of times each selected test is run in a row. When
+	// greater than 1, the pass rate of every test is recorded in the report
+	// and tests whose outcome changes between runs are flagged as unstable.
+	// The namespaces of the base resources are recreated before every run.
+	Repeat int `json:"repeat"`
+	// DebugBundleDir is the directory where, for each failed test, a debug
+	// bundle is written with the Gateway API resources, events and echo pods
+	// logs of the test namespaces, the GatewayClass and the last round trip
+	// made by the test. No debug bundle is written when empty.
+	DebugBundleDir    string `json:"debugBundleDir"`
+	Mode              string `json:"mode"`
+	AllowCRDsMismatch bool   `json:"allowCrdsMismatch"`
+	// CRDVersionSkew runs the suite against the installed Gateway API CRDs
+	// whatever their bundle version and channel. Tests whose manifests use
+	// resources, versions or fields absent from the installed CRDs are
+	// skipped, and the installed CRDs are described in the report.
+	CRDVersionSkew      bool                     `json:"crdVersionSkew"`
 	Implementation      confv1.Implementation    `json:"implementation"`
 	ConformanceProfiles []ConformanceProfileName `json:"conformanceProfiles"`
 	FailFast            bool                     `json:"failFast"`
 
+	// GatewayDialer selects how the tests reach Gateway addresses: "direct",
+	// the default, dials them from where the tests run, "port-forward"
+	// port-forwards to the pods serving them through the API server, and
+	// "exec" runs a client connecting to them in GatewayDialerExecPod. Unless
+	// Gateways are dialed directly, HTTP/3 tests are skipped.
+	GatewayDialer string `json:"gatewayDialer"`
+	// GatewayDialerExecPod is the helper pod, as namespace/name, used by the
+	// "exec" GatewayDialer. It must provide socat.
+	GatewayDialerExecPod string `json:"gatewayDialerExecPod"`
+
 	// UsableNetworkAddresses is an optional pool of usable addresses for
 	// Gateways for tests which need to test manual address assignments.
 	UsableNetworkAddresses []gatewayv1.GatewaySpecAddress `json:"usableNetworkAddresses"`
@@ -197,6 +281,12 @@ type ConformanceOptions struct {
 	// Hook is an optional function that can be used to run custom logic after each test at suite level.
 	Hook       func(t *testing.T, test ConformanceTest, suite *ConformanceTestSuite)
 	ManifestFS []fs.FS
+
+	// DialContext is an optional function used by the default HTTP
+	// RoundTripper, gRPC Client, WebSocket Dialer and TCP and UDP clients to
+	// open connections to Gateways. It takes precedence over GatewayDialer.
+	// HTTP/3 tests are skipped when it is set.
+	DialContext func(ctx context.Context, network, address string) (net.Conn, error)
 }
 
 type FeaturesSet = sets.Set[features.FeatureName]
@@ -225,11 +315,9 @@ func NewConformanceTestSuite(options ConformanceOptions) (*ConformanceTestSuite,
 		// NewConformanceTestSuite without setting Mode.
 		options.Mode = "default"
 	}
-	supportedFeatures := sets.New(options.SupportedFeatures...).Difference(sets.New(options.ExemptFeatures...))
+	supportedFeatures := manualSupportedFeatures(&options)
 	source := supportedFeaturesSourceManual
-	if options.EnableAllSupportedFeatures {
-		supportedFeatures = features.SetsToNamesSet(features.AllFeatures).Difference(sets.New(options.ExemptFeatures...))
-	} else if shouldInferSupportedFeatures(&options) {
+	if shouldInferSupportedFeatures(&options) {
 		var err error
 		if options.GatewayClassName != "" {
 			supportedFeatures, err = fetchGatewayClassSupportedFeatures(options.Client, options.GatewayClassName)
@@ -271,18 +359,41 @@ func NewConformanceTestSuite(options ConformanceOptions) (*ConformanceTestSuite,
 		extendedUnsupportedFeatures[conformanceProfileName] = conformanceProfile.ExtendedFeatures.Difference(supportedFeatures)
 	}
 
+	// Every run of a repeated test must start from fresh test resources,
+	// otherwise the outcome of a run could depend on the previous ones.
+	if options.Repeat > 1 && !options.CleanupTestResources {
+		return nil, fmt.Errorf("repeating tests requires test resources to be cleaned up after each test")
+	}
+
 	config.SetupTimeoutConfig(&options.TimeoutConfig)
+	config.SetupWeightConfig(&options.WeightConfig)
+	config.SetupBenchmarkConfig(&options.BenchmarkConfig)
+
+	dialContext := options.DialContext
+	if dialContext == nil {
+		var err error
+		if dialContext, err = gatewayDialContext(options); err != nil {
+			return nil, err
+		}
+	}
 
 	roundTripper := options.RoundTripper
 	if roundTripper == nil {
-		roundTripper = &roundtripper.DefaultRoundTripper{Debug: options.Debug, TimeoutConfig: options.TimeoutConfig}
+		roundTripper = &roundtripper.DefaultRoundTripper{Debug: options.Debug, TimeoutConfig: options.TimeoutConfig, CustomDialContext: dialContext}
+	}
+	if options.DebugBundleDir != "" {
+		// keep the last round trip of each test for the debug bundles
+		roundTripper = &roundtripper.RecordingRoundTripper{RoundTripper: roundTripper}
 	}
 
 	grpcClient := options.GRPCClient
+	if grpcClient == nil && dialContext != nil {
+		grpcClient = &grpc.DefaultClient{DialContext: dialContext}
+	}
 
 	webSocketDialer := options.WebSocketDialer
 	if webSocketDialer == nil {
-		webSocketDialer = &websocket.DefaultDialer{}
+		webSocketDialer = &websocket.DefaultDialer{DialContext: dialContext}
 	}
 
 	installedCRDs := &apiextensionsv1.CustomResourceDefinitionList{}
@@ -290,8 +401,13 @@ func NewConformanceTestSuite(options ConformanceOptions) (*ConformanceTestSuite,
 	if err != nil {
 		return nil, err
 	}
-	apiVersion, apiChannel, err := getAPIVersionAndChannel(installedCRDs.Items)
-	if err != nil {
+	var apiVersion, apiChannel string
+	if options.CRDVersionSkew {
+		apiVersion, apiChannel, err = getSkewedAPIVersionAndChannel(installedCRDs.Items)
+		if err != nil {
+			return nil, err
+		}
+	} else if apiVersion, apiChannel, err = getAPIVersionAndChannel(installedCRDs.Items); err != nil {
 		// in case an error is returned and the AllowCRDsMismatch flag is false, the suite fails.
 		// This is the default behavior but can be customized in case one wants to experiment
 		// with mixed versions/channels of the API.
@@ -310,6 +426,8 @@ func NewConformanceTestSuite(options ConformanceOptions) (*ConformanceTestSuite,
 		RoundTripper:         roundTripper,
 		GRPCClient:           grpcClient,
 		WebSocketDialer:      webSocketDialer,
+		TCPClient:            tcp.Client{DialContext: dialContext},
+		UDPClient:            udp.Client{DialContext: dialContext},
 		GatewayClassName:     options.GatewayClassName,
 		Debug:                options.Debug,
 		Cleanup:              options.CleanupBaseResources,
@@ -322,14 +440,21 @@ func NewConformanceTestSuite(options ConformanceOptions) (*ConformanceTestSuite,
 		},
 		SupportedFeatures:           supportedFeatures,
 		TimeoutConfig:               options.TimeoutConfig,
+		WeightConfig:                options.WeightConfig,
+		BenchmarkConfig:             options.BenchmarkConfig,
 		SkipTests:                   sets.New(options.SkipTests...),
 		RunTest:                     options.RunTest,
+		Repeat:                      options.Repeat,
+		DebugBundleDir:              options.DebugBundleDir,
+		CRDVersionSkew:              options.CRDVersionSkew,
 		SkipProvisionalTests:        options.SkipProvisionalTests,
 		DisableParallelTests:        options.DisableParallelTests,
 		ManifestFS:                  options.ManifestFS,
 		UsableNetworkAddresses:      options.UsableNetworkAddresses,
 		UnusableNetworkAddresses:    options.UnusableNetworkAddresses,
 		results:                     make(map[string]testResult),
+		repeatedResults:             make(map[string]confv1.Statistics),
+		crdVersionSkewReasons:       make(map[string]string),
 		extendedUnsupportedFeatures: extendedUnsupportedFeatures,
 		extendedSupportedFeatures:   extendedSupportedFeatures,
 		conformanceProfiles:         sets.New(options.ConformanceProfiles...),
@@ -340,6 +465,19 @@ func NewConformanceTestSuite(options ConformanceOptions) (*ConformanceTestSuite,
 		supportedFeaturesSource:     source,
 		Hook:                        options.Hook,
 		failFast:                    options.FailFast,
+		http3Unavailable:            http3Unavailable(options),
+	}
+
+	if options.CRDVersionSkew {
+		crds := make([]*apiextensionsv1.CustomResourceDefinition, len(installedCRDs.Items))
+		for i := range installedCRDs.Items {
+			crds[i] = &installedCRDs.Items[i]
+		}
+		suite.installedCRDs = capabilities.NewBundle(crds...)
+	}
+
+	if err := suite.setTestSelection(&options); err != nil {
+		return nil, err
 	}
 
 	// apply defaults
@@ -392,66 +530,105 @@ func (suite *ConformanceTestSuite) Setup(t *testing.T, tests []ConformanceTest)
 		suite.Applier.GatewayClass = suite.GatewayClassName
 		suite.Applier.ControllerName = suite.ControllerName
 
-		tlog.Logf(t, "Test Setup: Applying base manifests")
-		suite.Applier.MustApplyWithCleanup(t, suite.Client, suite.TimeoutConfig, suite.BaseManifests, suite.Cleanup)
-
-		tlog.Logf(t, "Test Setup: Applying programmatic resources")
-		secret := kubernetes.MustCreateSelfSignedCertSecret(t, WebBackendNamespace, "certificate", []string{"*"})
-		suite.Applier.MustApplyObjectsWithCleanup(t, suite.Client, suite.TimeoutConfig, []client.Object{secret}, suite.Cleanup)
-		secret = kubernetes.MustCreateSelfSignedCertSecret(t, InfrastructureNamespace, "tls-validity-checks-certificate", []string{"*", "*.org", "*.wildcard.org"})
-		suite.Applier.MustApplyObjectsWithCleanup(t, suite.Client, suite.TimeoutConfig, []client.Object{secret}, suite.Cleanup)
-		configMap, _, _ := kubernetes.MustCreateCACertConfigMap(t, WebBackendNamespace, "web-backend-cm")
-		suite.Applier.MustApplyObjectsWithCleanup(t, suite.Client, suite.TimeoutConfig, []client.Object{configMap}, suite.Cleanup)
-
-		// secrets for client certificates validation tests
-		caConfigMap, ca, caPrivKey := kubernetes.MustCreateCACertConfigMap(t, InfrastructureNamespace, "tls-validity-checks-ca-certificate")
-		suite.Applier.MustApplyObjectsWithCleanup(t, suite.Client, suite.TimeoutConfig, []client.Object{caConfigMap}, suite.Cleanup)
-		secret = kubernetes.MustCreateCASignedClientCertSecret(t, "gateway-conformance-infra", "tls-validity-checks-client-certificate", ca, caPrivKey)
-		suite.Applier.MustApplyObjectsWithCleanup(t, suite.Client, suite.TimeoutConfig, []client.Object{secret}, suite.Cleanup)
-		caConfigMap, ca, caPrivKey = kubernetes.MustCreateCACertConfigMap(t, InfrastructureNamespace, "tls-validity-checks-per-port-ca-certificate")
-		suite.Applier.MustApplyObjectsWithCleanup(t, suite.Client, suite.TimeoutConfig, []client.Object{caConfigMap}, suite.Cleanup)
-		secret = kubernetes.MustCreateCASignedClientCertSecret(t, InfrastructureNamespace, "tls-validity-checks-per-port-client-certificate", ca, caPrivKey)
-		suite.Applier.MustApplyObjectsWithCleanup(t, suite.Client, suite.TimeoutConfig, []client.Object{secret}, suite.Cleanup)
-
-		caConfigMap, ca, caPrivKey = kubernetes.MustCreateCACertConfigMap(t, InfrastructureNamespace, "tls-checks-ca-certificate")
-		suite.Applier.MustApplyObjectsWithCleanup(t, suite.Client, suite.TimeoutConfig, []client.Object{caConfigMap}, suite.Cleanup)
-		secret = kubernetes.MustCreateCASignedCertSecret(t, InfrastructureNamespace, "tls-checks-certificate", []string{"abc.example.com", "spiffe://abc.example.com/test-identity", "other.example.com"}, ca, caPrivKey)
-		suite.Applier.MustApplyObjectsWithCleanup(t, suite.Client, suite.TimeoutConfig, []client.Object{secret}, suite.Cleanup)
-		secret = kubernetes.MustCreateCASignedClientCertSecret(t, InfrastructureNamespace, "tls-checks-client-certificate", ca, caPrivKey)
-		suite.Applier.MustApplyObjectsWithCleanup(t, suite.Client, suite.TimeoutConfig, []client.Object{secret}, suite.Cleanup)
-
-		// Secret used for tcp-backend serving TLS
-		secret = kubernetes.MustCreateCASignedCertSecret(t, InfrastructureNamespace, "tls-passthrough-checks-certificate", []string{"abc.example.com"}, ca, caPrivKey)
-		suite.Applier.MustApplyObjectsWithCleanup(t, suite.Client, suite.TimeoutConfig, []client.Object{secret}, suite.Cleanup)
-		secret = kubernetes.MustCreateCASignedCertSecret(t, AppBackendNamespace, "tls-passthrough-checks-certificate", []string{"abc.example.com"}, ca, caPrivKey)
-		suite.Applier.MustApplyObjectsWithCleanup(t, suite.Client, suite.TimeoutConfig, []client.Object{secret}, suite.Cleanup)
-
-		// The following secret is used for TLSRoute mode Terminate validation
-		secret = kubernetes.MustCreateCASignedCertSecret(t, InfrastructureNamespace, "tls-terminate-checks-certificate", []string{"tls.example.com"}, ca, caPrivKey)
-		suite.Applier.MustApplyObjectsWithCleanup(t, suite.Client, suite.TimeoutConfig, []client.Object{secret}, suite.Cleanup)
-
-		// The following CA certificate is used for BackendTLSPolicy testing to intentionally force TLS validation to fail.
-		caConfigMap, _, _ = kubernetes.MustCreateCACertConfigMap(t, InfrastructureNamespace, "mismatch-ca-certificate")
-		suite.Applier.MustApplyObjectsWithCleanup(t, suite.Client, suite.TimeoutConfig, []client.Object{caConfigMap}, suite.Cleanup)
-
-		tlog.Logf(t, "Test Setup: Ensuring Gateways and Pods from base manifests are ready")
-		namespaces := []string{
+		suite.setupBaseResources(t, suite.Cleanup)
+	}
+
+	if supportsMesh {
+		suite.setupMeshResources(t, suite.Cleanup)
+	}
+
+	suite.baseSetUp, suite.meshSetUp = supportsGateway, supportsMesh
+}
+
+// setupBaseResources applies the base manifests and resources of the Gateway
+// tests, and waits for them to be ready. If cleanup is true, they are deleted
+// when t completes.
+func (suite *ConformanceTestSuite) setupBaseResources(t *testing.T, cleanup bool) {
+	tlog.Logf(t, "Test Setup: Applying base manifests")
+	suite.Applier.MustApplyWithCleanup(t, suite.Client, suite.TimeoutConfig, suite.BaseManifests, cleanup)
+
+	tlog.Logf(t, "Test Setup: Applying programmatic resources")
+	secret := kubernetes.MustCreateSelfSignedCertSecret(t, WebBackendNamespace, "certificate", []string{"*"})
+	suite.Applier.MustApplyObjectsWithCleanup(t, suite.Client, suite.TimeoutConfig, []client.Object{secret}, cleanup)
+	secret = kubernetes.MustCreateSelfSignedCertSecret(t, InfrastructureNamespace, "tls-validity-checks-certificate", []string{"*", "*.org", "*.wildcard.org"})
+	suite.Applier.MustApplyObjectsWithCleanup(t, suite.Client, suite.TimeoutConfig, []client.Object{secret}, cleanup)
+	configMap, _, _ := kubernetes.MustCreateCACertConfigMap(t, WebBackendNamespace, "web-backend-cm")
+	suite.Applier.MustApplyObjectsWithCleanup(t, suite.Client, suite.TimeoutConfig, []client.Object{configMap}, cleanup)
+
+	// secrets for client certificates validation tests
+	caConfigMap, ca, caPrivKey := kubernetes.MustCreateCACertConfigMap(t, InfrastructureNamespace, "tls-validity-checks-ca-certificate")
+	suite.Applier.MustApplyObjectsWithCleanup(t, suite.Client, suite.TimeoutConfig, []client.Object{caConfigMap}, cleanup)
+	secret = kubernetes.MustCreateCASignedClientCertSecret(t, "gateway-conformance-infra", "tls-validity-checks-client-certificate", ca, caPrivKey)
+	suite.Applier.MustApplyObjectsWithCleanup(t, suite.Client, suite.TimeoutConfig, []client.Object{secret}, cleanup)
+	caConfigMap, ca, caPrivKey = kubernetes.MustCreateCACertConfigMap(t, InfrastructureNamespace, "tls-validity-checks-per-port-ca-certificate")
+	suite.Applier.MustApplyObjectsWithCleanup(t, suite.Client, suite.TimeoutConfig, []client.Object{caConfigMap}, cleanup)
+	secret = kubernetes.MustCreateCASignedClientCertSecret(t, InfrastructureNamespace, "tls-validity-checks-per-port-client-certificate", ca, caPrivKey)
+	suite.Applier.MustApplyObjectsWithCleanup(t, suite.Client, suite.TimeoutConfig, []client.Object{secret}, cleanup)
+
+	caConfigMap, ca, caPrivKey = kubernetes.MustCreateCACertConfigMap(t, InfrastructureNamespace, "tls-checks-ca-certificate")
+	suite.Applier.MustApplyObjectsWithCleanup(t, suite.Client, suite.TimeoutConfig, []client.Object{caConfigMap}, cleanup)
+	secret = kubernetes.MustCreateCASignedCertSecret(t, InfrastructureNamespace, "tls-checks-certificate", []string{"abc.example.com", "spiffe://abc.example.com/test-identity", "other.example.com"}, ca, caPrivKey)
+	suite.Applier.MustApplyObjectsWithCleanup(t, suite.Client, suite.TimeoutConfig, []client.Object{secret}, cleanup)
+	secret = kubernetes.MustCreateCASignedClientCertSecret(t, InfrastructureNamespace, "tls-checks-client-certificate", ca, caPrivKey)
+	suite.Applier.MustApplyObjectsWithCleanup(t, suite.Client, suite.TimeoutConfig, []client.Object{secret}, cleanup)
+
+	// Secret used for tcp-backend serving TLS
+	secret = kubernetes.MustCreateCASignedCertSecret(t, InfrastructureNamespace, "tls-passthrough-checks-certificate", []string{"abc.example.com"}, ca, caPrivKey)
+	suite.Applier.MustApplyObjectsWithCleanup(t, suite.Client, suite.TimeoutConfig, []client.Object{secret}, cleanup)
+	secret = kubernetes.MustCreateCASignedCertSecret(t, AppBackendNamespace, "tls-passthrough-checks-certificate", []string{"abc.example.com"}, ca, caPrivKey)
+	suite.Applier.MustApplyObjectsWithCleanup(t, suite.Client, suite.TimeoutConfig, []client.Object{secret}, cleanup)
+
+	// The following secret is used for TLSRoute mode Terminate validation
+	secret = kubernetes.MustCreateCASignedCertSecret(t, InfrastructureNamespace, "tls-terminate-checks-certificate", []string{"tls.example.com"}, ca, caPrivKey)
+	suite.Applier.MustApplyObjectsWithCleanup(t, suite.Client, suite.TimeoutConfig, []client.Object{secret}, cleanup)
+
+	// The following CA certificate is used for BackendTLSPolicy testing to intentionally force TLS validation to fail.
+	caConfigMap, _, _ = kubernetes.MustCreateCACertConfigMap(t, InfrastructureNamespace, "mismatch-ca-certificate")
+	suite.Applier.MustApplyObjectsWithCleanup(t, suite.Client, suite.TimeoutConfig, []client.Object{caConfigMap}, cleanup)
+
+	tlog.Logf(t, "Test Setup: Ensuring Gateways and Pods from base manifests are ready")
+	namespaces := []string{
+		InfrastructureNamespace,
+		AppBackendNamespace,
+		WebBackendNamespace,
+	}
+	kubernetes.NamespacesMustBeReady(t, suite.Client, suite.TimeoutConfig, namespaces)
+}
+
+// setupMeshResources applies the base manifests of the Mesh tests, and waits
+// for them to be ready. If cleanup is true, they are deleted when t completes.
+func (suite *ConformanceTestSuite) setupMeshResources(t *testing.T, cleanup bool) {
+	tlog.Logf(t, "Test Setup: Applying base manifests")
+	suite.Applier.MustApplyWithCleanup(t, suite.Client, suite.TimeoutConfig, suite.MeshManifests, cleanup)
+	tlog.Logf(t, "Test Setup: Ensuring Gateways and Pods from mesh manifests are ready")
+	namespaces := []string{
+		MeshNamespace,
+		MeshConsumerNamespace,
+	}
+	kubernetes.MeshNamespacesMustBeReady(t, suite.Client, suite.TimeoutConfig, namespaces)
+}
+
+// recreateBaseNamespaces deletes the namespaces of the base resources applied
+// by Setup, and applies the base resources again, so that the next test
+// starts from fresh namespaces. The resources are still deleted when the
+// suite completes if it cleans up after itself.
+func (suite *ConformanceTestSuite) recreateBaseNamespaces(t *testing.T) {
+	if suite.baseSetUp {
+		tlog.Logf(t, "Test Setup: Recreating base namespaces")
+		kubernetes.NamespacesMustBeDeleted(t, suite.Client, suite.TimeoutConfig, []string{
 			InfrastructureNamespace,
 			AppBackendNamespace,
 			WebBackendNamespace,
-		}
-		kubernetes.NamespacesMustBeReady(t, suite.Client, suite.TimeoutConfig, namespaces)
+		})
+		suite.setupBaseResources(t, false)
 	}
-
-	if supportsMesh {
-		tlog.Logf(t, "Test Setup: Applying base manifests")
-		suite.Applier.MustApplyWithCleanup(t, suite.Client, suite.TimeoutConfig, suite.MeshManifests, suite.Cleanup)
-		tlog.Logf(t, "Test Setup: Ensuring Gateways and Pods from mesh manifests are ready")
-		namespaces := []string{
+	if suite.meshSetUp {
+		tlog.Logf(t, "Test Setup: Recreating mesh namespaces")
+		kubernetes.NamespacesMustBeDeleted(t, suite.Client, suite.TimeoutConfig, []string{
 			MeshNamespace,
 			MeshConsumerNamespace,
-		}
-		kubernetes.MeshNamespacesMustBeReady(t, suite.Client, suite.TimeoutConfig, namespaces)
+		})
+		suite.setupMeshResources(t, false)
 	}
 }
 
@@ -498,6 +675,8 @@ func (suite *ConformanceTestSuite) Run(t *testing.T, tests []ConformanceTest) er
 	// new test run.
 	suite.running = true
 	suite.results = make(map[string]testResult)
+	suite.repeatedResults = make(map[string]confv1.Statistics)
+	suite.crdVersionSkewReasons = make(map[string]string)
 	suite.lock.Unlock()
 
 	t.Cleanup(func() {
@@ -509,19 +688,7 @@ func (suite *ConformanceTestSuite) Run(t *testing.T, tests []ConformanceTest) er
 	// run all tests and collect the test results for conformance reporting
 	sleepForTestIsolation := false
 	for _, test := range tests {
-		res := testSucceeded
-		if suite.RunTest != "" && test.ShortName != suite.RunTest {
-			res = testSkipped
-		}
-		if suite.SkipTests.Has(test.ShortName) {
-			res = testSkipped
-		}
-		if suite.SkipProvisionalTests && test.Provisional {
-			res = testProvisionalSkipped
-		}
-		if !suite.SupportedFeatures.HasAll(test.Features...) {
-			res = testNotSupported
-		}
+		res := suite.initialTestResult(test)
 
 		// TODO(wstcliyu): need a better long term solution for test isolation
 		// https://github.com/kubernetes-sigs/gateway-api/issues/3233
@@ -539,6 +706,10 @@ func (suite *ConformanceTestSuite) Run(t *testing.T, tests []ConformanceTest) er
 			})
 			err := suite.setClientsetForTest(test)
 			require.NoError(subT, err, "failed to create new clientset for test")
+			if suite.Repeat > 1 && res == testSucceeded {
+				suite.runRepeated(subT, test)
+				return
+			}
 			test.Run(subT, suite)
 		})
 
@@ -551,9 +722,62 @@ func (suite *ConformanceTestSuite) Run(t *testing.T, tests []ConformanceTest) er
 		}
 	}
 
+	if suite.Repeat > 1 {
+		suite.logRepeatedResults(t)
+	}
+
 	return nil
 }
 
+// runRepeated runs the provided test suite.Repeat times in a row, each run
+// being a subtest with its own test resources, and records the outcome of
+// every run. The namespaces of the base resources are recreated before every
+// run, so repeated tests are never run in parallel with other tests.
+func (suite *ConformanceTestSuite) runRepeated(t *testing.T, test ConformanceTest) {
+	stats := confv1.Statistics{}
+	for i := 1; i <= suite.Repeat; i++ {
+		suite.recreateBaseNamespaces(t)
+		t.Run(fmt.Sprintf("run-%d", i), func(runT *testing.T) {
+			runT.Cleanup(func() {
+				switch {
+				case runT.Failed():
+					stats.Failed++
+				case runT.Skipped():
+					stats.Skipped++
+				default:
+					stats.Passed++
+				}
+			})
+			test.run(runT, suite)
+		})
+	}
+
+	suite.lock.Lock()
+	suite.repeatedResults[test.ShortName] = stats
+	suite.lock.Unlock()
+
+	if stats.Passed == 0 && stats.Failed == 0 {
+		t.Skipf("Skipping %s: all %d runs were skipped", test.ShortName, suite.Repeat)
+	}
+}
+
+// logRepeatedResults logs the pass rate of every repeated test, flagging the
+// tests whose outcome was not stable across runs.
+func (suite *ConformanceTestSuite) logRepeatedResults(t *testing.T) {
+	suite.lock.RLock()
+	defer suite.lock.RUnlock()
+
+	for _, report := range buildRepeatedTestReports(suite.repeatedResults) {
+		if report.Stable {
+			tlog.Logf(t, "Repeated test %s: pass rate %s (%d passed, %d failed, %d skipped)",
+				report.Name, report.PassRate, report.Passed, report.Failed, report.Skipped)
+		} else {
+			tlog.Logf(t, "Repeated test %s is UNSTABLE: pass rate %s (%d passed, %d failed, %d skipped)",
+				report.Name, report.PassRate, report.Passed, report.Failed, report.Skipped)
+		}
+	}
+}
+
 func (suite *ConformanceTestSuite) recordTestResult(t *testing.T, test ConformanceTest, initialRes resultType) {
 	res := initialRes
 	switch {
@@ -602,6 +826,10 @@ func (suite *ConformanceTestSuite) Report() (*confv1.ConformanceReport, error) {
 		if tr.result == testProvisionalSkipped {
 			continue
 		}
+		// implementation-specific tests are reported separately
+		if _, ok := implementationSpecificTestNamespaces[tN]; ok {
+			continue
+		}
 		if tr.result == testSucceeded && tr.test.Provisional {
 			succeededProvisionalTestSet.Insert(tN)
 		}
@@ -632,6 +860,9 @@ func (suite *ConformanceTestSuite) Report() (*confv1.ConformanceReport, error) {
 		GatewayAPIChannel:         suite.apiChannel,
 		ProfileReports:            profileReports.list(),
 		SucceededProvisionalTests: succeededProvisionalTests,
+		RepeatedTests:             buildRepeatedTestReports(suite.repeatedResults),
+		ImplementationSpecific:    buildImplementationSpecificReports(suite.results, suite.SupportedFeatures),
+		CRDVersionSkew:            suite.buildCRDVersionSkew(),
 	}, nil
 }
 
@@ -669,10 +900,7 @@ func fetchGatewayClassSupportedFeatures(client client.Client, gatewayClassName s
 		return nil, fmt.Errorf("fetchGatewayClassSupportedFeatures(): %w", err)
 	}
 
-	fs := FeaturesSet{}
-	for _, feature := range gwc.Status.SupportedFeatures {
-		fs.Insert(features.FeatureName(feature.Name))
-	}
+	fs := capabilities.GatewayClassSupportedFeatures(gwc)
 
 	// If Mesh features are populated in the GatewayClass we remove them from the supported features set.
 	meshFeatureNames := features.SetsToNamesSet(features.MeshCoreFeatures, features.MeshExtendedFeatures)
@@ -712,6 +940,17 @@ func fetchMeshSupportedFeatures(client client.Client, meshName string) (Features
 	return fs, nil
 }
 
+// manualSupportedFeatures returns the supported features explicitly provided
+// through the options, without the exempt features.
+func manualSupportedFeatures(opts *ConformanceOptions) FeaturesSet {
+	if opts.EnableAllSupportedFeatures {
+		return features.SetsToNamesSet(features.AllFeatures).
+			Union(implementationSpecificFeatures()).
+			Difference(sets.New(opts.ExemptFeatures...))
+	}
+	return sets.New(opts.SupportedFeatures...).Difference(sets.New(opts.ExemptFeatures...))
+}
+
 // shouldInferSupportedFeatures checks if any flags were supplied for manually
 // picking what to test. Inferred supported features are only used when no flags
 // are set.
@@ -0,0 +1,224 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tcp
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	tcpserver "sigs.k8s.io/gateway-api-conformance-images/echo-basic/tcpserver"
+	"sigs.k8s.io/gateway-api/conformance/utils/config"
+	"sigs.k8s.io/gateway-api/conformance/utils/tlog"
+)
+
+// ErrSessionClosed is returned by the Session exchanges when the connection
+// was closed, or reset, by the Gateway or the backend.
+var ErrSessionClosed = errors.New("TCP session closed by the peer")
+
+// Session is a long-lived connection to the conformance TCP echo backend, on
+// which any number of exchanges can be made. It allows to verify that a
+// connection keeps being served by the same backend over time.
+type Session struct {
+	conn    net.Conn
+	reader  *bufio.Reader
+	timeout time.Duration
+	pod     string
+}
+
+// OpenSession opens a connection to gwAddr, checks the welcome message of the
+// TCP echo backend, and records the Pod serving the connection. Every exchange
+// made on the session must complete within timeout.
+func OpenSession(ctx context.Context, gwAddr string, timeout time.Duration) (*Session, error) {
+	return Client{}.OpenSession(ctx, gwAddr, timeout)
+}
+
+// OpenSession is the same as the package level function, dialing through the
+// client.
+func (c Client) OpenSession(ctx context.Context, gwAddr string, timeout time.Duration) (*Session, error) {
+	conn, err := c.makeDialer(nil).DialContext(ctx, "tcp", gwAddr)
+	if err != nil {
+		return nil, fmt.Errorf("dialing TCP %s: %w", gwAddr, err)
+	}
+	s := &Session{conn: conn, reader: bufio.NewReader(conn), timeout: timeout}
+
+	if err = conn.SetDeadline(time.Now().Add(timeout)); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("setting TCP deadline: %w", err)
+	}
+	welcome, err := s.reader.ReadString('\n')
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("reading TCP welcome message: %w", sessionError(err))
+	}
+	if welcome != tcpserver.WelcomeMessage {
+		conn.Close()
+		return nil, fmt.Errorf("unexpected TCP welcome message: %q", welcome)
+	}
+
+	resp, err := s.Test()
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	s.pod = resp.Pod
+	return s, nil
+}
+
+// Pod returns the name of the Pod that served the connection when it was
+// opened.
+func (s *Session) Pod() string {
+	return s.pod
+}
+
+// Ping checks that the connection is still served, without asserting which
+// backend serves it.
+func (s *Session) Ping() error {
+	line, err := s.exchange("PING")
+	if err != nil {
+		return err
+	}
+	if line != "PONG" {
+		return fmt.Errorf("unexpected PING response: %q", line)
+	}
+	return nil
+}
+
+// Test makes a TEST exchange on the connection and returns the decoded
+// response of the backend.
+func (s *Session) Test() (*tcpserver.TCPAssertions, error) {
+	line, err := s.exchange("TEST")
+	if err != nil {
+		return nil, err
+	}
+	resp := &tcpserver.TCPAssertions{}
+	if err := json.Unmarshal([]byte(line), resp); err != nil {
+		return nil, fmt.Errorf("decoding TCP echo response %q: %w", line, err)
+	}
+	if resp.Pod == "" {
+		return nil, fmt.Errorf("TCP echo response missing pod name: %q", line)
+	}
+	return resp, nil
+}
+
+// ExpectSameBackend makes a TEST exchange on the connection and checks that
+// it is still served by the Pod that served it when it was opened.
+func (s *Session) ExpectSameBackend() error {
+	resp, err := s.Test()
+	if err != nil {
+		return err
+	}
+	if resp.Pod != s.pod {
+		return fmt.Errorf("TCP session opened on pod %s is now served by pod %s", s.pod, resp.Pod)
+	}
+	return nil
+}
+
+// Close closes the connection.
+func (s *Session) Close() error {
+	return s.conn.Close()
+}
+
+func (s *Session) exchange(command string) (string, error) {
+	if err := s.conn.SetDeadline(time.Now().Add(s.timeout)); err != nil {
+		return "", fmt.Errorf("setting TCP deadline: %w", err)
+	}
+	if _, err := fmt.Fprintf(s.conn, "%s\n", command); err != nil {
+		return "", fmt.Errorf("writing %s: %w", command, sessionError(err))
+	}
+	line, err := s.reader.ReadString('\n')
+	if err != nil {
+		return "", fmt.Errorf("reading %s response: %w", command, sessionError(err))
+	}
+	return strings.TrimSuffix(line, "\n"), nil
+}
+
+// sessionError wraps err with ErrSessionClosed when it means that the peer
+// closed the connection.
+func sessionError(err error) error {
+	if errors.Is(err, io.EOF) || errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.EPIPE) {
+		return fmt.Errorf("%w: %w", ErrSessionClosed, err)
+	}
+	return err
+}
+
+// EchoSendSession opens a single TCP connection to gwAddr, makes exchanges
+// TEST exchanges on it, and returns the name of the Pod serving it. It fails
+// if the exchanges are not all served by the same Pod. Unlike EchoSendOnce, it
+// can be used to sample the distribution of connections rather than of
+// exchanges, when run concurrently.
+func EchoSendSession(ctx context.Context, gwAddr string, exchanges int, timeout time.Duration) (string, error) {
+	return Client{}.EchoSendSession(ctx, gwAddr, exchanges, timeout)
+}
+
+// EchoSendSession is the same as the package level function, dialing through
+// the client.
+func (c Client) EchoSendSession(ctx context.Context, gwAddr string, exchanges int, timeout time.Duration) (string, error) {
+	s, err := c.OpenSession(ctx, gwAddr, timeout)
+	if err != nil {
+		return "", err
+	}
+	defer s.Close()
+
+	// the session already made one exchange when opened
+	for range exchanges - 1 {
+		if err := s.ExpectSameBackend(); err != nil {
+			return "", err
+		}
+	}
+	return s.Pod(), nil
+}
+
+// ExpectSessionDrained checks that the session is drained gracefully, after
+// the backend serving it was removed from the routes or the route was
+// deleted: for as long as MaxTimeToConsistency, the connection must either
+// keep being served by its original backend, or be closed. A connection left
+// hanging, or served by another backend, fails the test.
+func ExpectSessionDrained(t *testing.T, timeoutConfig config.TimeoutConfig, s *Session) {
+	t.Helper()
+
+	var closed bool
+	err := wait.PollUntilContextTimeout(t.Context(), timeoutConfig.DefaultPollInterval, timeoutConfig.MaxTimeToConsistency, true,
+		func(_ context.Context) (bool, error) {
+			err := s.ExpectSameBackend()
+			if errors.Is(err, ErrSessionClosed) {
+				tlog.Logf(t, "TCP session opened on pod %s was closed: %v", s.Pod(), err)
+				closed = true
+				return true, nil
+			}
+			return false, err
+		})
+	if closed {
+		return
+	}
+	if wait.Interrupted(err) {
+		tlog.Logf(t, "TCP session opened on pod %s was kept open for %v", s.Pod(), timeoutConfig.MaxTimeToConsistency)
+		return
+	}
+	require.NoError(t, err, "TCP session opened on pod %s was not drained gracefully", s.Pod())
+}
@@ -0,0 +1,101 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tcp
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	tcpserver "sigs.k8s.io/gateway-api-conformance-images/echo-basic/tcpserver"
+)
+
+// serveEcho runs a minimal TCP echo backend answering as pod, and closing
+// every connection after maxExchanges exchanges when not zero.
+func serveEcho(t *testing.T, pod string, maxExchanges int) string {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { l.Close() })
+
+	payload, err := json.Marshal(tcpserver.TCPAssertions{Context: tcpserver.Context{Pod: pod}})
+	require.NoError(t, err)
+
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				fmt.Fprint(conn, tcpserver.WelcomeMessage)
+				scanner := bufio.NewScanner(conn)
+				for exchanges := 0; scanner.Scan(); exchanges++ {
+					if maxExchanges > 0 && exchanges == maxExchanges {
+						return
+					}
+					switch scanner.Text() {
+					case "TEST":
+						fmt.Fprintf(conn, "%s\n", payload)
+					case "PING":
+						fmt.Fprint(conn, "PONG\n")
+					}
+				}
+			}()
+		}
+	}()
+	return l.Addr().String()
+}
+
+func TestSession(t *testing.T) {
+	addr := serveEcho(t, "tcp-backend-v1-abc-def", 0)
+
+	s, err := OpenSession(context.Background(), addr, time.Second)
+	require.NoError(t, err)
+	defer s.Close()
+
+	require.Equal(t, "tcp-backend-v1-abc-def", s.Pod())
+	require.NoError(t, s.Ping())
+	require.NoError(t, s.ExpectSameBackend())
+
+	pod, err := EchoSendSession(context.Background(), addr, 3, time.Second)
+	require.NoError(t, err)
+	require.Equal(t, "tcp-backend-v1-abc-def", pod)
+}
+
+func TestSessionClosedByPeer(t *testing.T) {
+	// the connection is closed after the TEST exchange of OpenSession and
+	// one more exchange
+	addr := serveEcho(t, "tcp-backend-v1-abc-def", 2)
+
+	s, err := OpenSession(context.Background(), addr, time.Second)
+	require.NoError(t, err)
+	defer s.Close()
+
+	require.NoError(t, s.Ping())
+	require.ErrorIs(t, s.Ping(), ErrSessionClosed)
+
+	_, err = EchoSendSession(context.Background(), addr, 3, time.Second)
+	require.ErrorIs(t, err, ErrSessionClosed)
+}
@@ -42,6 +42,14 @@ type Dialer interface {
 	Dial(network, address string) (net.Conn, error)
 }
 
+// Client makes the TCP requests of the conformance tests. Its zero value dials
+// the Gateway addresses directly, as the package level functions do.
+type Client struct {
+	// DialContext, if set, is used to open the connections to the Gateway
+	// instead of dialing its address directly.
+	DialContext func(ctx context.Context, network, address string) (net.Conn, error)
+}
+
 type ExpectedResponse struct {
 	BackendIsTLS bool
 	Backend      string // Backend will be asserted from podname.
@@ -57,6 +65,13 @@ type ExpectedResponse struct {
 // of the backend, it will pass, otherwise fails
 func MakeTCPRequestAndExpectEventuallyValidResponse(t *testing.T, timeoutConfig config.TimeoutConfig, gwAddr string, serverCertificate []byte, serverName string, useTLS bool, expected ExpectedResponse) {
 	t.Helper()
+	Client{}.MakeTCPRequestAndExpectEventuallyValidResponse(t, timeoutConfig, gwAddr, serverCertificate, serverName, useTLS, expected)
+}
+
+// MakeTCPRequestAndExpectEventuallyValidResponse is the same as the package
+// level function, dialing through the client.
+func (c Client) MakeTCPRequestAndExpectEventuallyValidResponse(t *testing.T, timeoutConfig config.TimeoutConfig, gwAddr string, serverCertificate []byte, serverName string, useTLS bool, expected ExpectedResponse) {
+	t.Helper()
 
 	var tlsConfig *tls.Config
 
@@ -71,7 +86,7 @@ func MakeTCPRequestAndExpectEventuallyValidResponse(t *testing.T, timeoutConfig
 			tlsConfig.RootCAs = certPool
 		}
 	}
-	dialer := makeClient(tlsConfig)
+	dialer := c.makeDialer(tlsConfig)
 	WaitForValidTCPResponse(t, dialer, gwAddr, expected, timeoutConfig)
 }
 
@@ -146,7 +161,11 @@ func WaitForValidTCPResponse(t *testing.T, dialer Dialer, gwAddr string, expecte
 	tlog.Logf(t, "Request passed")
 }
 
-func makeClient(tlsConfig *tls.Config) Dialer {
+func (c Client) makeDialer(tlsConfig *tls.Config) Dialer {
+	if c.DialContext != nil {
+		return &contextDialer{dialContext: c.DialContext, tlsConfig: tlsConfig}
+	}
+
 	if tlsConfig == nil {
 		return &net.Dialer{}
 	}
@@ -156,6 +175,31 @@ func makeClient(tlsConfig *tls.Config) Dialer {
 	}
 }
 
+// contextDialer is a Dialer opening connections with a custom DialContext
+// function, and establishing TLS over them when tlsConfig is set.
+type contextDialer struct {
+	dialContext func(ctx context.Context, network, address string) (net.Conn, error)
+	tlsConfig   *tls.Config
+}
+
+func (d *contextDialer) DialContext(ctx context.Context, network, address string) (net.Conn, error) {
+	conn, err := d.dialContext(ctx, network, address)
+	if err != nil || d.tlsConfig == nil {
+		return conn, err
+	}
+
+	tlsConn := tls.Client(conn, d.tlsConfig)
+	if err := tlsConn.HandshakeContext(ctx); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return tlsConn, nil
+}
+
+func (d *contextDialer) Dial(network, address string) (net.Conn, error) {
+	return d.DialContext(context.Background(), network, address)
+}
+
 func assertTestMessage(t *testing.T, payload *tcpserver.TCPAssertions, expected ExpectedResponse) {
 	t.Helper()
 	require.NotNil(t, payload)
@@ -174,43 +218,18 @@ func assertTestMessage(t *testing.T, payload *tcpserver.TCPAssertions, expected
 // It is intended for tests that need to attribute a single response to a
 // specific backend Pod (for example, weighted routing).
 func EchoSendOnce(ctx context.Context, gwAddr string, timeout time.Duration) (string, error) {
-	var dialer net.Dialer
-	conn, err := dialer.DialContext(ctx, "tcp", gwAddr)
-	if err != nil {
-		return "", fmt.Errorf("dialing TCP %s: %w", gwAddr, err)
-	}
-	defer conn.Close()
-
-	if err = conn.SetDeadline(time.Now().Add(timeout)); err != nil {
-		return "", fmt.Errorf("setting TCP deadline: %w", err)
-	}
-
-	reader := bufio.NewReader(conn)
-	welcome, err := reader.ReadString('\n')
-	if err != nil {
-		return "", fmt.Errorf("reading TCP welcome message: %w", err)
-	}
-	if welcome != tcpserver.WelcomeMessage {
-		return "", fmt.Errorf("unexpected TCP welcome message: %q", welcome)
-	}
-
-	if _, err = fmt.Fprint(conn, "TEST\n"); err != nil {
-		return "", fmt.Errorf("writing TEST: %w", err)
-	}
+	return Client{}.EchoSendOnce(ctx, gwAddr, timeout)
+}
 
-	line, err := reader.ReadString('\n')
+// EchoSendOnce is the same as the package level function, dialing through the
+// client.
+func (c Client) EchoSendOnce(ctx context.Context, gwAddr string, timeout time.Duration) (string, error) {
+	s, err := c.OpenSession(ctx, gwAddr, timeout)
 	if err != nil {
-		return "", fmt.Errorf("reading TEST response: %w", err)
+		return "", err
 	}
-
-	var resp tcpserver.TCPAssertions
-	if err := json.Unmarshal([]byte(line), &resp); err != nil {
-		return "", fmt.Errorf("decoding TCP echo response %q: %w", line, err)
-	}
-	if resp.Pod == "" {
-		return "", fmt.Errorf("TCP echo response missing pod name: %q", line)
-	}
-	return resp.Pod, nil
+	defer s.Close()
+	return s.Pod(), nil
 }
 
 // ExpectEchoResponse polls until a complete TCP echo handshake succeeds against
@@ -218,11 +237,18 @@ func EchoSendOnce(ctx context.Context, gwAddr string, timeout time.Duration) (st
 // connection probe, this verifies that a route has reached a ready backend.
 func ExpectEchoResponse(t *testing.T, timeoutConfig config.TimeoutConfig, address string) {
 	t.Helper()
+	Client{}.ExpectEchoResponse(t, timeoutConfig, address)
+}
+
+// ExpectEchoResponse is the same as the package level function, dialing
+// through the client.
+func (c Client) ExpectEchoResponse(t *testing.T, timeoutConfig config.TimeoutConfig, address string) {
+	t.Helper()
 
 	tlog.Logf(t, "performing TCP echo probe on %s", address)
 	err := wait.PollUntilContextTimeout(t.Context(), timeoutConfig.DefaultPollInterval, timeoutConfig.MaxTimeToConsistency, true,
 		func(ctx context.Context) (bool, error) {
-			pod, err := EchoSendOnce(ctx, address, timeoutConfig.RequestTimeout)
+			pod, err := c.EchoSendOnce(ctx, address, timeoutConfig.RequestTimeout)
 			if err != nil {
 				tlog.Logf(t, "failed to receive a TCP echo response from %s; retrying: %v", address, err)
 				return false, nil
@@ -233,18 +259,55 @@ func ExpectEchoResponse(t *testing.T, timeoutConfig config.TimeoutConfig, addres
 	require.NoError(t, err, "failed waiting for a TCP echo response from %s after %v", address, timeoutConfig.MaxTimeToConsistency)
 }
 
+// ExpectEchoResponseFromBackend polls until a TCP echo handshake against the
+// provided address is answered by a Pod of the given backend, or fails the
+// test if the timeout expires. It allows to wait for a route update to be
+// applied to new connections.
+func ExpectEchoResponseFromBackend(t *testing.T, timeoutConfig config.TimeoutConfig, address, backend string) {
+	t.Helper()
+	Client{}.ExpectEchoResponseFromBackend(t, timeoutConfig, address, backend)
+}
+
+// ExpectEchoResponseFromBackend is the same as the package level function,
+// dialing through the client.
+func (c Client) ExpectEchoResponseFromBackend(t *testing.T, timeoutConfig config.TimeoutConfig, address, backend string) {
+	t.Helper()
+
+	tlog.Logf(t, "performing TCP echo probe on %s expecting backend %s", address, backend)
+	err := wait.PollUntilContextTimeout(t.Context(), timeoutConfig.DefaultPollInterval, timeoutConfig.MaxTimeToConsistency, true,
+		func(ctx context.Context) (bool, error) {
+			pod, err := c.EchoSendOnce(ctx, address, timeoutConfig.RequestTimeout)
+			if err != nil {
+				tlog.Logf(t, "failed to receive a TCP echo response from %s; retrying: %v", address, err)
+				return false, nil
+			}
+			if !strings.HasPrefix(pod, backend+"-") {
+				tlog.Logf(t, "TCP echo response from %s came from pod %s, not from backend %s; retrying", address, pod, backend)
+				return false, nil
+			}
+			return true, nil
+		})
+	require.NoError(t, err, "failed waiting for a TCP echo response from backend %s on %s after %v", backend, address, timeoutConfig.MaxTimeToConsistency)
+}
+
 // ExpectAddressBeAvailable polls until a TCP connection to the provided address
 // can be established, or fails the test if the timeout expires. It only verifies
 // that the address accepts TCP connections; it does not validate an echo response
 // or backend selection.
 func ExpectAddressBeAvailable(t *testing.T, timeoutConfig config.TimeoutConfig, address string) {
 	t.Helper()
+	Client{}.ExpectAddressBeAvailable(t, timeoutConfig, address)
+}
+
+// ExpectAddressBeAvailable is the same as the package level function, dialing
+// through the client.
+func (c Client) ExpectAddressBeAvailable(t *testing.T, timeoutConfig config.TimeoutConfig, address string) {
+	t.Helper()
 
 	tlog.Logf(t, "performing TCP connection probe on %s", address)
 	err := wait.PollUntilContextTimeout(t.Context(), timeoutConfig.DefaultPollInterval, timeoutConfig.MaxTimeToConsistency, true,
 		func(ctx context.Context) (bool, error) {
-			var dialer net.Dialer
-			conn, err := dialer.DialContext(ctx, "tcp", address)
+			conn, err := c.makeDialer(nil).DialContext(ctx, "tcp", address)
 			if err != nil {
 				tlog.Logf(t, "failed to establish TCP connection to %s; retrying: %v", address, err)
 				return false, nil
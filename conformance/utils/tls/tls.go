@@ -19,7 +19,9 @@ package tls
 import (
 	"context"
 	cryptotls "crypto/tls"
+	"crypto/x509"
 	"errors"
+	"fmt"
 	"io"
 	"net"
 	"strings"
@@ -28,6 +30,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"sigs.k8s.io/gateway-api/conformance/utils/config"
 	"sigs.k8s.io/gateway-api/conformance/utils/http"
@@ -135,6 +138,97 @@ func MakeTLSRequestAndExpectEventuallyConsistentFailureResponse(t *testing.T, r
 	}
 }
 
+// ExpectedCertificate describes the certificate a Gateway must serve to a TLS
+// client.
+type ExpectedCertificate struct {
+	// ServerName is the SNI sent by the client.
+	ServerName string
+	// ServerCertificate is the PEM encoded CA the served certificate must be
+	// issued by, the Gateway sending the intermediate CAs if any.
+	ServerCertificate []byte
+	// KeyAlgorithm, when set to x509.RSA or x509.ECDSA, restricts the client to
+	// TLS 1.2 and the cipher suites authenticated with keys of this algorithm,
+	// so that a Gateway holding several certificates must serve the one the
+	// client is capable of. The served certificate must have a key of this
+	// algorithm.
+	KeyAlgorithm x509.PublicKeyAlgorithm
+	// Certificate, if set, is the certificate that must be served.
+	Certificate *x509.Certificate
+}
+
+// ExpectEventuallyServedCertificate makes TLS handshakes with the Gateway until
+// the certificate it serves is consistently the expected one. It allows to
+// check which of the certificates of a listener is selected, and that a
+// certificate rotation was picked up.
+func ExpectEventuallyServedCertificate(t *testing.T, timeoutConfig config.TimeoutConfig, gwAddr string, expected ExpectedCertificate) {
+	t.Helper()
+
+	tlsConfig := &cryptotls.Config{
+		ServerName: expected.ServerName,
+		MinVersion: cryptotls.VersionTLS12,
+	}
+	if len(expected.ServerCertificate) > 0 {
+		roots := x509.NewCertPool()
+		require.True(t, roots.AppendCertsFromPEM(expected.ServerCertificate), "invalid server certificate")
+		tlsConfig.RootCAs = roots
+	}
+	switch expected.KeyAlgorithm {
+	case x509.UnknownPublicKeyAlgorithm:
+	case x509.RSA, x509.ECDSA:
+		tlsConfig.MaxVersion = cryptotls.VersionTLS12
+		tlsConfig.CipherSuites = cipherSuites(expected.KeyAlgorithm)
+	default:
+		t.Fatalf("unsupported key algorithm %s, only RSA and ECDSA can be selected by the client", expected.KeyAlgorithm)
+	}
+	dialer := &cryptotls.Dialer{Config: tlsConfig}
+
+	http.AwaitConvergence(t, timeoutConfig, func(elapsed time.Duration) bool {
+		ctx, cancel := context.WithTimeout(t.Context(), timeoutConfig.RequestTimeout)
+		defer cancel()
+
+		conn, err := dialer.DialContext(ctx, "tcp", gwAddr)
+		if err != nil {
+			tlog.Logf(t, "TLS handshake failed, not ready yet: %v (after %v)", err, elapsed)
+			return false
+		}
+		defer conn.Close()
+
+		if err := checkServedCertificate(conn.(*cryptotls.Conn).ConnectionState().PeerCertificates[0], expected); err != nil {
+			tlog.Logf(t, "Served certificate expectation failed, not ready yet: %v (after %v)", err, elapsed)
+			return false
+		}
+		return true
+	})
+	tlog.Logf(t, "TLS handshake passed")
+}
+
+func checkServedCertificate(served *x509.Certificate, expected ExpectedCertificate) error {
+	if expected.KeyAlgorithm != x509.UnknownPublicKeyAlgorithm && served.PublicKeyAlgorithm != expected.KeyAlgorithm {
+		return fmt.Errorf("expected a certificate with a %s key, got a %s key", expected.KeyAlgorithm, served.PublicKeyAlgorithm)
+	}
+	if expected.Certificate != nil && !served.Equal(expected.Certificate) {
+		return fmt.Errorf("expected certificate with serial number %s, got serial number %s", expected.Certificate.SerialNumber, served.SerialNumber)
+	}
+	return nil
+}
+
+// cipherSuites returns the TLS 1.2 cipher suites authenticated with keys of
+// the given algorithm.
+func cipherSuites(algorithm x509.PublicKeyAlgorithm) []uint16 {
+	if algorithm == x509.ECDSA {
+		return []uint16{
+			cryptotls.TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256,
+			cryptotls.TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384,
+			cryptotls.TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305_SHA256,
+		}
+	}
+	return []uint16{
+		cryptotls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256,
+		cryptotls.TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384,
+		cryptotls.TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305_SHA256,
+	}
+}
+
 // MakeTLSConnectionAndExpectEventuallyConnectionRejection initiates a TCP connection, then initiates TLS Handshake, and expects the TCP connection to be eventually rejected.
 // This is useful for testing scenarios where a Gateway accepts a TCP connection but then closes it due to not matching SNI or invalid routing configuration.
 func MakeTLSConnectionAndExpectEventuallyConnectionRejection(t *testing.T, timeoutConfig config.TimeoutConfig, gwAddr string, serverName string) {
@@ -18,6 +18,8 @@ package tls
 
 import (
 	"context"
+	cryptotls "crypto/tls"
+	"crypto/x509"
 	"io"
 	"net"
 	"sync/atomic"
@@ -25,8 +27,10 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"sigs.k8s.io/gateway-api/conformance/utils/config"
+	"sigs.k8s.io/gateway-api/conformance/utils/kubernetes"
 )
 
 type dialContextFunc func(ctx context.Context, network, address string) (net.Conn, error)
@@ -87,3 +91,49 @@ func TestWaitForTLSConnectionRejectionClosesUnexpectedConnection(t *testing.T) {
 
 	assert.True(t, conn.closed.Load())
 }
+
+func TestExpectEventuallyServedCertificate(t *testing.T) {
+	ca := kubernetes.MustGenerateCertificate(t, kubernetes.CertificateOptions{IsCA: true})
+	rsaCert := kubernetes.MustGenerateCertificate(t, kubernetes.CertificateOptions{Hosts: []string{"example.com"}, Issuer: ca})
+	intermediate := kubernetes.MustGenerateCertificate(t, kubernetes.CertificateOptions{IsCA: true, Issuer: ca})
+	ecdsaCert := kubernetes.MustGenerateCertificate(t, kubernetes.CertificateOptions{Hosts: []string{"example.com"}, KeyAlgorithm: kubernetes.ECDSAP256KeyAlgorithm, Issuer: intermediate})
+
+	var certificates []cryptotls.Certificate
+	for _, cert := range []*kubernetes.Certificate{rsaCert, ecdsaCert} {
+		certificate, err := cryptotls.X509KeyPair(cert.CertificatePEM, cert.KeyPEM)
+		require.NoError(t, err)
+		certificates = append(certificates, certificate)
+	}
+	l, err := cryptotls.Listen("tcp", "127.0.0.1:0", &cryptotls.Config{Certificates: certificates})
+	require.NoError(t, err)
+	defer l.Close()
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			_ = conn.(*cryptotls.Conn).Handshake()
+			conn.Close()
+		}
+	}()
+
+	timeoutConfig := config.DefaultTimeoutConfig()
+	timeoutConfig.RequiredConsecutiveSuccesses = 1
+	caPEM := ca.CertificatePEM
+
+	ExpectEventuallyServedCertificate(t, timeoutConfig, l.Addr().String(), ExpectedCertificate{
+		ServerName:        "example.com",
+		ServerCertificate: caPEM,
+		KeyAlgorithm:      x509.RSA,
+		Certificate:       rsaCert.Certificate,
+	})
+	// the ECDSA certificate is verified through the intermediate sent by the
+	// server
+	ExpectEventuallyServedCertificate(t, timeoutConfig, l.Addr().String(), ExpectedCertificate{
+		ServerName:        "example.com",
+		ServerCertificate: caPEM,
+		KeyAlgorithm:      x509.ECDSA,
+		Certificate:       ecdsaCert.Certificate,
+	})
+}
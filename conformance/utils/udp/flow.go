@@ -0,0 +1,159 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package udp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"sigs.k8s.io/gateway-api/conformance/utils/config"
+	"sigs.k8s.io/gateway-api/conformance/utils/tlog"
+)
+
+// flowSendAttempts is the number of times a datagram is sent on a flow before
+// giving up, UDP offering no delivery guarantee.
+const flowSendAttempts = 3
+
+// Flow is a UDP flow to the Gateway: every datagram is sent from the same local
+// address and port, so that all of them share the same 5-tuple.
+type Flow struct {
+	conn    net.Conn
+	timeout time.Duration
+	sent    int
+}
+
+// OpenFlow opens a UDP flow to gwAddr. Every datagram sent on the flow must be
+// answered within timeout.
+func OpenFlow(ctx context.Context, gwAddr string, timeout time.Duration) (*Flow, error) {
+	return Client{}.OpenFlow(ctx, gwAddr, timeout)
+}
+
+// OpenFlow is the same as the package level function, dialing through the
+// client.
+func (c Client) OpenFlow(ctx context.Context, gwAddr string, timeout time.Duration) (*Flow, error) {
+	dialContext := c.DialContext
+	if dialContext == nil {
+		var dialer net.Dialer
+		dialContext = dialer.DialContext
+	}
+	conn, err := dialContext(ctx, "udp", gwAddr)
+	if err != nil {
+		return nil, fmt.Errorf("dialing UDP %s: %w", gwAddr, err)
+	}
+	return &Flow{conn: conn, timeout: timeout}, nil
+}
+
+// LocalAddr returns the local address of the flow.
+func (f *Flow) LocalAddr() net.Addr {
+	return f.conn.LocalAddr()
+}
+
+// Send sends a datagram on the flow and returns the name of the Pod that
+// answered it. The datagram is sent again when no answer is received in time.
+func (f *Flow) Send() (string, error) {
+	var err error
+	for range flowSendAttempts {
+		var pod string
+		if pod, err = f.sendOnce(); err == nil {
+			return pod, nil
+		}
+	}
+	return "", err
+}
+
+func (f *Flow) sendOnce() (string, error) {
+	f.sent++
+	probe := fmt.Sprintf("gateway-api-conformance-udp-flow-%d", f.sent)
+	if err := f.conn.SetDeadline(time.Now().Add(f.timeout)); err != nil {
+		return "", fmt.Errorf("setting UDP deadline: %w", err)
+	}
+	if _, err := f.conn.Write([]byte(probe)); err != nil {
+		return "", fmt.Errorf("writing UDP probe: %w", err)
+	}
+
+	buf := make([]byte, 1024)
+	for {
+		n, err := f.conn.Read(buf)
+		if err != nil {
+			return "", fmt.Errorf("reading UDP echo response: %w", err)
+		}
+		var resp echoResponse
+		if err := json.Unmarshal(buf[:n], &resp); err != nil {
+			return "", fmt.Errorf("decoding UDP echo response %q: %w", string(buf[:n]), err)
+		}
+		// skip the late answers to the previous attempts
+		if resp.Request != probe {
+			continue
+		}
+		if resp.Pod == "" {
+			return "", fmt.Errorf("UDP echo response missing pod name: %q", string(buf[:n]))
+		}
+		return resp.Pod, nil
+	}
+}
+
+// Close closes the flow.
+func (f *Flow) Close() error {
+	return f.conn.Close()
+}
+
+// ExpectFlowAffinity opens flows UDP flows to gwAddr and sends datagrams
+// datagrams on each of them, checking that all the datagrams of a flow are
+// answered by the same Pod. It returns the name of the Pod that answered
+// every flow, so that tests can check how the flows were distributed.
+func ExpectFlowAffinity(t *testing.T, timeoutConfig config.TimeoutConfig, gwAddr string, flows, datagrams int) []string {
+	t.Helper()
+	return Client{}.ExpectFlowAffinity(t, timeoutConfig, gwAddr, flows, datagrams)
+}
+
+// ExpectFlowAffinity is the same as the package level function, dialing
+// through the client.
+func (c Client) ExpectFlowAffinity(t *testing.T, timeoutConfig config.TimeoutConfig, gwAddr string, flows, datagrams int) []string {
+	t.Helper()
+
+	pods := make([]string, 0, flows)
+	for range flows {
+		flow, err := c.OpenFlow(t.Context(), gwAddr, timeoutConfig.RequestTimeout)
+		if err != nil {
+			t.Fatalf("failed to open UDP flow to %s: %v", gwAddr, err)
+		}
+
+		var pod string
+		for i := range datagrams {
+			got, err := flow.Send()
+			if err != nil {
+				flow.Close()
+				t.Fatalf("UDP flow from %s to %s got no answer to datagram %d: %v", flow.LocalAddr(), gwAddr, i, err)
+			}
+			if pod == "" {
+				pod = got
+			} else if got != pod {
+				flow.Close()
+				t.Fatalf("UDP flow from %s to %s moved from pod %s to pod %s at datagram %d", flow.LocalAddr(), gwAddr, pod, got, i)
+			}
+		}
+		flow.Close()
+
+		tlog.Logf(t, "UDP flow from %s to %s was answered by pod %s", flow.LocalAddr(), gwAddr, pod)
+		pods = append(pods, pod)
+	}
+	return pods
+}
@@ -43,6 +43,14 @@ type ExpectedResponse struct {
 	Namespace string
 }
 
+// Client makes the UDP requests of the conformance tests. Its zero value dials
+// the Gateway addresses directly, as the package level functions do.
+type Client struct {
+	// DialContext, if set, is used to open the connections to the Gateway
+	// instead of dialing its address directly.
+	DialContext func(ctx context.Context, network, address string) (net.Conn, error)
+}
+
 // echoResponse mirrors the JSON envelope produced by the conformance UDP echo
 // backend. It is intentionally tolerant of unknown fields.
 type echoResponse struct {
@@ -56,7 +64,14 @@ type echoResponse struct {
 // gateway address succeeds, or the timeout is exceeded.
 func ExpectEchoResponse(t *testing.T, timeoutConfig config.TimeoutConfig, gwAddr string) {
 	t.Helper()
-	ExpectEchoResponseFromBackend(t, timeoutConfig, gwAddr, ExpectedResponse{})
+	Client{}.ExpectEchoResponse(t, timeoutConfig, gwAddr)
+}
+
+// ExpectEchoResponse is the same as the package level function, dialing
+// through the client.
+func (c Client) ExpectEchoResponse(t *testing.T, timeoutConfig config.TimeoutConfig, gwAddr string) {
+	t.Helper()
+	c.ExpectEchoResponseFromBackend(t, timeoutConfig, gwAddr, ExpectedResponse{})
 }
 
 // ExpectEchoResponseFromBackend polls until a UDP echo round-trip against the
@@ -64,6 +79,13 @@ func ExpectEchoResponse(t *testing.T, timeoutConfig config.TimeoutConfig, gwAddr
 // expected backend Service and/or Namespace, or the timeout is exceeded.
 func ExpectEchoResponseFromBackend(t *testing.T, timeoutConfig config.TimeoutConfig, gwAddr string, expected ExpectedResponse) {
 	t.Helper()
+	Client{}.ExpectEchoResponseFromBackend(t, timeoutConfig, gwAddr, expected)
+}
+
+// ExpectEchoResponseFromBackend is the same as the package level function,
+// dialing through the client.
+func (c Client) ExpectEchoResponseFromBackend(t *testing.T, timeoutConfig config.TimeoutConfig, gwAddr string, expected ExpectedResponse) {
+	t.Helper()
 
 	const probe = "gateway-api-conformance-udp-echo"
 	if expected.Service != "" || expected.Namespace != "" {
@@ -75,8 +97,12 @@ func ExpectEchoResponseFromBackend(t *testing.T, timeoutConfig config.TimeoutCon
 
 	err := wait.PollUntilContextTimeout(t.Context(), timeoutConfig.DefaultPollInterval, timeoutConfig.MaxTimeToConsistency, true,
 		func(ctx context.Context) (bool, error) {
-			var dialer net.Dialer
-			conn, err := dialer.DialContext(ctx, "udp", gwAddr)
+			dialContext := c.DialContext
+			if dialContext == nil {
+				var dialer net.Dialer
+				dialContext = dialer.DialContext
+			}
+			conn, err := dialContext(ctx, "udp", gwAddr)
 			if err != nil {
 				tlog.Logf(t, "failed to dial UDP %s: %v", gwAddr, err)
 				return false, nil
@@ -0,0 +1,146 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package websocket
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"golang.org/x/net/websocket"
+
+	"sigs.k8s.io/gateway-api/conformance/utils/config"
+	gwhttp "sigs.k8s.io/gateway-api/conformance/utils/http"
+	"sigs.k8s.io/gateway-api/conformance/utils/tlog"
+)
+
+// ExpectedResponse defines a WebSocket upgrade request and its expected
+// outcome.
+type ExpectedResponse struct {
+	Request Request
+
+	// StatusCode is the status the upgrade request must be answered with,
+	// http.StatusSwitchingProtocols when zero. Any other status means that
+	// the upgrade must be rejected.
+	StatusCode int
+
+	// Protocol is the subprotocol the server must select.
+	Protocol string
+
+	// Messages are sent once the connection is open, and must be echoed
+	// back. A string is sent as a text frame and a []byte as a binary frame.
+	Messages []any
+
+	// User Given TestCase name
+	TestCaseName string
+}
+
+// GetTestCaseName returns the name of the test case, generating one if not set.
+func (er *ExpectedResponse) GetTestCaseName(i int) string {
+	if er.TestCaseName != "" {
+		return er.TestCaseName
+	}
+	return fmt.Sprintf("%d upgrade request to %s with headers %v should receive a %d", i, er.Request.URL, er.Request.Headers, er.statusCode())
+}
+
+func (er *ExpectedResponse) statusCode() int {
+	if er.StatusCode == 0 {
+		return http.StatusSwitchingProtocols
+	}
+	return er.StatusCode
+}
+
+// ExpectEcho sends every message on ws and checks that it is echoed back. A
+// string is sent as a text frame and a []byte as a binary frame.
+func ExpectEcho(ws *websocket.Conn, messages ...any) error {
+	for _, message := range messages {
+		switch m := message.(type) {
+		case string:
+			if err := websocket.Message.Send(ws, m); err != nil {
+				return fmt.Errorf("failed to send text frame: %w", err)
+			}
+			var reply string
+			if err := websocket.Message.Receive(ws, &reply); err != nil {
+				return fmt.Errorf("failed to receive text frame: %w", err)
+			}
+			if reply != m {
+				return fmt.Errorf("unexpected reply - want: %s got: %s", m, reply)
+			}
+		case []byte:
+			if err := websocket.Message.Send(ws, m); err != nil {
+				return fmt.Errorf("failed to send binary frame: %w", err)
+			}
+			var reply []byte
+			if err := websocket.Message.Receive(ws, &reply); err != nil {
+				return fmt.Errorf("failed to receive binary frame: %w", err)
+			}
+			if !bytes.Equal(reply, m) {
+				return fmt.Errorf("unexpected reply - want: %#v got: %#v", m, reply)
+			}
+		default:
+			return fmt.Errorf("unsupported message type %T", message)
+		}
+	}
+	return nil
+}
+
+// MakeRequestAndExpectEventuallyConsistentResponse makes the upgrade request of
+// expected until its outcome is consistently the expected one.
+func MakeRequestAndExpectEventuallyConsistentResponse(t *testing.T, d Dialer, timeoutConfig config.TimeoutConfig, expected ExpectedResponse) {
+	t.Helper()
+	if expected.Request.Timeout == 0 {
+		expected.Request.Timeout = timeoutConfig.RequestTimeout
+	}
+	gwhttp.AwaitConvergence(t, timeoutConfig, func(elapsed time.Duration) bool {
+		if err := checkResponse(d, expected); err != nil {
+			tlog.Logf(t, "Response expectation failed for upgrade request to %s, not ready yet: %v (after %v)", expected.Request.URL, err, elapsed)
+			return false
+		}
+		return true
+	})
+	tlog.Logf(t, "Request passed")
+}
+
+func checkResponse(d Dialer, expected ExpectedResponse) error {
+	ws, resp, err := DialRequest(d, expected.Request)
+	if expected.statusCode() != http.StatusSwitchingProtocols {
+		if err == nil {
+			ws.Close()
+			return fmt.Errorf("expected the upgrade to be rejected with status %d", expected.statusCode())
+		}
+		if resp == nil {
+			return fmt.Errorf("expected the upgrade to be rejected with status %d, got: %w", expected.statusCode(), err)
+		}
+		if resp.StatusCode != expected.statusCode() {
+			return fmt.Errorf("expected the upgrade to be rejected with status %d, got %d", expected.statusCode(), resp.StatusCode)
+		}
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to dial: %w", err)
+	}
+	defer ws.Close()
+
+	// the selected subprotocol can only be checked when the response is
+	// known, which is the case with the DefaultDialer
+	if resp != nil && resp.Protocol != expected.Protocol {
+		return fmt.Errorf("expected subprotocol %q to be selected, got %q", expected.Protocol, resp.Protocol)
+	}
+	return ExpectEcho(ws, expected.Messages...)
+}
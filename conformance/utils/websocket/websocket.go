@@ -17,6 +17,18 @@ limitations under the License.
 package websocket
 
 import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
 	"golang.org/x/net/websocket"
 )
 
@@ -33,14 +45,216 @@ type Dialer interface {
 	Dial(url, protocol, origin string) (*websocket.Conn, error)
 }
 
+// RequestDialer is implemented by the Dialers supporting the upgrade requests
+// described by Request. It is separate from Dialer so that existing custom
+// implementations of Dialer keep working for plain upgrade requests.
+type RequestDialer interface {
+	// DialRequest opens a WebSocket connection as described by req. The
+	// response to the upgrade request is returned whenever it was received,
+	// including when the upgrade was rejected.
+	DialRequest(req Request) (*websocket.Conn, *Response, error)
+}
+
+// Request describes a WebSocket upgrade request.
+type Request struct {
+	// URL is the ws:// or wss:// URL to dial.
+	URL string
+	// Origin is presented as the Origin header.
+	Origin string
+	// Protocols are the subprotocols offered to the server, in order of
+	// preference.
+	Protocols []string
+	// Host, if set, overrides the Host header, the connection still being
+	// made to the host of URL.
+	Host string
+	// Headers are added to the upgrade request. Every value is sent on its
+	// own line.
+	Headers map[string][]string
+	// ServerName is the SNI sent over wss:// URLs, the host of the request
+	// being used when empty.
+	ServerName string
+	// ServerCertificate is the PEM encoded certificate, or CA, trusted for
+	// wss:// URLs. The system roots are used when empty.
+	ServerCertificate []byte
+	// Timeout bounds opening the connection, including the upgrade. There is
+	// no timeout when zero.
+	Timeout time.Duration
+}
+
+// Response is the response to a WebSocket upgrade request.
+type Response struct {
+	StatusCode int
+	Headers    http.Header
+	// Protocol is the subprotocol selected by the server, if any.
+	Protocol string
+}
+
 // DefaultDialer is the default implementation of Dialer. It is used when a
 // custom implementation is not specified, preserving the suite's historical
 // behavior of dialing the Gateway address directly via websocket.Dial.
-type DefaultDialer struct{}
+type DefaultDialer struct {
+	// DialContext, if set, is used to open the connections to the Gateway
+	// instead of dialing its address directly.
+	DialContext func(ctx context.Context, network, address string) (net.Conn, error)
+}
 
-var _ Dialer = (*DefaultDialer)(nil)
+var (
+	_ Dialer        = (*DefaultDialer)(nil)
+	_ RequestDialer = (*DefaultDialer)(nil)
+)
 
-// Dial dials the WebSocket endpoint directly using golang.org/x/net/websocket.
+// Dial dials the WebSocket endpoint using golang.org/x/net/websocket, through
+// DialContext when set.
 func (d *DefaultDialer) Dial(url, protocol, origin string) (*websocket.Conn, error) {
-	return websocket.Dial(url, protocol, origin)
+	req := Request{URL: url, Origin: origin}
+	if protocol != "" {
+		req.Protocols = []string{protocol}
+	}
+	ws, _, err := d.DialRequest(req)
+	return ws, err
+}
+
+// DialRequest dials the WebSocket endpoint described by req using
+// golang.org/x/net/websocket, through DialContext when set.
+func (d *DefaultDialer) DialRequest(req Request) (*websocket.Conn, *Response, error) {
+	config, err := websocket.NewConfig(req.URL, req.Origin)
+	if err != nil {
+		return nil, nil, err
+	}
+	config.Protocol = req.Protocols
+	for name, values := range req.Headers {
+		for _, value := range values {
+			config.Header.Add(name, value)
+		}
+	}
+
+	host := config.Location.Hostname()
+	port := config.Location.Port()
+	if port == "" {
+		port = "80"
+		if config.Location.Scheme == "wss" {
+			port = "443"
+		}
+	}
+	address := net.JoinHostPort(host, port)
+	if req.Host != "" {
+		// the Host header is taken from the location
+		config.Location.Host = req.Host
+	}
+
+	ctx := context.Background()
+	if req.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, req.Timeout)
+		defer cancel()
+	}
+
+	dialContext := d.DialContext
+	if dialContext == nil {
+		var dialer net.Dialer
+		dialContext = dialer.DialContext
+	}
+	conn, err := dialContext(ctx, "tcp", address)
+	if err != nil {
+		return nil, nil, err
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		if err = conn.SetDeadline(deadline); err != nil {
+			conn.Close()
+			return nil, nil, err
+		}
+	}
+
+	if config.Location.Scheme == "wss" {
+		tlsConfig := &tls.Config{ServerName: req.ServerName}
+		if tlsConfig.ServerName == "" {
+			tlsConfig.ServerName = config.Location.Hostname()
+		}
+		if len(req.ServerCertificate) > 0 {
+			tlsConfig.RootCAs = x509.NewCertPool()
+			if !tlsConfig.RootCAs.AppendCertsFromPEM(req.ServerCertificate) {
+				conn.Close()
+				return nil, nil, errors.New("unexpected error adding trusted CA")
+			}
+		}
+		tlsConn := tls.Client(conn, tlsConfig)
+		if err = tlsConn.HandshakeContext(ctx); err != nil {
+			conn.Close()
+			return nil, nil, err
+		}
+		conn = tlsConn
+	}
+
+	recorder := &handshakeRecorder{Conn: conn}
+	ws, err := websocket.NewClient(config, recorder)
+	resp := recorder.response()
+	if err != nil {
+		conn.Close()
+		return nil, resp, err
+	}
+	if err := conn.SetDeadline(time.Time{}); err != nil {
+		ws.Close()
+		return nil, resp, err
+	}
+	return ws, resp, nil
+}
+
+// handshakeRecorder records what is read from a connection until the end of
+// the headers of the upgrade response, as golang.org/x/net/websocket doesn't
+// expose them.
+type handshakeRecorder struct {
+	net.Conn
+
+	mu       sync.Mutex
+	recorded bytes.Buffer
+	done     bool
+}
+
+func (r *handshakeRecorder) Read(b []byte) (int, error) {
+	n, err := r.Conn.Read(b)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if !r.done {
+		r.recorded.Write(b[:n])
+		r.done = bytes.Contains(r.recorded.Bytes(), []byte("\r\n\r\n"))
+	}
+	return n, err
+}
+
+// response returns the upgrade response recorded, or nil if it was not fully
+// received.
+func (r *handshakeRecorder) response() *Response {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if !r.done {
+		return nil
+	}
+	httpResp, err := http.ReadResponse(bufio.NewReader(bytes.NewReader(r.recorded.Bytes())), nil)
+	if err != nil {
+		return nil
+	}
+	httpResp.Body.Close()
+	return &Response{
+		StatusCode: httpResp.StatusCode,
+		Headers:    httpResp.Header,
+		Protocol:   httpResp.Header.Get("Sec-WebSocket-Protocol"),
+	}
+}
+
+// DialRequest opens a WebSocket connection as described by req with d, which
+// must implement RequestDialer unless req only sets the fields supported by
+// Dial.
+func DialRequest(d Dialer, req Request) (*websocket.Conn, *Response, error) {
+	if rd, ok := d.(RequestDialer); ok {
+		return rd.DialRequest(req)
+	}
+	if len(req.Protocols) > 1 || req.Host != "" || len(req.Headers) > 0 || req.ServerName != "" || len(req.ServerCertificate) > 0 {
+		return nil, nil, fmt.Errorf("WebSocket dialer %T does not support headers, Host overrides, TLS settings or several subprotocols", d)
+	}
+	protocol := ""
+	if len(req.Protocols) > 0 {
+		protocol = req.Protocols[0]
+	}
+	ws, err := d.Dial(req.URL, protocol, req.Origin)
+	return ws, nil, err
 }
@@ -17,9 +17,14 @@ limitations under the License.
 package websocket
 
 import (
+	"context"
+	"encoding/pem"
+	"net"
+	"net/http"
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	"golang.org/x/net/websocket"
 )
@@ -60,3 +65,172 @@ func TestDefaultDialerDial(t *testing.T) {
 		t.Fatalf("unexpected echo: want %q, got %q", want, got)
 	}
 }
+
+// TestDefaultDialerDialContext verifies that DefaultDialer opens its
+// connections through DialContext when set, so that the Gateway can be reached
+// through another address than the one in the URL.
+func TestDefaultDialerDialContext(t *testing.T) {
+	server := httptest.NewServer(websocket.Handler(func(ws *websocket.Conn) {
+		var msg string
+		if err := websocket.Message.Receive(ws, &msg); err != nil {
+			return
+		}
+		_ = websocket.Message.Send(ws, msg)
+	}))
+	defer server.Close()
+
+	var dialed string
+	dialer := &DefaultDialer{
+		DialContext: func(ctx context.Context, network, address string) (net.Conn, error) {
+			dialed = address
+			var d net.Dialer
+			return d.DialContext(ctx, network, server.Listener.Addr().String())
+		},
+	}
+
+	conn, err := dialer.Dial("ws://gateway.example.com/", "", "http://example.com/")
+	if err != nil {
+		t.Fatalf("DefaultDialer.Dial returned error: %v", err)
+	}
+	defer conn.Close()
+
+	if dialed != "gateway.example.com:80" {
+		t.Fatalf("unexpected dialed address: want %q, got %q", "gateway.example.com:80", dialed)
+	}
+
+	const want = "websocket round-trip"
+	if err := websocket.Message.Send(conn, want); err != nil {
+		t.Fatalf("failed to send message: %v", err)
+	}
+
+	var got string
+	if err := websocket.Message.Receive(conn, &got); err != nil {
+		t.Fatalf("failed to receive message: %v", err)
+	}
+
+	if got != want {
+		t.Fatalf("unexpected echo: want %q, got %q", want, got)
+	}
+}
+
+// echoServer answers upgrade requests carrying the Version: one header, and
+// echoes every frame back, the first offered subprotocol being selected.
+func echoServer() http.Handler {
+	ws := websocket.Server{
+		Handshake: func(config *websocket.Config, r *http.Request) error {
+			if len(config.Protocol) > 0 {
+				config.Protocol = config.Protocol[:1]
+			}
+			return nil
+		},
+		Handler: func(ws *websocket.Conn) {
+			for {
+				var frame []byte
+				if err := websocket.Message.Receive(ws, &frame); err != nil {
+					return
+				}
+				if ws.PayloadType == websocket.BinaryFrame {
+					_ = websocket.Message.Send(ws, frame)
+				} else {
+					_ = websocket.Message.Send(ws, string(frame))
+				}
+			}
+		},
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Version") != "one" || r.Host != "websocket.example.com" {
+			http.NotFound(w, r)
+			return
+		}
+		ws.ServeHTTP(w, r)
+	})
+}
+
+func TestCheckResponse(t *testing.T) {
+	server := httptest.NewServer(echoServer())
+	defer server.Close()
+	tlsServer := httptest.NewTLSServer(echoServer())
+	defer tlsServer.Close()
+	serverCertificate := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: tlsServer.Certificate().Raw})
+
+	url := strings.Replace(server.URL, "http://", "ws://", 1) + "/ws"
+	tlsURL := strings.Replace(tlsServer.URL, "https://", "wss://", 1) + "/ws"
+	messages := []any{"text frame", []byte{1, 2, 3}}
+
+	testCases := []struct {
+		name     string
+		expected ExpectedResponse
+		wantErr  bool
+	}{{
+		name: "headers and host are sent",
+		expected: ExpectedResponse{
+			Request:  Request{URL: url, Origin: "http://example.com/", Host: "websocket.example.com", Headers: map[string][]string{"Version": {"one"}}},
+			Messages: messages,
+		},
+	}, {
+		name: "subprotocol is negotiated",
+		expected: ExpectedResponse{
+			Request:  Request{URL: url, Origin: "http://example.com/", Host: "websocket.example.com", Protocols: []string{"echo", "chat"}, Headers: map[string][]string{"Version": {"one"}}},
+			Protocol: "echo",
+			Messages: messages,
+		},
+	}, {
+		name: "unexpected subprotocol",
+		expected: ExpectedResponse{
+			Request:  Request{URL: url, Origin: "http://example.com/", Host: "websocket.example.com", Protocols: []string{"chat"}, Headers: map[string][]string{"Version": {"one"}}},
+			Protocol: "echo",
+		},
+		wantErr: true,
+	}, {
+		name: "rejected upgrade",
+		expected: ExpectedResponse{
+			Request:    Request{URL: url, Origin: "http://example.com/", Host: "websocket.example.com"},
+			StatusCode: http.StatusNotFound,
+		},
+	}, {
+		name: "upgrade unexpectedly accepted",
+		expected: ExpectedResponse{
+			Request:    Request{URL: url, Origin: "http://example.com/", Host: "websocket.example.com", Headers: map[string][]string{"Version": {"one"}}},
+			StatusCode: http.StatusNotFound,
+		},
+		wantErr: true,
+	}, {
+		name: "upgrade over TLS",
+		expected: ExpectedResponse{
+			Request: Request{
+				URL:               tlsURL,
+				Origin:            "http://example.com/",
+				Host:              "websocket.example.com",
+				ServerName:        "example.com",
+				ServerCertificate: serverCertificate,
+				Headers:           map[string][]string{"Version": {"one"}},
+			},
+			Messages: messages,
+		},
+	}, {
+		name: "untrusted server certificate",
+		expected: ExpectedResponse{
+			Request: Request{
+				URL:        tlsURL,
+				Origin:     "http://example.com/",
+				Host:       "websocket.example.com",
+				ServerName: "example.com",
+				Headers:    map[string][]string{"Version": {"one"}},
+			},
+		},
+		wantErr: true,
+	}}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.expected.Request.Timeout = 5 * time.Second
+			err := checkResponse(&DefaultDialer{}, tc.expected)
+			if tc.wantErr && err == nil {
+				t.Fatal("expected an error")
+			}
+			if !tc.wantErr && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
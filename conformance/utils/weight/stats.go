@@ -0,0 +1,89 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package weight
+
+import "math"
+
+// chiSquaredSurvival returns the probability of a chi-squared distributed
+// variable with df degrees of freedom to be greater than x.
+func chiSquaredSurvival(x float64, df int) float64 {
+	return regularizedGammaQ(float64(df)/2, x/2)
+}
+
+const (
+	gammaMaxIterations = 1000
+	gammaEpsilon       = 1e-15
+	gammaTiny          = 1e-300
+)
+
+// regularizedGammaQ returns the regularized upper incomplete gamma function
+// Q(a, x), computed from its series expansion when x < a+1 and from its
+// continued fraction otherwise, both converging quickly in their range.
+func regularizedGammaQ(a, x float64) float64 {
+	if x <= 0 {
+		return 1
+	}
+	if x < a+1 {
+		return 1 - gammaSeries(a, x)
+	}
+	return gammaContinuedFraction(a, x)
+}
+
+// gammaSeries returns the regularized lower incomplete gamma function P(a, x)
+// from its series expansion.
+func gammaSeries(a, x float64) float64 {
+	lgamma, _ := math.Lgamma(a)
+	sum := 1 / a
+	term := sum
+	for n := 1; n <= gammaMaxIterations; n++ {
+		term *= x / (a + float64(n))
+		sum += term
+		if math.Abs(term) < math.Abs(sum)*gammaEpsilon {
+			break
+		}
+	}
+	return sum * math.Exp(-x+a*math.Log(x)-lgamma)
+}
+
+// gammaContinuedFraction returns Q(a, x) from its continued fraction,
+// evaluated with the modified Lentz's method.
+func gammaContinuedFraction(a, x float64) float64 {
+	lgamma, _ := math.Lgamma(a)
+	b := x + 1 - a
+	c := 1 / gammaTiny
+	d := 1 / b
+	h := d
+	for n := 1; n <= gammaMaxIterations; n++ {
+		an := -float64(n) * (float64(n) - a)
+		b += 2
+		d = an*d + b
+		if math.Abs(d) < gammaTiny {
+			d = gammaTiny
+		}
+		c = b + an/c
+		if math.Abs(c) < gammaTiny {
+			c = gammaTiny
+		}
+		d = 1 / d
+		delta := d * c
+		h *= delta
+		if math.Abs(delta-1) < gammaEpsilon {
+			break
+		}
+	}
+	return math.Exp(-x+a*math.Log(x)-lgamma) * h
+}
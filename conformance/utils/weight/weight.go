@@ -21,7 +21,6 @@ import (
 	"crypto/rand"
 	"errors"
 	"fmt"
-	"math"
 	"math/big"
 	"slices"
 	"strconv"
@@ -29,6 +28,8 @@ import (
 	"sync"
 
 	"golang.org/x/sync/errgroup"
+
+	"sigs.k8s.io/gateway-api/conformance/utils/config"
 )
 
 const (
@@ -59,22 +60,28 @@ type BatchRequestSender interface {
 	SendBatchRequest(count int) ([]string, error)
 }
 
-// TestWeightedDistribution tests that requests are distributed according to expected weights
+// TestWeightedDistribution tests that requests are distributed according to
+// expected weights, with the default WeightConfig.
 func TestWeightedDistribution(sender RequestSender, expectedWeights map[string]float64) error {
-	const (
-		concurrentRequests  = 10
-		tolerancePercentage = 0.05
-		totalRequests       = 500
-	)
+	_, err := TestWeightedDistributionWithConfig(sender, expectedWeights, config.DefaultWeightConfig())
+	return err
+}
+
+// TestWeightedDistributionWithConfig tests that requests are distributed
+// according to expected weights, sending them as configured by weightConfig.
+// It returns the observed distribution, which is also part of the error when
+// the distribution does not follow the weights.
+func TestWeightedDistributionWithConfig(sender RequestSender, expectedWeights map[string]float64, weightConfig config.WeightConfig) (Distribution, error) {
+	config.SetupWeightConfig(&weightConfig)
 
 	var (
 		g         errgroup.Group
 		seenMutex sync.Mutex
-		seen      = make(map[string]float64, len(expectedWeights))
+		seen      = make(map[string]int, len(expectedWeights))
 	)
 
-	g.SetLimit(concurrentRequests)
-	for range totalRequests {
+	g.SetLimit(weightConfig.ConcurrentRequests)
+	for range weightConfig.TotalRequests {
 		g.Go(func() error {
 			podName, err := sender.SendRequest()
 			if err != nil {
@@ -97,115 +104,152 @@ func TestWeightedDistribution(sender RequestSender, expectedWeights map[string]f
 	}
 
 	if err := g.Wait(); err != nil {
-		return fmt.Errorf("error while sending requests: %w", err)
+		return Distribution{}, fmt.Errorf("error while sending requests: %w", err)
 	}
 
-	// Count how many backends should receive traffic (weight > 0)
-	expectedActiveBackends := 0
-	for _, weight := range expectedWeights {
-		if weight > 0.0 {
-			expectedActiveBackends++
-		}
-	}
-
-	var errs []error
-	if len(seen) != expectedActiveBackends {
-		errs = append(errs, fmt.Errorf("expected %d backends to receive traffic, but got %d", expectedActiveBackends, len(seen)))
-	}
-
-	for wantBackend, wantPercent := range expectedWeights {
-		gotCount, ok := seen[wantBackend]
-
-		if !ok && wantPercent != 0.0 {
-			errs = append(errs, fmt.Errorf("expect traffic to hit backend %q - but none was received", wantBackend))
-			continue
-		}
-
-		gotPercent := gotCount / float64(totalRequests)
-
-		if math.Abs(gotPercent-wantPercent) > tolerancePercentage {
-			errs = append(errs, fmt.Errorf("backend %q weighted traffic of %v not within tolerance %v (+/-%f)",
-				wantBackend,
-				gotPercent,
-				wantPercent,
-				tolerancePercentage,
-			))
-		}
-	}
-
-	slices.SortFunc(errs, func(a, b error) int {
-		return cmp.Compare(a.Error(), b.Error())
-	})
-	return errors.Join(errs...)
+	return verifyDistribution(seen, expectedWeights, weightConfig.FalseFailureRate)
 }
 
 // TestWeightedDistributionBatch tests that requests are distributed according to expected weights
-// using batch request execution for improved performance
+// using batch request execution for improved performance, with the default WeightConfig.
 func TestWeightedDistributionBatch(sender BatchRequestSender, expectedWeights map[string]float64) error {
-	const (
-		tolerancePercentage = 0.05
-		totalRequests       = 500
-	)
+	_, err := TestWeightedDistributionBatchWithConfig(sender, expectedWeights, config.DefaultWeightConfig())
+	return err
+}
+
+// TestWeightedDistributionBatchWithConfig is the same as
+// TestWeightedDistributionWithConfig, using batch request execution.
+func TestWeightedDistributionBatchWithConfig(sender BatchRequestSender, expectedWeights map[string]float64, weightConfig config.WeightConfig) (Distribution, error) {
+	config.SetupWeightConfig(&weightConfig)
 
 	// Execute all requests in a single batch
-	podNames, err := sender.SendBatchRequest(totalRequests)
+	podNames, err := sender.SendBatchRequest(weightConfig.TotalRequests)
 	if err != nil {
-		return fmt.Errorf("error while sending batch request: %w", err)
+		return Distribution{}, fmt.Errorf("error while sending batch request: %w", err)
 	}
 
-	if len(podNames) != totalRequests {
-		return fmt.Errorf("expected %d responses but got %d", totalRequests, len(podNames))
+	if len(podNames) != weightConfig.TotalRequests {
+		return Distribution{}, fmt.Errorf("expected %d responses but got %d", weightConfig.TotalRequests, len(podNames))
 	}
 
 	// Count the distribution
-	seen := make(map[string]float64, len(expectedWeights))
+	seen := make(map[string]int, len(expectedWeights))
 	for _, podName := range podNames {
 		backendName := extractBackendName(podName)
 
 		if _, exists := expectedWeights[backendName]; exists {
 			seen[backendName]++
 		} else {
-			return fmt.Errorf("request was handled by an unexpected pod %q (extracted backend: %q)", podName, backendName)
+			return Distribution{}, fmt.Errorf("request was handled by an unexpected pod %q (extracted backend: %q)", podName, backendName)
 		}
 	}
 
-	// Count how many backends should receive traffic (weight > 0)
-	expectedActiveBackends := 0
-	for _, weight := range expectedWeights {
-		if weight > 0.0 {
-			expectedActiveBackends++
-		}
+	return verifyDistribution(seen, expectedWeights, weightConfig.FalseFailureRate)
+}
+
+// Distribution is an observed distribution of requests across backends, along
+// with the outcome of the chi-squared goodness of fit test of the backends
+// with a non-zero weight.
+type Distribution struct {
+	// Expected is the expected share of the requests of every backend.
+	Expected map[string]float64
+	// Counts is the number of requests handled by every backend.
+	Counts map[string]int
+	// Total is the number of requests.
+	Total int
+	// ChiSquared is the chi-squared statistic of the distribution.
+	ChiSquared float64
+	// DegreesOfFreedom is the number of degrees of freedom of ChiSquared.
+	DegreesOfFreedom int
+	// PValue is the probability of a distribution following the weights to
+	// be at least as far from them as the observed one.
+	PValue float64
+}
+
+// String describes the distribution, one backend after the other.
+func (d Distribution) String() string {
+	backends := make([]string, 0, len(d.Expected))
+	for backend := range d.Expected {
+		backends = append(backends, backend)
 	}
+	slices.Sort(backends)
 
-	var errs []error
-	if len(seen) != expectedActiveBackends {
-		errs = append(errs, fmt.Errorf("expected %d backends to receive traffic, but got %d", expectedActiveBackends, len(seen)))
+	var b strings.Builder
+	for i, backend := range backends {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		var got float64
+		if d.Total > 0 {
+			got = float64(d.Counts[backend]) / float64(d.Total)
+		}
+		fmt.Fprintf(&b, "%s: %d/%d (%.1f%%, want %.1f%%)", backend, d.Counts[backend], d.Total, 100*got, 100*d.Expected[backend])
 	}
+	fmt.Fprintf(&b, "; chi-squared=%.3f with %d degree(s) of freedom, p-value=%.4g", d.ChiSquared, d.DegreesOfFreedom, d.PValue)
+	return b.String()
+}
 
-	for wantBackend, wantPercent := range expectedWeights {
-		gotCount, ok := seen[wantBackend]
+// verifyDistribution checks that the requests handled by every backend, seen,
+// follow expectedWeights. Backends with a zero weight must handle no request,
+// and the distribution across the other ones must not be rejected by a
+// chi-squared goodness of fit test at the falseFailureRate significance level.
+// At least one backend must have a positive weight, as there is no
+// distribution to compare against otherwise.
+func verifyDistribution(seen map[string]int, expectedWeights map[string]float64, falseFailureRate float64) (Distribution, error) {
+	d := Distribution{
+		Expected: make(map[string]float64, len(expectedWeights)),
+		Counts:   seen,
+	}
+	var totalWeight float64
+	for _, weight := range expectedWeights {
+		totalWeight += weight
+	}
+	for _, count := range seen {
+		d.Total += count
+	}
+	if totalWeight <= 0 {
+		return d, fmt.Errorf("expected weights %v must include at least one positive weight", expectedWeights)
+	}
+	for backend, weight := range expectedWeights {
+		d.Expected[backend] = weight / totalWeight
+	}
 
-		if !ok && wantPercent != 0.0 {
-			errs = append(errs, fmt.Errorf("expect traffic to hit backend %q - but none was received", wantBackend))
+	var errs []error
+	activeBackends := 0
+	for backend, want := range d.Expected {
+		got := seen[backend]
+		if want == 0.0 {
+			if got > 0 {
+				errs = append(errs, fmt.Errorf("backend %q has a weight of 0 but received %d requests", backend, got))
+			}
 			continue
 		}
 
-		gotPercent := gotCount / float64(totalRequests)
-
-		if math.Abs(gotPercent-wantPercent) > tolerancePercentage {
-			errs = append(errs, fmt.Errorf("backend %q weighted traffic of %v not within tolerance %v (+/-%f)",
-				wantBackend,
-				gotPercent,
-				wantPercent,
-				tolerancePercentage,
-			))
+		activeBackends++
+		if got == 0 {
+			errs = append(errs, fmt.Errorf("expect traffic to hit backend %q - but none was received", backend))
 		}
+		expected := want * float64(d.Total)
+		d.ChiSquared += (float64(got) - expected) * (float64(got) - expected) / expected
+	}
+
+	d.PValue = 1
+	if activeBackends > 1 {
+		d.DegreesOfFreedom = activeBackends - 1
+		d.PValue = chiSquaredSurvival(d.ChiSquared, d.DegreesOfFreedom)
+	}
+	if d.PValue < falseFailureRate {
+		errs = append(errs, fmt.Errorf("traffic distribution does not follow the weights: p-value %.4g below the false failure rate %g", d.PValue, falseFailureRate))
 	}
 
+	if len(errs) == 0 {
+		return d, nil
+	}
 	slices.SortFunc(errs, func(a, b error) int {
 		return cmp.Compare(a.Error(), b.Error())
 	})
-	return errors.Join(errs...)
+	errs = append(errs, fmt.Errorf("observed distribution: %s", d))
+	return d, errors.Join(errs...)
 }
 
 // Entropy utilities
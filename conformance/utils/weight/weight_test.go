@@ -0,0 +1,103 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package weight
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChiSquaredSurvival(t *testing.T) {
+	testCases := []struct {
+		x    float64
+		df   int
+		want float64
+	}{
+		{x: 0, df: 1, want: 1},
+		{x: 3.841459, df: 1, want: 0.05},
+		{x: 6.634897, df: 1, want: 0.01},
+		{x: 5.991465, df: 2, want: 0.05},
+		{x: 1, df: 2, want: math.Exp(-0.5)},
+		{x: 16.266236, df: 3, want: 0.001},
+		{x: 2, df: 10, want: 0.996340},
+	}
+
+	for _, tc := range testCases {
+		assert.InDelta(t, tc.want, chiSquaredSurvival(tc.x, tc.df), 1e-6, "x=%v df=%d", tc.x, tc.df)
+	}
+}
+
+func TestVerifyDistribution(t *testing.T) {
+	expectedWeights := map[string]float64{
+		"backend-v1": 0.7,
+		"backend-v2": 0.3,
+		"backend-v3": 0.0,
+	}
+
+	testCases := []struct {
+		name    string
+		seen    map[string]int
+		wantErr []string
+	}{{
+		name: "exact distribution",
+		seen: map[string]int{"backend-v1": 350, "backend-v2": 150},
+	}, {
+		name: "distribution within sampling noise",
+		seen: map[string]int{"backend-v1": 365, "backend-v2": 135},
+	}, {
+		name:    "skewed distribution",
+		seen:    map[string]int{"backend-v1": 250, "backend-v2": 250},
+		wantErr: []string{"does not follow the weights", "backend-v1: 250/500 (50.0%, want 70.0%)"},
+	}, {
+		name:    "zero-weighted backend receiving traffic",
+		seen:    map[string]int{"backend-v1": 349, "backend-v2": 150, "backend-v3": 1},
+		wantErr: []string{`backend "backend-v3" has a weight of 0 but received 1 requests`},
+	}, {
+		name:    "backend receiving no traffic",
+		seen:    map[string]int{"backend-v1": 500},
+		wantErr: []string{`expect traffic to hit backend "backend-v2" - but none was received`},
+	}}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			d, err := verifyDistribution(tc.seen, expectedWeights, 0.001)
+			if len(tc.wantErr) == 0 {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			for _, want := range tc.wantErr {
+				assert.Contains(t, err.Error(), want)
+			}
+			assert.Equal(t, 500, d.Total)
+		})
+	}
+}
+
+func TestVerifyDistributionZeroWeights(t *testing.T) {
+	expectedWeights := map[string]float64{
+		"backend-v1": 0.0,
+		"backend-v2": 0.0,
+	}
+
+	d, err := verifyDistribution(map[string]int{"backend-v1": 500}, expectedWeights, 0.001)
+	require.ErrorContains(t, err, "must include at least one positive weight")
+	assert.Equal(t, 500, d.Total)
+}
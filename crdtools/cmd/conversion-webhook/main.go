@@ -0,0 +1,65 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// The conversion-webhook command serves a CRD conversion webhook for the
+// Gateway API kinds served in several versions, such as TLSRoute v1alpha2,
+// v1alpha3 and v1, so that their storage version can change without deleting
+// and recreating objects.
+//
+// The webhook is served over TLS at /convert, with the tls.crt and tls.key
+// certificate of --cert-dir. The CRDs of the converted kinds must use the
+// Webhook conversion strategy, with a client config pointing to the
+// Service of the webhook and the CA bundle of its certificate.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager/signals"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+
+	"sigs.k8s.io/gateway-api/crdtools/conversion"
+)
+
+func main() {
+	host := flag.String("host", "", "The address the webhook listens on. Defaults to all addresses.")
+	port := flag.Int("port", 9443, "The port the webhook listens on.")
+	certDir := flag.String("cert-dir", "", "The directory of the tls.crt and tls.key certificate of the webhook. Defaults to <temp-dir>/k8s-webhook-server/serving-certs.")
+	klog.InitFlags(nil)
+	flag.Parse()
+	log.SetLogger(klog.NewKlogr())
+
+	if err := run(signals.SetupSignalHandler(), webhook.Options{Host: *host, Port: *port, CertDir: *certDir}); err != nil {
+		fmt.Fprintf(os.Stderr, "Failed: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// run serves the conversion webhook until ctx is done.
+func run(ctx context.Context, opts webhook.Options) error {
+	handler, err := conversion.NewWebhookHandler()
+	if err != nil {
+		return err
+	}
+	server := webhook.NewServer(opts)
+	server.Register(conversion.Path, handler)
+	return server.Start(ctx)
+}
@@ -0,0 +1,131 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// The migrate-storage-version command migrates the Gateway API objects stored
+// in older versions to the current storage version of their CRDs, e.g. after
+// upgrading the Gateway API bundle:
+//
+//	migrate-storage-version --kubeconfig ~/.kube/config --progress-file progress.json
+//
+// Objects are rewritten at --qps, and the status.storedVersions of every CRD
+// is set to its storage version once all its objects are rewritten, so that
+// the older versions can be removed from the CRDs. The progress is saved to
+// --progress-file after every page of objects, and an interrupted migration
+// is resumed from it. With --dry-run, objects and CRDs are only updated with
+// server-side dry run. The objects of CRDs of another bundle version than the
+// command's are left as is.
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	apiextensionsclientset "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
+	"k8s.io/client-go/util/flowcontrol"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client/config"
+	"sigs.k8s.io/controller-runtime/pkg/manager/signals"
+
+	"sigs.k8s.io/gateway-api/crdtools/migration"
+	"sigs.k8s.io/gateway-api/pkg/client/clientset/versioned"
+)
+
+func main() {
+	dryRun := flag.Bool("dry-run", false, "If true, objects and CRDs are only updated with server-side dry run.")
+	qps := flag.Float64("qps", 10, "The maximum number of objects rewritten per second.")
+	burst := flag.Int("burst", 10, "The maximum burst of objects rewritten.")
+	pageSize := flag.Int64("page-size", migration.DefaultPageSize, "The number of objects listed at once.")
+	progressFile := flag.String("progress-file", "", "If set, the file the progress is saved to, and resumed from.")
+	klog.InitFlags(nil)
+	flag.Parse()
+
+	if err := run(*dryRun, float32(*qps), *burst, *pageSize, *progressFile); err != nil {
+		fmt.Fprintf(os.Stderr, "Failed: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(dryRun bool, qps float32, burst int, pageSize int64, progressFile string) error {
+	cfg, err := config.GetConfig()
+	if err != nil {
+		return err
+	}
+	crdClient, err := apiextensionsclientset.NewForConfig(cfg)
+	if err != nil {
+		return err
+	}
+	gatewayClient, err := versioned.NewForConfig(cfg)
+	if err != nil {
+		return err
+	}
+
+	opts := migration.Options{
+		DryRun:      dryRun,
+		PageSize:    pageSize,
+		RateLimiter: flowcontrol.NewTokenBucketRateLimiter(qps, burst),
+	}
+	if progressFile != "" {
+		if opts.Progress, err = loadProgress(progressFile); err != nil {
+			return err
+		}
+		opts.Checkpoint = func(p *migration.Progress) error {
+			return saveProgress(progressFile, p)
+		}
+	}
+	return migration.Migrate(signals.SetupSignalHandler(), crdClient, gatewayClient, opts)
+}
+
+// loadProgress reads the progress saved to path, if any.
+func loadProgress(path string) (*migration.Progress, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	progress := &migration.Progress{}
+	if err := json.Unmarshal(data, progress); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", path, err)
+	}
+	return progress, nil
+}
+
+// saveProgress saves progress to path, replacing it atomically so that an
+// interrupted save doesn't lose the previous progress.
+func saveProgress(path string, progress *migration.Progress) error {
+	data, err := json.MarshalIndent(progress, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
@@ -0,0 +1,169 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// The validate command validates the Gateway API objects of YAML manifests
+// against the Gateway API CRDs, without a cluster, e.g. in pre-merge checks:
+//
+//	validate --channel experimental manifests/ gateway.yaml
+//
+// By default, the CRDs of the channel bundled with the command are used. The
+// CRDs of another bundle version, such as the install manifest of a release,
+// can be used with --crds. Errors are reported with their file and line, and
+// the command exits with status 1 if any object is invalid.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+
+	"sigs.k8s.io/gateway-api/config/crd"
+	"sigs.k8s.io/gateway-api/crdtools/validation"
+	"sigs.k8s.io/gateway-api/pkg/consts"
+)
+
+func main() {
+	channel := flag.String("channel", string(validation.StandardChannel), "The channel of the CRDs to validate against, standard or experimental.")
+	crds := flag.String("crds", "", "A file or directory of CRD manifests to validate against instead of the bundled CRDs, e.g. the install manifest of a release.")
+	bundleVersion := flag.String("bundle-version", "", "If set, the bundle version the CRDs must have, e.g. v1.4.0.")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [flags] <file or directory | -> ...\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	invalid, err := run(context.Background(), validation.Channel(*channel), *crds, *bundleVersion, flag.Args())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed: %v\n", err)
+		os.Exit(2)
+	}
+	if invalid {
+		os.Exit(1)
+	}
+}
+
+// run validates the manifests of paths, and returns whether any object is
+// invalid.
+func run(ctx context.Context, channel validation.Channel, crdsPath, bundleVersion string, paths []string) (bool, error) {
+	if channel != validation.StandardChannel && channel != validation.ExperimentalChannel {
+		return false, fmt.Errorf("unknown channel %q", channel)
+	}
+
+	var crds []*apiextensionsv1.CustomResourceDefinition
+	var err error
+	if crdsPath == "" {
+		crds, err = validation.LoadCRDs(crd.Manifests, string(channel))
+	} else {
+		crds, err = loadCRDs(crdsPath)
+	}
+	if err != nil {
+		return false, err
+	}
+	if err = checkCRDs(crds, channel, bundleVersion); err != nil {
+		return false, err
+	}
+	v, err := validation.NewValidatorForCRDs(crds...)
+	if err != nil {
+		return false, err
+	}
+
+	invalid := false
+	for _, path := range paths {
+		err := forEachManifest(path, func(name string, f *os.File) error {
+			errs, err := v.ValidateManifests(ctx, name, f)
+			for _, e := range errs {
+				fmt.Println(e)
+			}
+			invalid = invalid || len(errs) > 0
+			return err
+		})
+		if err != nil {
+			return false, err
+		}
+	}
+	return invalid, nil
+}
+
+// loadCRDs loads the CRDs of the manifest at path, or of the manifests of
+// the directory at path.
+func loadCRDs(path string) ([]*apiextensionsv1.CustomResourceDefinition, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if info.IsDir() {
+		return validation.LoadCRDs(os.DirFS(path), ".")
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return validation.DecodeCRDs(f)
+}
+
+// checkCRDs checks that crds are Gateway API CRDs of channel and, if set, of
+// bundleVersion.
+func checkCRDs(crds []*apiextensionsv1.CustomResourceDefinition, channel validation.Channel, bundleVersion string) error {
+	if len(crds) == 0 {
+		return fmt.Errorf("no CRDs found")
+	}
+	for _, c := range crds {
+		if ch := c.Annotations[consts.ChannelAnnotation]; ch != string(channel) {
+			return fmt.Errorf("CRD %s is of channel %q, not %q", c.Name, ch, channel)
+		}
+		if v := c.Annotations[consts.BundleVersionAnnotation]; bundleVersion != "" && v != bundleVersion {
+			return fmt.Errorf("CRD %s is of bundle version %q, not %q", c.Name, v, bundleVersion)
+		}
+	}
+	return nil
+}
+
+// forEachManifest calls f with the manifest at path, the YAML manifests of
+// the directory at path and its subdirectories, or the standard input if path
+// is "-".
+func forEachManifest(path string, f func(name string, file *os.File) error) error {
+	if path == "-" {
+		return f("<stdin>", os.Stdin)
+	}
+	return filepath.WalkDir(path, func(name string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		if name != path && filepath.Ext(name) != ".yaml" && filepath.Ext(name) != ".yml" {
+			return nil
+		}
+		file, err := os.Open(name)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		return f(name, file)
+	})
+}
@@ -0,0 +1,296 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package conversion implements a CRD conversion webhook for the Gateway API
+// kinds served in several versions, so that the storage version of their CRDs
+// can change without deleting and recreating objects.
+//
+// Objects are converted through their v1 hub version, with the generated
+// conversion functions. The hub and spoke converters are exported, for
+// controllers to convert objects with Convert or to serve them from their own
+// conversion webhook, in place of the conversion.Hub and
+// conversion.Convertible implementations the apis packages don't provide. The v1alpha2 TCPRoutes, TLSRoutes and UDPRoutes may
+// have more rules than v1 allows: the additional rules are dropped from the
+// v1 object, kept in its consts.ConversionDataAnnotation annotation, and
+// restored when converting it back to v1alpha2.
+package conversion
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+	"sync"
+
+	apiconversion "k8s.io/apimachinery/pkg/conversion"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	crconversion "sigs.k8s.io/controller-runtime/pkg/webhook/conversion"
+
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+	"sigs.k8s.io/gateway-api/apis/v1alpha3"
+	"sigs.k8s.io/gateway-api/apis/v1beta1"
+	"sigs.k8s.io/gateway-api/pkg/consts"
+)
+
+// Path is the path the webhook is served at, which is also the one envtest
+// configures in the CRDs it installs.
+const Path = "/convert"
+
+// maxV1Rules is the maximum number of rules of v1 TCPRoutes, TLSRoutes and
+// UDPRoutes.
+const maxV1Rules = 1
+
+// conversionData is the content of the consts.ConversionDataAnnotation
+// annotation.
+type conversionData struct {
+	// Rules are the rules of a route which v1 can't represent.
+	Rules json.RawMessage `json:"rules,omitempty"`
+}
+
+// NewScheme returns a scheme with all the versions of the Gateway API kinds,
+// and their conversion functions.
+func NewScheme() (*runtime.Scheme, error) {
+	scheme := runtime.NewScheme()
+	for _, install := range []func(*runtime.Scheme) error{v1.Install, v1alpha2.Install, v1alpha3.Install, v1beta1.Install} {
+		if err := install(scheme); err != nil {
+			return nil, err
+		}
+	}
+	return scheme, nil
+}
+
+// HubSpokeConverters returns the controller-runtime hub and spoke converters
+// of the Gateway API kinds served in several versions, by kind. The v1 types
+// are the hubs, and the other versions of a kind are its spokes.
+//
+// The apis packages don't implement conversion.Hub and conversion.Convertible,
+// so that they don't depend on controller-runtime. Controllers serving their
+// own conversion webhook can use these converters instead, by registering them
+// with RegisterConverters, and convert objects with Convert.
+func HubSpokeConverters() map[string]func(*runtime.Scheme) (crconversion.Converter, error) {
+	return map[string]func(*runtime.Scheme) (crconversion.Converter, error){
+		"BackendTLSPolicy": crconversion.NewHubSpokeConverter(&v1.BackendTLSPolicy{},
+			generatedSpoke(&v1alpha3.BackendTLSPolicy{}, v1alpha3.Convert_v1_BackendTLSPolicy_To_v1alpha3_BackendTLSPolicy, v1alpha3.Convert_v1alpha3_BackendTLSPolicy_To_v1_BackendTLSPolicy)),
+		"Gateway": crconversion.NewHubSpokeConverter(&v1.Gateway{},
+			generatedSpoke(&v1beta1.Gateway{}, v1beta1.Convert_v1_Gateway_To_v1beta1_Gateway, v1beta1.Convert_v1beta1_Gateway_To_v1_Gateway)),
+		"GatewayClass": crconversion.NewHubSpokeConverter(&v1.GatewayClass{},
+			generatedSpoke(&v1beta1.GatewayClass{}, v1beta1.Convert_v1_GatewayClass_To_v1beta1_GatewayClass, v1beta1.Convert_v1beta1_GatewayClass_To_v1_GatewayClass)),
+		"GRPCRoute": crconversion.NewHubSpokeConverter(&v1.GRPCRoute{},
+			generatedSpoke(&v1alpha2.GRPCRoute{}, v1alpha2.Convert_v1_GRPCRoute_To_v1alpha2_GRPCRoute, v1alpha2.Convert_v1alpha2_GRPCRoute_To_v1_GRPCRoute)),
+		"HTTPRoute": crconversion.NewHubSpokeConverter(&v1.HTTPRoute{},
+			generatedSpoke(&v1beta1.HTTPRoute{}, v1beta1.Convert_v1_HTTPRoute_To_v1beta1_HTTPRoute, v1beta1.Convert_v1beta1_HTTPRoute_To_v1_HTTPRoute)),
+		"ReferenceGrant": crconversion.NewHubSpokeConverter(&v1.ReferenceGrant{},
+			generatedSpoke(&v1alpha2.ReferenceGrant{}, v1alpha2.Convert_v1_ReferenceGrant_To_v1alpha2_ReferenceGrant, v1alpha2.Convert_v1alpha2_ReferenceGrant_To_v1_ReferenceGrant),
+			generatedSpoke(&v1beta1.ReferenceGrant{}, v1beta1.Convert_v1_ReferenceGrant_To_v1beta1_ReferenceGrant, v1beta1.Convert_v1beta1_ReferenceGrant_To_v1_ReferenceGrant)),
+		"TCPRoute": crconversion.NewHubSpokeConverter(&v1.TCPRoute{},
+			rulesSpoke(&v1alpha2.TCPRoute{}, v1alpha2.Convert_v1_TCPRoute_To_v1alpha2_TCPRoute, v1alpha2.Convert_v1alpha2_TCPRoute_To_v1_TCPRoute,
+				func(r *v1.TCPRoute) *[]v1.TCPRouteRule { return &r.Spec.Rules },
+				func(r *v1alpha2.TCPRoute) *[]v1alpha2.TCPRouteRule { return &r.Spec.Rules })),
+		"TLSRoute": crconversion.NewHubSpokeConverter(&v1.TLSRoute{},
+			rulesSpoke(&v1alpha2.TLSRoute{}, v1alpha2.Convert_v1_TLSRoute_To_v1alpha2_TLSRoute, v1alpha2.Convert_v1alpha2_TLSRoute_To_v1_TLSRoute,
+				func(r *v1.TLSRoute) *[]v1.TLSRouteRule { return &r.Spec.Rules },
+				func(r *v1alpha2.TLSRoute) *[]v1alpha2.TLSRouteRule { return &r.Spec.Rules }),
+			generatedSpoke(&v1alpha3.TLSRoute{}, v1alpha3.Convert_v1_TLSRoute_To_v1alpha3_TLSRoute, v1alpha3.Convert_v1alpha3_TLSRoute_To_v1_TLSRoute)),
+		"UDPRoute": crconversion.NewHubSpokeConverter(&v1.UDPRoute{},
+			rulesSpoke(&v1alpha2.UDPRoute{}, v1alpha2.Convert_v1_UDPRoute_To_v1alpha2_UDPRoute, v1alpha2.Convert_v1alpha2_UDPRoute_To_v1_UDPRoute,
+				func(r *v1.UDPRoute) *[]v1.UDPRouteRule { return &r.Spec.Rules },
+				func(r *v1alpha2.UDPRoute) *[]v1alpha2.UDPRouteRule { return &r.Spec.Rules })),
+	}
+}
+
+// RegisterConverters registers the HubSpokeConverters in registry. scheme must
+// include all the versions of the Gateway API kinds, as the one returned by
+// NewScheme does.
+func RegisterConverters(registry crconversion.Registry, scheme *runtime.Scheme) error {
+	for kind, newConverter := range HubSpokeConverters() {
+		converter, err := newConverter(scheme)
+		if err != nil {
+			return err
+		}
+		if err := registry.RegisterConverter(schema.GroupKind{Group: v1.GroupName, Kind: kind}, converter); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// NewRegistry returns a registry with the converters of the Gateway API kinds
+// served in several versions.
+func NewRegistry(scheme *runtime.Scheme) (crconversion.Registry, error) {
+	registry := crconversion.NewRegistry()
+	if err := RegisterConverters(registry, scheme); err != nil {
+		return nil, err
+	}
+	return registry, nil
+}
+
+// converters are a scheme and the registry of its converters.
+type converters struct {
+	scheme   *runtime.Scheme
+	registry crconversion.Registry
+}
+
+// defaultConverters returns the converters Convert converts objects with.
+var defaultConverters = sync.OnceValues(func() (converters, error) {
+	scheme, err := NewScheme()
+	if err != nil {
+		return converters{}, err
+	}
+	registry, err := NewRegistry(scheme)
+	if err != nil {
+		return converters{}, err
+	}
+	return converters{scheme: scheme, registry: registry}, nil
+})
+
+// Convert converts src into dst, another version of the same Gateway API kind,
+// through the v1 hub as the conversion webhook does. It replaces the ConvertTo
+// and ConvertFrom methods of conversion.Convertible. src is left unchanged.
+func Convert(ctx context.Context, src, dst runtime.Object) error {
+	c, err := defaultConverters()
+	if err != nil {
+		return err
+	}
+	srcGVK, err := apiutil.GVKForObject(src, c.scheme)
+	if err != nil {
+		return err
+	}
+	dstGVK, err := apiutil.GVKForObject(dst, c.scheme)
+	if err != nil {
+		return err
+	}
+	converter, ok := c.registry.GetConverter(srcGVK.GroupKind())
+	if !ok {
+		return fmt.Errorf("%s is not served in several versions", srcGVK.GroupKind())
+	}
+
+	// The converters need the type meta of both objects.
+	src = src.DeepCopyObject()
+	src.GetObjectKind().SetGroupVersionKind(srcGVK)
+	dst.GetObjectKind().SetGroupVersionKind(dstGVK)
+	return converter.ConvertObject(ctx, src, dst)
+}
+
+// NewWebhookHandler returns the handler of the conversion webhook, to be
+// served at Path.
+func NewWebhookHandler() (http.Handler, error) {
+	scheme, err := NewScheme()
+	if err != nil {
+		return nil, err
+	}
+	registry, err := NewRegistry(scheme)
+	if err != nil {
+		return nil, err
+	}
+	return crconversion.NewWebhookHandler(scheme, registry), nil
+}
+
+// convertFunc is the signature of the generated conversion functions.
+type convertFunc[In, Out any] func(in In, out Out, s apiconversion.Scope) error
+
+// generatedSpoke returns a spoke converter using the generated conversion functions of
+// the spoke.
+func generatedSpoke[H, S client.Object](spoke S, hubToSpoke convertFunc[H, S], spokeToHub convertFunc[S, H]) crconversion.SpokeConverter[H] {
+	return crconversion.NewSpokeConverter(spoke,
+		func(_ context.Context, hub H, spoke S) error { return hubToSpoke(hub, spoke, nil) },
+		func(_ context.Context, spoke S, hub H) error { return spokeToHub(spoke, hub, nil) },
+	)
+}
+
+// rulesSpoke returns a spoke converter using the generated conversion
+// functions of the spoke, which keeps the rules beyond maxV1Rules in an
+// annotation of the hub.
+func rulesSpoke[H, S client.Object, HR, SR any](spoke S, hubToSpoke convertFunc[H, S], spokeToHub convertFunc[S, H],
+	hubRules func(H) *[]HR, spokeRules func(S) *[]SR,
+) crconversion.SpokeConverter[H] {
+	return crconversion.NewSpokeConverter(spoke,
+		func(_ context.Context, hub H, spoke S) error {
+			if err := hubToSpoke(hub, spoke, nil); err != nil {
+				return err
+			}
+			return restoreRules(spoke, spokeRules(spoke))
+		},
+		func(_ context.Context, spoke S, hub H) error {
+			if err := spokeToHub(spoke, hub, nil); err != nil {
+				return err
+			}
+			return dropRules(hub, hubRules(hub))
+		},
+	)
+}
+
+// dropRules drops the rules beyond maxV1Rules, and keeps them in the
+// conversion data annotation of obj.
+func dropRules[R any](obj client.Object, rules *[]R) error {
+	// converted objects share their annotations with the source ones
+	annotations := maps.Clone(obj.GetAnnotations())
+	delete(annotations, consts.ConversionDataAnnotation)
+	if len(*rules) > maxV1Rules {
+		raw, err := json.Marshal((*rules)[maxV1Rules:])
+		if err != nil {
+			return err
+		}
+		data, err := json.Marshal(conversionData{Rules: raw})
+		if err != nil {
+			return err
+		}
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		annotations[consts.ConversionDataAnnotation] = string(data)
+		*rules = (*rules)[:maxV1Rules]
+	}
+	if len(annotations) == 0 {
+		annotations = nil
+	}
+	obj.SetAnnotations(annotations)
+	return nil
+}
+
+// restoreRules appends the rules kept in the conversion data annotation of
+// obj, if any, and removes the annotation.
+func restoreRules[R any](obj client.Object, rules *[]R) error {
+	value, ok := obj.GetAnnotations()[consts.ConversionDataAnnotation]
+	if !ok {
+		return nil
+	}
+	var data conversionData
+	if err := json.Unmarshal([]byte(value), &data); err != nil {
+		return fmt.Errorf("invalid %s annotation: %w", consts.ConversionDataAnnotation, err)
+	}
+	var dropped []R
+	if len(data.Rules) > 0 {
+		if err := json.Unmarshal(data.Rules, &dropped); err != nil {
+			return fmt.Errorf("invalid rules in %s annotation: %w", consts.ConversionDataAnnotation, err)
+		}
+	}
+
+	annotations := maps.Clone(obj.GetAnnotations())
+	delete(annotations, consts.ConversionDataAnnotation)
+	if len(annotations) == 0 {
+		annotations = nil
+	}
+	obj.SetAnnotations(annotations)
+	// the rules share their backing array with the source ones
+	*rules = append(slices.Clip(*rules), dropped...)
+	return nil
+}
@@ -0,0 +1,182 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conversion_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+	"sigs.k8s.io/gateway-api/apis/v1beta1"
+	"sigs.k8s.io/gateway-api/crdtools/conversion"
+	"sigs.k8s.io/gateway-api/pkg/consts"
+)
+
+// convert converts obj to apiVersion with the webhook handler.
+func convert(t *testing.T, handler http.Handler, obj runtime.Object, apiVersion string) *unstructured.Unstructured {
+	t.Helper()
+	raw, err := json.Marshal(obj)
+	require.NoError(t, err)
+	review := apiextensionsv1.ConversionReview{
+		TypeMeta: metav1.TypeMeta{APIVersion: "apiextensions.k8s.io/v1", Kind: "ConversionReview"},
+		Request: &apiextensionsv1.ConversionRequest{
+			UID:               types.UID("uid"),
+			DesiredAPIVersion: apiVersion,
+			Objects:           []runtime.RawExtension{{Raw: raw}},
+		},
+	}
+	body, err := json.Marshal(review)
+	require.NoError(t, err)
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, conversion.Path, bytes.NewReader(body)))
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	var res apiextensionsv1.ConversionReview
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+	require.NotNil(t, res.Response)
+	require.Equal(t, metav1.StatusSuccess, res.Response.Result.Status, res.Response.Result.Message)
+	require.Len(t, res.Response.ConvertedObjects, 1)
+	u := &unstructured.Unstructured{}
+	require.NoError(t, json.Unmarshal(res.Response.ConvertedObjects[0].Raw, &u.Object))
+	require.Equal(t, apiVersion, u.GetAPIVersion())
+	return u
+}
+
+func tcpRule(name, backend string) v1alpha2.TCPRouteRule {
+	return v1alpha2.TCPRouteRule{
+		Name:        new(v1.SectionName(name)),
+		BackendRefs: []v1.BackendRef{{BackendObjectReference: v1.BackendObjectReference{Name: v1.ObjectName(backend), Port: new(v1.PortNumber(8080))}}},
+	}
+}
+
+func TestWebhookHandler(t *testing.T) {
+	handler, err := conversion.NewWebhookHandler()
+	require.NoError(t, err)
+
+	t.Run("rules beyond the v1 maximum round trip through the annotation", func(t *testing.T) {
+		route := &v1alpha2.TCPRoute{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "gateway.networking.k8s.io/v1alpha2", Kind: "TCPRoute"},
+			ObjectMeta: metav1.ObjectMeta{Name: "route", Namespace: "default", Annotations: map[string]string{"foo": "bar"}},
+			Spec: v1alpha2.TCPRouteSpec{
+				Rules: []v1alpha2.TCPRouteRule{tcpRule("a", "svc-a"), tcpRule("b", "svc-b"), tcpRule("c", "svc-c")},
+			},
+		}
+
+		u := convert(t, handler, route, "gateway.networking.k8s.io/v1")
+		hub := &v1.TCPRoute{}
+		require.NoError(t, runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, hub))
+		require.Len(t, hub.Spec.Rules, 1)
+		assert.Equal(t, v1.SectionName("a"), *hub.Spec.Rules[0].Name)
+		assert.Equal(t, "bar", hub.Annotations["foo"])
+		assert.JSONEq(t,
+			`{"rules":[
+				{"name":"b","backendRefs":[{"name":"svc-b","port":8080}]},
+				{"name":"c","backendRefs":[{"name":"svc-c","port":8080}]}
+			]}`,
+			hub.Annotations[consts.ConversionDataAnnotation])
+
+		u = convert(t, handler, hub, "gateway.networking.k8s.io/v1alpha2")
+		res := &v1alpha2.TCPRoute{}
+		require.NoError(t, runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, res))
+		assert.Equal(t, route.Spec, res.Spec)
+		assert.Equal(t, map[string]string{"foo": "bar"}, res.Annotations)
+	})
+
+	t.Run("routes within the v1 maximum have no annotation", func(t *testing.T) {
+		route := &v1alpha2.TCPRoute{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "gateway.networking.k8s.io/v1alpha2", Kind: "TCPRoute"},
+			ObjectMeta: metav1.ObjectMeta{Name: "route", Namespace: "default"},
+			Spec:       v1alpha2.TCPRouteSpec{Rules: []v1alpha2.TCPRouteRule{tcpRule("a", "svc-a")}},
+		}
+		u := convert(t, handler, route, "gateway.networking.k8s.io/v1")
+		assert.Empty(t, u.GetAnnotations())
+	})
+
+	t.Run("kinds without dropped fields are converted as is", func(t *testing.T) {
+		gateway := &v1beta1.Gateway{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "gateway.networking.k8s.io/v1beta1", Kind: "Gateway"},
+			ObjectMeta: metav1.ObjectMeta{Name: "gateway", Namespace: "default"},
+			Spec: v1beta1.GatewaySpec{
+				GatewayClassName: "class",
+				Listeners:        []v1beta1.Listener{{Name: "http", Port: 80, Protocol: v1.HTTPProtocolType}},
+			},
+		}
+		u := convert(t, handler, gateway, "gateway.networking.k8s.io/v1")
+		res := &v1.Gateway{}
+		require.NoError(t, runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, res))
+		assert.Equal(t, gateway.Spec, res.Spec)
+	})
+	t.Run("spoke versions are converted through the hub", func(t *testing.T) {
+		grant := &v1alpha2.ReferenceGrant{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "gateway.networking.k8s.io/v1alpha2", Kind: "ReferenceGrant"},
+			ObjectMeta: metav1.ObjectMeta{Name: "grant", Namespace: "default"},
+			Spec: v1beta1.ReferenceGrantSpec{
+				From: []v1beta1.ReferenceGrantFrom{{Group: v1.GroupName, Kind: "HTTPRoute", Namespace: "apps"}},
+				To:   []v1beta1.ReferenceGrantTo{{Kind: "Service"}},
+			},
+		}
+		u := convert(t, handler, grant, "gateway.networking.k8s.io/v1beta1")
+		res := &v1beta1.ReferenceGrant{}
+		require.NoError(t, runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, res))
+		assert.Equal(t, grant.Spec, res.Spec)
+	})
+}
+
+func TestConvert(t *testing.T) {
+	route := &v1alpha2.TCPRoute{
+		ObjectMeta: metav1.ObjectMeta{Name: "route", Namespace: "default"},
+		Spec: v1alpha2.TCPRouteSpec{
+			Rules: []v1alpha2.TCPRouteRule{tcpRule("a", "svc-a"), tcpRule("b", "svc-b")},
+		},
+	}
+
+	hub := &v1.TCPRoute{}
+	require.NoError(t, conversion.Convert(t.Context(), route, hub))
+	require.Len(t, hub.Spec.Rules, 1)
+	assert.Contains(t, hub.Annotations, consts.ConversionDataAnnotation)
+	assert.Empty(t, route.GetObjectKind().GroupVersionKind(), "src was modified")
+
+	res := &v1alpha2.TCPRoute{}
+	require.NoError(t, conversion.Convert(t.Context(), hub, res))
+	assert.Equal(t, route.Spec, res.Spec)
+
+	// Spokes are converted to each other through the hub.
+	grant := &v1alpha2.ReferenceGrant{
+		Spec: v1beta1.ReferenceGrantSpec{
+			From: []v1beta1.ReferenceGrantFrom{{Group: v1.GroupName, Kind: "HTTPRoute", Namespace: "apps"}},
+			To:   []v1beta1.ReferenceGrantTo{{Kind: "Service"}},
+		},
+	}
+	resGrant := &v1beta1.ReferenceGrant{}
+	require.NoError(t, conversion.Convert(t.Context(), grant, resGrant))
+	assert.Equal(t, grant.Spec, resGrant.Spec)
+
+	require.ErrorContains(t, conversion.Convert(t.Context(), &v1.ListenerSet{}, &v1.ListenerSet{}), "not served in several versions")
+}
@@ -0,0 +1,255 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package migration migrates the Gateway API objects stored in older versions
+// to the current storage version of their CRDs, so that the older versions can
+// be removed from the CRDs once the bundle is upgraded.
+//
+// Every object of a CRD listing more than its storage version in
+// status.storedVersions is rewritten, unchanged, with the generated clientset:
+// the API server stores it again in the storage version. Once all the objects
+// of a CRD are rewritten, its status.storedVersions is set to the storage
+// version only.
+//
+// Objects are rewritten with the types of the clientset, which drop the fields
+// they don't know, so the objects of CRDs of another bundle version than the
+// clientset's aren't migrated.
+package migration
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apiextensionsclientset "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/flowcontrol"
+	"k8s.io/klog/v2"
+
+	"sigs.k8s.io/gateway-api/pkg/client/capabilities"
+	"sigs.k8s.io/gateway-api/pkg/client/clientset/versioned"
+	"sigs.k8s.io/gateway-api/pkg/consts"
+)
+
+// DefaultPageSize is the number of objects listed at once when Options.PageSize
+// isn't set.
+const DefaultPageSize = 500
+
+// Options configure a migration.
+type Options struct {
+	// DryRun rewrites the objects and patches the CRDs with server-side dry
+	// run, so that nothing is persisted. Checkpoint isn't called.
+	DryRun bool
+
+	// PageSize is the number of objects listed at once. Defaults to
+	// DefaultPageSize.
+	PageSize int64
+
+	// RateLimiter, if set, limits the rate at which objects are rewritten.
+	RateLimiter flowcontrol.RateLimiter
+
+	// Progress is the progress of a previous migration to resume from. It is
+	// updated as objects are rewritten. If nil, the migration starts over.
+	Progress *Progress
+
+	// Checkpoint, if set, is called with the progress after every page of
+	// objects is rewritten and every CRD is migrated, e.g. to save it so that
+	// an interrupted migration can be resumed.
+	Checkpoint func(*Progress) error
+}
+
+// Progress is the progress of a migration.
+type Progress struct {
+	// CRDs is the progress of the CRDs, by CRD name.
+	CRDs map[string]*CRDProgress `json:"crds,omitempty"`
+}
+
+// CRDProgress is the progress of the migration of the objects of a CRD.
+type CRDProgress struct {
+	// StorageVersion is the storage version the objects are migrated to.
+	// The progress of the CRD is reset if its storage version changes.
+	StorageVersion string `json:"storageVersion"`
+
+	// Continue is the continue token of the next page of objects to rewrite.
+	Continue string `json:"continue,omitempty"`
+
+	// Rewritten is the number of objects rewritten so far.
+	Rewritten int `json:"rewritten"`
+
+	// Completed is whether every object is rewritten, and status.storedVersions
+	// of the CRD is set to the storage version.
+	Completed bool `json:"completed,omitempty"`
+}
+
+// Migrate migrates the objects of the installed Gateway API CRDs to their
+// storage version, and sets the stored versions of the CRDs once done.
+//
+// CRDs whose storage version the clientset doesn't support, and CRDs with
+// objects to migrate whose bundle version isn't consts.BundleVersion, are
+// skipped.
+func Migrate(ctx context.Context, crdClient apiextensionsclientset.Interface, gatewayClient versioned.Interface, opts Options) error {
+	if opts.PageSize == 0 {
+		opts.PageSize = DefaultPageSize
+	}
+	if opts.Progress == nil {
+		opts.Progress = &Progress{}
+	}
+	if opts.Progress.CRDs == nil {
+		opts.Progress.CRDs = map[string]*CRDProgress{}
+	}
+	m := &migrator{
+		crdClient: crdClient,
+		resources: resources(gatewayClient),
+		opts:      opts,
+	}
+
+	crds, err := crdClient.ApiextensionsV1().CustomResourceDefinitions().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("listing CRDs: %w", err)
+	}
+	for i := range crds.Items {
+		crd := &crds.Items[i]
+		if !capabilities.Groups.Has(crd.Spec.Group) {
+			continue
+		}
+		if err := m.migrate(ctx, crd); err != nil {
+			return fmt.Errorf("migrating %s: %w", crd.Name, err)
+		}
+	}
+	return nil
+}
+
+type migrator struct {
+	crdClient apiextensionsclientset.Interface
+	resources map[schema.GroupVersionResource]resource
+	opts      Options
+}
+
+// migrate rewrites the objects of crd, and sets its stored versions.
+func (m *migrator) migrate(ctx context.Context, crd *apiextensionsv1.CustomResourceDefinition) error {
+	logger := klog.FromContext(ctx).WithValues("crd", crd.Name)
+
+	storageVersion := storageVersion(crd)
+	if storageVersion == "" {
+		return fmt.Errorf("no storage version")
+	}
+	progress := m.opts.Progress.CRDs[crd.Name]
+	if progress == nil || progress.StorageVersion != storageVersion {
+		progress = &CRDProgress{StorageVersion: storageVersion}
+		m.opts.Progress.CRDs[crd.Name] = progress
+	}
+	if slices.Equal(crd.Status.StoredVersions, []string{storageVersion}) {
+		progress.Completed = true
+	}
+	if progress.Completed {
+		logger.V(2).Info("Objects are stored in the storage version", "version", storageVersion)
+		return nil
+	}
+
+	gvr := schema.GroupVersionResource{Group: crd.Spec.Group, Version: storageVersion, Resource: crd.Spec.Names.Plural}
+	res, ok := m.resources[gvr]
+	if !ok {
+		logger.Info("Skipping CRD with an unsupported storage version", "version", storageVersion)
+		return nil
+	}
+	if v := crd.Annotations[consts.BundleVersionAnnotation]; v != consts.BundleVersion {
+		logger.Info("Skipping CRD of another bundle version", "bundleVersion", v, "expected", consts.BundleVersion)
+		return nil
+	}
+
+	logger.Info("Migrating objects", "storedVersions", crd.Status.StoredVersions, "version", storageVersion)
+	for {
+		objs, next, err := res.list(ctx, metav1.ListOptions{Limit: m.opts.PageSize, Continue: progress.Continue})
+		if apierrors.IsResourceExpired(err) {
+			// Rewriting objects is idempotent, so an expired continue token
+			// only costs rewriting them again.
+			logger.Info("Continue token expired, listing objects again")
+			progress.Continue = ""
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("listing objects: %w", err)
+		}
+		for _, obj := range objs {
+			if m.opts.RateLimiter != nil {
+				if err := m.opts.RateLimiter.Wait(ctx); err != nil {
+					return err
+				}
+			}
+			if err := res.rewrite(ctx, obj, m.updateOptions()); err != nil {
+				return err
+			}
+			progress.Rewritten++
+		}
+		progress.Continue = next
+		if next == "" {
+			break
+		}
+		if err := m.checkpoint(); err != nil {
+			return err
+		}
+	}
+
+	patch, err := json.Marshal(map[string]any{
+		"status": map[string]any{"storedVersions": []string{storageVersion}},
+	})
+	if err != nil {
+		return err
+	}
+	patchOpts := metav1.PatchOptions{}
+	if m.opts.DryRun {
+		patchOpts.DryRun = []string{metav1.DryRunAll}
+	}
+	_, err = m.crdClient.ApiextensionsV1().CustomResourceDefinitions().Patch(ctx, crd.Name, types.MergePatchType, patch, patchOpts, "status")
+	if err != nil {
+		return fmt.Errorf("setting stored versions: %w", err)
+	}
+	progress.Completed = true
+	logger.Info("Migrated objects", "version", storageVersion, "rewritten", progress.Rewritten)
+	return m.checkpoint()
+}
+
+func (m *migrator) updateOptions() metav1.UpdateOptions {
+	if m.opts.DryRun {
+		return metav1.UpdateOptions{DryRun: []string{metav1.DryRunAll}}
+	}
+	return metav1.UpdateOptions{}
+}
+
+func (m *migrator) checkpoint() error {
+	if m.opts.DryRun || m.opts.Checkpoint == nil {
+		return nil
+	}
+	if err := m.opts.Checkpoint(m.opts.Progress); err != nil {
+		return fmt.Errorf("saving progress: %w", err)
+	}
+	return nil
+}
+
+// storageVersion returns the storage version of crd.
+func storageVersion(crd *apiextensionsv1.CustomResourceDefinition) string {
+	for _, v := range crd.Spec.Versions {
+		if v.Storage {
+			return v.Name
+		}
+	}
+	return ""
+}
@@ -0,0 +1,108 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package migration_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apiextensionsfake "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset/fake"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/crdtools/migration"
+	"sigs.k8s.io/gateway-api/pkg/client/clientset/versioned/fake"
+	"sigs.k8s.io/gateway-api/pkg/consts"
+)
+
+func TestMigrateBundleVersion(t *testing.T) {
+	testCases := []struct {
+		name          string
+		bundleVersion string
+		wantMigrated  bool
+	}{
+		{
+			name:          "bundle version of the clientset",
+			bundleVersion: consts.BundleVersion,
+			wantMigrated:  true,
+		},
+		{
+			name:          "other bundle version",
+			bundleVersion: "v99.0.0",
+		},
+	}
+
+	crd := func(kind, plural, bundleVersion, olderVersion string) *apiextensionsv1.CustomResourceDefinition {
+		return &apiextensionsv1.CustomResourceDefinition{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        plural + "." + gatewayv1.GroupName,
+				Annotations: map[string]string{consts.BundleVersionAnnotation: bundleVersion},
+			},
+			Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+				Group: gatewayv1.GroupName,
+				Names: apiextensionsv1.CustomResourceDefinitionNames{Plural: plural, Kind: kind},
+				Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+					{Name: "v1", Served: true, Storage: true},
+					{Name: olderVersion, Served: true},
+				},
+			},
+			Status: apiextensionsv1.CustomResourceDefinitionStatus{StoredVersions: []string{olderVersion, "v1"}},
+		}
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			routes := crd("HTTPRoute", "httproutes", tc.bundleVersion, "v1beta1")
+			// CRDs of another bundle version don't prevent the other ones from
+			// being migrated.
+			grants := crd("ReferenceGrant", "referencegrants", consts.BundleVersion, "v1beta1")
+			// NewClientset has no schema to patch CRDs with.
+			//nolint:staticcheck
+			crdClient := apiextensionsfake.NewSimpleClientset(routes, grants)
+			gatewayClient := fake.NewClientset(
+				&gatewayv1.HTTPRoute{ObjectMeta: metav1.ObjectMeta{Name: "route", Namespace: "default"}},
+				&gatewayv1.ReferenceGrant{ObjectMeta: metav1.ObjectMeta{Name: "grant", Namespace: "default"}},
+			)
+
+			require.NoError(t, migration.Migrate(ctx, crdClient, gatewayClient, migration.Options{}))
+
+			rewritten := map[string]bool{}
+			for _, action := range gatewayClient.Actions() {
+				if action.GetVerb() == "update" {
+					rewritten[action.GetResource().Resource] = true
+				}
+			}
+			require.True(t, rewritten["referencegrants"], "objects of the ReferenceGrant CRD weren't rewritten")
+			require.Equal(t, tc.wantMigrated, rewritten["httproutes"], "unexpected rewriting of the HTTPRoute objects")
+
+			storedVersions := func(name string) []string {
+				stored, err := crdClient.ApiextensionsV1().CustomResourceDefinitions().Get(ctx, name, metav1.GetOptions{})
+				require.NoError(t, err)
+				return stored.Status.StoredVersions
+			}
+			require.Equal(t, []string{"v1"}, storedVersions(grants.Name))
+			if tc.wantMigrated {
+				require.Equal(t, []string{"v1"}, storedVersions(routes.Name))
+			} else {
+				require.Equal(t, []string{"v1beta1", "v1"}, storedVersions(routes.Name))
+			}
+		})
+	}
+}
@@ -0,0 +1,147 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package migration
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/klog/v2"
+
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gatewayv1alpha3 "sigs.k8s.io/gateway-api/apis/v1alpha3"
+	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+	apisxv1alpha1 "sigs.k8s.io/gateway-api/apisx/v1alpha1"
+	"sigs.k8s.io/gateway-api/pkg/client/clientset/versioned"
+)
+
+// resource lists and rewrites the objects of a resource version.
+type resource struct {
+	// list returns a page of objects, and the continue token of the next one.
+	list func(ctx context.Context, opts metav1.ListOptions) ([]runtime.Object, string, error)
+	// rewrite updates obj unchanged, with its latest version on conflicts.
+	// Deleted objects are ignored.
+	rewrite func(ctx context.Context, obj runtime.Object, opts metav1.UpdateOptions) error
+}
+
+// typedClient is the part of the typed clients of the clientset used to
+// rewrite objects.
+type typedClient[T, L runtime.Object] interface {
+	Get(ctx context.Context, name string, opts metav1.GetOptions) (T, error)
+	List(ctx context.Context, opts metav1.ListOptions) (L, error)
+	Update(ctx context.Context, obj T, opts metav1.UpdateOptions) (T, error)
+}
+
+// resources returns the resource versions of the clientset.
+func resources(c versioned.Interface) map[schema.GroupVersionResource]resource {
+	v1 := c.GatewayV1()
+	v1alpha2 := c.GatewayV1alpha2()
+	v1alpha3 := c.GatewayV1alpha3()
+	v1beta1 := c.GatewayV1beta1()
+	xv1alpha1 := c.ExperimentalV1alpha1()
+	return map[schema.GroupVersionResource]resource{
+		gatewayv1.SchemeGroupVersion.WithResource("backendtlspolicies"): newResource(v1.BackendTLSPolicies),
+		gatewayv1.SchemeGroupVersion.WithResource("gatewayclasses"):     newResource(clusterScoped(v1.GatewayClasses)),
+		gatewayv1.SchemeGroupVersion.WithResource("gateways"):           newResource(v1.Gateways),
+		gatewayv1.SchemeGroupVersion.WithResource("grpcroutes"):         newResource(v1.GRPCRoutes),
+		gatewayv1.SchemeGroupVersion.WithResource("httproutes"):         newResource(v1.HTTPRoutes),
+		gatewayv1.SchemeGroupVersion.WithResource("listenersets"):       newResource(v1.ListenerSets),
+		gatewayv1.SchemeGroupVersion.WithResource("referencegrants"):    newResource(v1.ReferenceGrants),
+		gatewayv1.SchemeGroupVersion.WithResource("tcproutes"):          newResource(v1.TCPRoutes),
+		gatewayv1.SchemeGroupVersion.WithResource("tlsroutes"):          newResource(v1.TLSRoutes),
+		gatewayv1.SchemeGroupVersion.WithResource("udproutes"):          newResource(v1.UDPRoutes),
+
+		gatewayv1alpha2.SchemeGroupVersion.WithResource("grpcroutes"):      newResource(v1alpha2.GRPCRoutes),
+		gatewayv1alpha2.SchemeGroupVersion.WithResource("referencegrants"): newResource(v1alpha2.ReferenceGrants),
+		gatewayv1alpha2.SchemeGroupVersion.WithResource("tcproutes"):       newResource(v1alpha2.TCPRoutes),
+		gatewayv1alpha2.SchemeGroupVersion.WithResource("tlsroutes"):       newResource(v1alpha2.TLSRoutes),
+		gatewayv1alpha2.SchemeGroupVersion.WithResource("udproutes"):       newResource(v1alpha2.UDPRoutes),
+
+		gatewayv1alpha3.SchemeGroupVersion.WithResource("backendtlspolicies"): newResource(v1alpha3.BackendTLSPolicies),
+		gatewayv1alpha3.SchemeGroupVersion.WithResource("tlsroutes"):          newResource(v1alpha3.TLSRoutes),
+
+		gatewayv1beta1.SchemeGroupVersion.WithResource("gatewayclasses"):  newResource(clusterScoped(v1beta1.GatewayClasses)),
+		gatewayv1beta1.SchemeGroupVersion.WithResource("gateways"):        newResource(v1beta1.Gateways),
+		gatewayv1beta1.SchemeGroupVersion.WithResource("httproutes"):      newResource(v1beta1.HTTPRoutes),
+		gatewayv1beta1.SchemeGroupVersion.WithResource("referencegrants"): newResource(v1beta1.ReferenceGrants),
+
+		apisxv1alpha1.SchemeGroupVersion.WithResource("xbackends"):               newResource(xv1alpha1.XBackends),
+		apisxv1alpha1.SchemeGroupVersion.WithResource("xbackendtrafficpolicies"): newResource(xv1alpha1.XBackendTrafficPolicies),
+		apisxv1alpha1.SchemeGroupVersion.WithResource("xmeshes"):                 newResource(clusterScoped(xv1alpha1.XMeshes)),
+	}
+}
+
+// clusterScoped adapts the typed client getter of a cluster-scoped resource
+// to the getter of a namespaced one.
+func clusterScoped[C any](client func() C) func(string) C {
+	return func(string) C {
+		return client()
+	}
+}
+
+// newResource returns the resource of the typed clients returned by client,
+// by namespace.
+func newResource[T, L runtime.Object, C typedClient[T, L]](client func(namespace string) C) resource {
+	return resource{
+		list: func(ctx context.Context, opts metav1.ListOptions) ([]runtime.Object, string, error) {
+			list, err := client(metav1.NamespaceAll).List(ctx, opts)
+			if err != nil {
+				return nil, "", err
+			}
+			objs, err := meta.ExtractList(list)
+			if err != nil {
+				return nil, "", err
+			}
+			listMeta, err := meta.ListAccessor(list)
+			if err != nil {
+				return nil, "", err
+			}
+			return objs, listMeta.GetContinue(), nil
+		},
+		rewrite: func(ctx context.Context, obj runtime.Object, opts metav1.UpdateOptions) error {
+			accessor, err := meta.Accessor(obj)
+			if err != nil {
+				return err
+			}
+			c := client(accessor.GetNamespace())
+			latest := obj.(T)
+			first := true
+			err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+				if !first {
+					var getErr error
+					if latest, getErr = c.Get(ctx, accessor.GetName(), metav1.GetOptions{}); getErr != nil {
+						return getErr
+					}
+				}
+				first = false
+				_, updateErr := c.Update(ctx, latest, opts)
+				return updateErr
+			})
+			if err != nil && !apierrors.IsNotFound(err) {
+				return fmt.Errorf("rewriting %s: %w", klog.KObj(accessor), err)
+			}
+			return nil
+		},
+	}
+}
@@ -0,0 +1,89 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/crdtools/validation"
+	"sigs.k8s.io/gateway-api/pkg/builder"
+	"sigs.k8s.io/gateway-api/pkg/features"
+)
+
+const controller = "example.com/gateway-controller"
+
+// TestBuilderObjectsValid checks that the objects built by the builder package
+// are valid, and that the API server wouldn't default them any further.
+func TestBuilderObjectsValid(t *testing.T) {
+	v, err := validation.NewValidator(validation.StandardChannel)
+	require.NoError(t, err)
+
+	gw := builder.NewGateway("default", "gateway", "example").
+		HTTPListener("http", 80).
+		HTTPSListener("https", 443, "cert").ListenerHostname("*.example.com").AllowRoutesFrom(gatewayv1.NamespacesFromAll).
+		Programmed("10.0.0.1").
+		AttachedRoutes("https", 1).
+		Build()
+	objs := []client.Object{
+		builder.NewGatewayClass("example", controller).
+			Description("Example").
+			Accepted().
+			SupportedFeatures(features.SupportGateway, features.SupportHTTPRoute).
+			Build(),
+		gw,
+		builder.NewHTTPRoute("default", "all").AttachTo(gw, "").Accepted(controller).Build(),
+		builder.NewHTTPRoute("other", "route").
+			AttachTo(gw, "https").
+			Hostnames("foo.example.com").
+			Rule().MatchPathPrefix("/api").MatchQueryParam("debug", "true").Backend("svc", 8080, 10).
+			Rule().MatchHeader("canary", "true").Filter(gatewayv1.HTTPRouteFilter{
+			Type: gatewayv1.HTTPRouteFilterRequestHeaderModifier,
+			RequestHeaderModifier: &gatewayv1.HTTPHeaderFilter{
+				Set: []gatewayv1.HTTPHeader{{Name: "canary", Value: "true"}},
+			},
+		}).Backend("canary", 8080, 1).
+			Accepted(controller).
+			Build(),
+		builder.NewBackendTLSPolicy("default", "policy").
+			TargetService("svc", "https").
+			Hostname("svc.example.com").
+			CACertificateConfigMaps("ca").
+			Accepted(controller, gw).
+			Build(),
+		builder.NewBackendTLSPolicy("default", "well-known").
+			TargetService("svc", "").
+			Hostname("svc.example.com").
+			WellKnownCACertificates(gatewayv1.WellKnownCACertificatesSystem).
+			Build(),
+	}
+	for _, obj := range objs {
+		t.Run(obj.GetObjectKind().GroupVersionKind().Kind+"/"+obj.GetName(), func(t *testing.T) {
+			require.NoError(t, v.Validate(context.Background(), obj, nil))
+			require.NoError(t, v.ValidateStatus(context.Background(), obj, obj))
+
+			defaulted := obj.DeepCopyObject()
+			require.NoError(t, v.Default(defaulted))
+			assert.Equal(t, obj, defaulted)
+		})
+	}
+}
@@ -0,0 +1,154 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+	"reflect"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	"sigs.k8s.io/gateway-api/crdtools/validation"
+	"sigs.k8s.io/gateway-api/pkg/client/clientset/versioned/scheme"
+)
+
+// NewClientBuilder returns a controller-runtime fake client builder whose
+// clients default and validate with v the objects they create, update and
+// patch. The builder has a scheme with the core and Gateway API types, and
+// the status subresource of the Gateway API kinds enabled. The objects the
+// clients are initialized with, and the server-side apply patches, are not
+// validated. Calling WithInterceptorFuncs on the builder disables the
+// validation.
+func NewClientBuilder(v *validation.Validator) (*fake.ClientBuilder, error) {
+	s := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(s); err != nil {
+		return nil, err
+	}
+	if err := scheme.AddToScheme(s); err != nil {
+		return nil, err
+	}
+
+	var statusObjs []client.Object
+	for _, gvk := range v.StatusSubresourceKinds() {
+		obj, err := s.New(gvk)
+		if err != nil {
+			if runtime.IsNotRegisteredError(err) {
+				continue
+			}
+			return nil, err
+		}
+		statusObjs = append(statusObjs, obj.(client.Object))
+	}
+
+	return fake.NewClientBuilder().
+		WithScheme(s).
+		WithStatusSubresource(statusObjs...).
+		WithInterceptorFuncs(interceptorFuncs(v)), nil
+}
+
+// interceptorFuncs returns the interceptor functions defaulting and
+// validating objects with v.
+func interceptorFuncs(v *validation.Validator) interceptor.Funcs {
+	return interceptor.Funcs{
+		Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+			if err := v.Default(obj); err != nil {
+				return err
+			}
+			if err := v.Validate(ctx, obj, nil); err != nil {
+				return err
+			}
+			return c.Create(ctx, obj, opts...)
+		},
+		Update: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.UpdateOption) error {
+			oldObj, err := getCurrent(ctx, c, obj)
+			if err != nil || oldObj == nil {
+				return c.Update(ctx, obj, opts...)
+			}
+			if err := validateUpdate(ctx, v, "", obj, oldObj); err != nil {
+				return err
+			}
+			return c.Update(ctx, obj, opts...)
+		},
+		Patch: func(ctx context.Context, c client.WithWatch, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+			oldObj, err := getCurrent(ctx, c, obj)
+			if err != nil || oldObj == nil {
+				return c.Patch(ctx, obj, patch, opts...)
+			}
+			patched := obj.DeepCopyObject().(client.Object)
+			if err := c.Patch(ctx, patched, patch, append(opts, client.DryRunAll)...); err != nil {
+				return err
+			}
+			if err := validateUpdate(ctx, v, "", patched, oldObj); err != nil {
+				return err
+			}
+			// Store the patched object with its defaults.
+			if err := c.Update(ctx, patched); err != nil {
+				return err
+			}
+			reflect.ValueOf(obj).Elem().Set(reflect.ValueOf(patched).Elem())
+			return nil
+		},
+		SubResourceUpdate: func(ctx context.Context, c client.Client, subResourceName string, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+			oldObj, err := getCurrent(ctx, c, obj)
+			if err != nil || oldObj == nil {
+				return c.SubResource(subResourceName).Update(ctx, obj, opts...)
+			}
+			if err := validateUpdate(ctx, v, subResourceName, obj, oldObj); err != nil {
+				return err
+			}
+			return c.SubResource(subResourceName).Update(ctx, obj, opts...)
+		},
+		SubResourcePatch: func(ctx context.Context, c client.Client, subResourceName string, obj client.Object, patch client.Patch, opts ...client.SubResourcePatchOption) error {
+			oldObj, err := getCurrent(ctx, c, obj)
+			if err != nil || oldObj == nil {
+				return c.SubResource(subResourceName).Patch(ctx, obj, patch, opts...)
+			}
+			patched := obj.DeepCopyObject().(client.Object)
+			dryRun := &client.SubResourcePatchOptions{PatchOptions: client.PatchOptions{DryRun: []string{metav1.DryRunAll}}}
+			if err := c.SubResource(subResourceName).Patch(ctx, patched, patch, append(opts, dryRun)...); err != nil {
+				return err
+			}
+			if err := validateUpdate(ctx, v, subResourceName, patched, oldObj); err != nil {
+				return err
+			}
+			if err := c.SubResource(subResourceName).Update(ctx, patched); err != nil {
+				return err
+			}
+			reflect.ValueOf(obj).Elem().Set(reflect.ValueOf(patched).Elem())
+			return nil
+		},
+	}
+}
+
+// getCurrent returns the current version of obj, or nil if it doesn't exist,
+// in which case the client reports the error.
+func getCurrent(ctx context.Context, c client.Reader, obj client.Object) (client.Object, error) {
+	current := obj.DeepCopyObject().(client.Object)
+	if err := c.Get(ctx, client.ObjectKeyFromObject(obj), current); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return current, nil
+}
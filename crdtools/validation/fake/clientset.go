@@ -0,0 +1,186 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package fake provides fake Gateway API clients which default and validate
+// the objects they create and update against the Gateway API CRDs, as the API
+// server does, so that unit tests see the errors the API server would return.
+package fake
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	jsonpatch "gopkg.in/evanphx/json-patch.v4"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+	k8stesting "k8s.io/client-go/testing"
+
+	"sigs.k8s.io/gateway-api/crdtools/validation"
+	"sigs.k8s.io/gateway-api/pkg/client/clientset/versioned/fake"
+)
+
+// NewClientset returns a fake clientset, as returned by fake.NewClientset,
+// which defaults and validates with v the objects it is initialized with and
+// the objects it creates, updates and patches. Server-side apply patches are
+// not validated.
+func NewClientset(v *validation.Validator, objects ...runtime.Object) (*fake.Clientset, error) {
+	for _, obj := range objects {
+		if err := v.Default(obj); err != nil {
+			return nil, err
+		}
+		if err := v.Validate(context.Background(), obj, nil); err != nil {
+			return nil, err
+		}
+	}
+	c := fake.NewClientset(objects...)
+	c.PrependReactor("*", "*", validatingReaction(v, c.Tracker()))
+	return c, nil
+}
+
+// validatingReaction returns a reaction defaulting and validating the objects
+// of create, update and patch actions with v before storing them in tracker.
+// The other actions are left to the next reactions.
+func validatingReaction(v *validation.Validator, tracker k8stesting.ObjectTracker) k8stesting.ReactionFunc {
+	return func(action k8stesting.Action) (bool, runtime.Object, error) {
+		ctx := context.Background()
+		gvr, ns := action.GetResource(), action.GetNamespace()
+
+		switch action := action.(type) {
+		case k8stesting.CreateActionImpl:
+			if action.GetSubresource() != "" {
+				return false, nil, nil
+			}
+			obj := action.GetObject().DeepCopyObject()
+			if err := v.Default(obj); err != nil {
+				return true, nil, err
+			}
+			if err := v.Validate(ctx, obj, nil); err != nil {
+				return true, nil, err
+			}
+			if err := tracker.Create(gvr, obj, ns, action.CreateOptions); err != nil {
+				return true, nil, err
+			}
+			return get(tracker, action, obj)
+
+		case k8stesting.UpdateActionImpl:
+			obj := action.GetObject().DeepCopyObject()
+			oldObj, err := getOld(tracker, action, obj)
+			if err != nil {
+				return true, nil, err
+			}
+			if err := validateUpdate(ctx, v, action.GetSubresource(), obj, oldObj); err != nil {
+				return true, nil, err
+			}
+			if err := tracker.Update(gvr, obj, ns, action.UpdateOptions); err != nil {
+				return true, nil, err
+			}
+			return get(tracker, action, obj)
+
+		case k8stesting.PatchActionImpl:
+			if action.GetPatchType() == types.ApplyPatchType {
+				return false, nil, nil
+			}
+			oldObj, err := tracker.Get(gvr, ns, action.GetName())
+			if err != nil {
+				return true, nil, err
+			}
+			obj, err := applyPatch(oldObj, action.GetPatchType(), action.GetPatch())
+			if err != nil {
+				return true, nil, err
+			}
+			if err := validateUpdate(ctx, v, action.GetSubresource(), obj, oldObj); err != nil {
+				return true, nil, err
+			}
+			if err := tracker.Patch(gvr, obj, ns, action.PatchOptions); err != nil {
+				return true, nil, err
+			}
+			return get(tracker, action, obj)
+		}
+		return false, nil, nil
+	}
+}
+
+// validateUpdate defaults obj and validates it as an update of oldObj, or of
+// its status if subresource is "status".
+func validateUpdate(ctx context.Context, v *validation.Validator, subresource string, obj, oldObj runtime.Object) error {
+	if err := v.Default(obj); err != nil {
+		return err
+	}
+	switch subresource {
+	case "":
+		return v.Validate(ctx, obj, oldObj)
+	case "status":
+		return v.ValidateStatus(ctx, obj, oldObj)
+	}
+	return nil
+}
+
+// getOld returns the current version of obj in tracker.
+func getOld(tracker k8stesting.ObjectTracker, action k8stesting.Action, obj runtime.Object) (runtime.Object, error) {
+	objMeta, err := meta.Accessor(obj)
+	if err != nil {
+		return nil, err
+	}
+	return tracker.Get(action.GetResource(), action.GetNamespace(), objMeta.GetName(), metav1.GetOptions{})
+}
+
+// get returns the reaction for the stored version of obj in tracker.
+func get(tracker k8stesting.ObjectTracker, action k8stesting.Action, obj runtime.Object) (bool, runtime.Object, error) {
+	stored, err := getOld(tracker, action, obj)
+	return true, stored, err
+}
+
+// applyPatch returns a copy of obj with patch applied, as the default
+// reaction of the fake clientsets does.
+func applyPatch(obj runtime.Object, patchType types.PatchType, patch []byte) (runtime.Object, error) {
+	old, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	var modified []byte
+	switch patchType {
+	case types.JSONPatchType:
+		var p jsonpatch.Patch
+		if p, err = jsonpatch.DecodePatch(patch); err != nil {
+			return nil, err
+		}
+		if modified, err = p.Apply(old); err != nil {
+			return nil, err
+		}
+	case types.MergePatchType:
+		if modified, err = jsonpatch.MergePatch(old, patch); err != nil {
+			return nil, err
+		}
+	case types.StrategicMergePatchType:
+		if modified, err = strategicpatch.StrategicMergePatch(old, patch, obj); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("PatchType %s is not supported", patchType)
+	}
+
+	patched := reflect.New(reflect.TypeOf(obj).Elem()).Interface().(runtime.Object)
+	if err := json.Unmarshal(modified, patched); err != nil {
+		return nil, err
+	}
+	return patched, nil
+}
@@ -0,0 +1,153 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/crdtools/validation"
+	"sigs.k8s.io/gateway-api/crdtools/validation/fake"
+)
+
+var corsFilter = gatewayv1.HTTPRouteFilter{
+	Type: gatewayv1.HTTPRouteFilterCORS,
+	CORS: &gatewayv1.HTTPCORSFilter{AllowOrigins: []gatewayv1.CORSOrigin{"https://example.com"}},
+}
+
+func newRoute(filters ...gatewayv1.HTTPRouteFilter) *gatewayv1.HTTPRoute {
+	return &gatewayv1.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{Name: "route", Namespace: "default"},
+		Spec: gatewayv1.HTTPRouteSpec{
+			CommonRouteSpec: gatewayv1.CommonRouteSpec{ParentRefs: []gatewayv1.ParentReference{{Name: "gw"}}},
+			Rules:           []gatewayv1.HTTPRouteRule{{Filters: filters}},
+		},
+	}
+}
+
+func newGatewayClass() *gatewayv1.GatewayClass {
+	return &gatewayv1.GatewayClass{
+		ObjectMeta: metav1.ObjectMeta{Name: "class"},
+		Spec:       gatewayv1.GatewayClassSpec{ControllerName: "example.com/controller"},
+	}
+}
+
+func newValidator(t *testing.T) *validation.Validator {
+	t.Helper()
+	v, err := validation.NewValidator(validation.StandardChannel)
+	require.NoError(t, err)
+	return v
+}
+
+func TestClientset(t *testing.T) {
+	ctx := context.Background()
+	v := newValidator(t)
+
+	_, err := fake.NewClientset(v, newRoute(corsFilter, corsFilter))
+	require.ErrorContains(t, err, "CORS filter cannot be repeated")
+
+	c, err := fake.NewClientset(v, newGatewayClass())
+	require.NoError(t, err)
+	routes := c.GatewayV1().HTTPRoutes("default")
+
+	_, err = routes.Create(ctx, newRoute(corsFilter, corsFilter), metav1.CreateOptions{})
+	require.True(t, apierrors.IsInvalid(err), "expected an Invalid error, got %v", err)
+	require.ErrorContains(t, err, "CORS filter cannot be repeated")
+
+	route, err := routes.Create(ctx, newRoute(corsFilter), metav1.CreateOptions{})
+	require.NoError(t, err)
+	require.Equal(t, ptr.To(gatewayv1.PathMatchPathPrefix), route.Spec.Rules[0].Matches[0].Path.Type, "defaults must be applied")
+
+	route.Spec.Rules[0].Filters = append(route.Spec.Rules[0].Filters, corsFilter)
+	_, err = routes.Update(ctx, route, metav1.UpdateOptions{})
+	require.ErrorContains(t, err, "CORS filter cannot be repeated")
+
+	_, err = routes.Patch(ctx, "route", types.JSONPatchType,
+		[]byte(`[{"op": "add", "path": "/spec/rules/0/filters/-", "value": {"type": "CORS", "cors": {"allowOrigins": ["https://example.com"]}}}]`),
+		metav1.PatchOptions{})
+	require.ErrorContains(t, err, "CORS filter cannot be repeated")
+
+	route, err = routes.Get(ctx, "route", metav1.GetOptions{})
+	require.NoError(t, err)
+	require.Len(t, route.Spec.Rules[0].Filters, 1, "invalid changes must not be stored")
+
+	route.Status.Parents = []gatewayv1.RouteParentStatus{{
+		ParentRef:      gatewayv1.ParentReference{Name: "gw"},
+		ControllerName: "invalid controller name",
+	}}
+	_, err = routes.UpdateStatus(ctx, route, metav1.UpdateOptions{})
+	require.ErrorContains(t, err, "status.parents[0].controllerName")
+
+	_, err = c.GatewayV1().GatewayClasses().Patch(ctx, "class", types.MergePatchType,
+		[]byte(`{"spec": {"controllerName": "example.com/other"}}`), metav1.PatchOptions{})
+	require.ErrorContains(t, err, "field is immutable")
+}
+
+func TestClientBuilder(t *testing.T) {
+	ctx := context.Background()
+	builder, err := fake.NewClientBuilder(newValidator(t))
+	require.NoError(t, err)
+	c := builder.Build()
+
+	err = c.Create(ctx, newRoute(corsFilter, corsFilter))
+	require.True(t, apierrors.IsInvalid(err), "expected an Invalid error, got %v", err)
+	require.ErrorContains(t, err, "CORS filter cannot be repeated")
+
+	route := newRoute(corsFilter)
+	require.NoError(t, c.Create(ctx, route))
+	require.Equal(t, ptr.To(gatewayv1.PathMatchPathPrefix), route.Spec.Rules[0].Matches[0].Path.Type, "defaults must be applied")
+
+	original := route.DeepCopy()
+	route.Spec.Rules[0].Filters = append(route.Spec.Rules[0].Filters, corsFilter)
+	require.ErrorContains(t, c.Patch(ctx, route, client.MergeFrom(original)), "CORS filter cannot be repeated")
+	require.ErrorContains(t, c.Update(ctx, route), "CORS filter cannot be repeated")
+
+	route = original.DeepCopy()
+	route.Spec.Rules = append(route.Spec.Rules, gatewayv1.HTTPRouteRule{})
+	require.NoError(t, c.Patch(ctx, route, client.MergeFrom(original)))
+	require.Len(t, route.Spec.Rules[1].Matches, 1, "defaults must be applied to patched objects")
+
+	original = route.DeepCopy()
+	route.Status.Parents = []gatewayv1.RouteParentStatus{{
+		ParentRef:      gatewayv1.ParentReference{Name: "gw"},
+		ControllerName: "invalid controller name",
+	}}
+	require.ErrorContains(t, c.Status().Update(ctx, route), "status.parents[0].controllerName")
+	require.ErrorContains(t, c.Status().Patch(ctx, route, client.MergeFrom(original)), "status.parents[0].controllerName")
+
+	route.Status.Parents[0].ControllerName = "example.com/controller"
+	route.Status.Parents[0].Conditions = []metav1.Condition{{
+		Type:               string(gatewayv1.RouteConditionAccepted),
+		Status:             metav1.ConditionTrue,
+		Reason:             string(gatewayv1.RouteReasonAccepted),
+		LastTransitionTime: metav1.Now(),
+	}}
+	require.NoError(t, c.Status().Update(ctx, route))
+
+	gatewayClass := newGatewayClass()
+	require.NoError(t, c.Create(ctx, gatewayClass))
+	gatewayClass.Spec.ControllerName = "example.com/other"
+	require.ErrorContains(t, c.Update(ctx, gatewayClass), "field is immutable")
+}
@@ -0,0 +1,265 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+
+	"go.yaml.in/yaml/v3"
+	structuralschema "k8s.io/apiextensions-apiserver/pkg/apiserver/schema"
+	structuraldefaulting "k8s.io/apiextensions-apiserver/pkg/apiserver/schema/defaulting"
+	structuralpruning "k8s.io/apiextensions-apiserver/pkg/apiserver/schema/pruning"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	apisxv1alpha1 "sigs.k8s.io/gateway-api/apisx/v1alpha1"
+)
+
+// ManifestError is an error of an object of a manifest.
+type ManifestError struct {
+	// File is the name of the manifest.
+	File string
+	// Line is the line of the field in error, or of the object if the
+	// field is not in the manifest.
+	Line int
+	// Object identifies the object in error, as "Kind name" or
+	// "Kind namespace/name".
+	Object string
+	// Field is the path of the field in error, or empty for errors of the
+	// whole object.
+	Field string
+	// Detail describes the error.
+	Detail string
+}
+
+func (e ManifestError) Error() string {
+	if e.Field == "" {
+		return fmt.Sprintf("%s:%d: %s: %s", e.File, e.Line, e.Object, e.Detail)
+	}
+	return fmt.Sprintf("%s:%d: %s: %s: %s", e.File, e.Line, e.Object, e.Field, e.Detail)
+}
+
+// ValidateManifests validates the Gateway API objects of the YAML manifests
+// read from r, named file in the returned errors, as the API server would
+// validate their creation. Unknown fields are reported as errors, as with
+// strict field validation, as well as objects of the Gateway API groups with
+// no CRD in the Validator. Objects of other groups are ignored. The returned
+// error is only set when r can't be read or parsed.
+func (v *Validator) ValidateManifests(ctx context.Context, file string, r io.Reader) ([]ManifestError, error) {
+	var errs []ManifestError
+	decoder := yaml.NewDecoder(r)
+	for {
+		var doc yaml.Node
+		if err := decoder.Decode(&doc); err != nil {
+			if errors.Is(err, io.EOF) {
+				return errs, nil
+			}
+			return nil, fmt.Errorf("%s: %w", file, err)
+		}
+		if len(doc.Content) == 0 {
+			continue
+		}
+
+		objs, err := manifestObjects(doc.Content[0])
+		if err != nil {
+			return nil, fmt.Errorf("%s:%d: %w", file, doc.Content[0].Line, err)
+		}
+		for _, obj := range objs {
+			objErrs := v.validateManifestObject(ctx, obj.u)
+			for i := range objErrs {
+				objErrs[i].File = file
+				objErrs[i].Line = fieldLine(obj.node, objErrs[i].Field)
+			}
+			slices.SortStableFunc(objErrs, func(a, b ManifestError) int { return a.Line - b.Line })
+			errs = append(errs, objErrs...)
+		}
+	}
+}
+
+// manifestObject is an object of a manifest, with its YAML node.
+type manifestObject struct {
+	u    *unstructured.Unstructured
+	node *yaml.Node
+}
+
+// manifestObjects returns the object of node, or the items of node if it is
+// a List.
+func manifestObjects(node *yaml.Node) ([]manifestObject, error) {
+	if node.Kind == yaml.ScalarNode && node.Tag == "!!null" {
+		return nil, nil
+	}
+	var content any
+	if err := node.Decode(&content); err != nil {
+		return nil, err
+	}
+	data, err := json.Marshal(content)
+	if err != nil {
+		return nil, err
+	}
+	u := &unstructured.Unstructured{}
+	if err := u.UnmarshalJSON(data); err != nil {
+		return nil, err
+	}
+	if !u.IsList() {
+		return []manifestObject{{u: u, node: node}}, nil
+	}
+
+	var objs []manifestObject
+	items := lookupNode(node, "items")
+	if items == nil {
+		return nil, nil
+	}
+	for _, item := range items.Content {
+		itemObjs, err := manifestObjects(item)
+		if err != nil {
+			return nil, err
+		}
+		objs = append(objs, itemObjs...)
+	}
+	return objs, nil
+}
+
+// validateManifestObject validates u as a creation, after applying the
+// defaults of its schema. The errors only have their Object, Field and
+// Detail set.
+func (v *Validator) validateManifestObject(ctx context.Context, u *unstructured.Unstructured) []ManifestError {
+	gvk := u.GroupVersionKind()
+	object := gvk.Kind + " " + u.GetName()
+	if u.GetNamespace() != "" {
+		object = gvk.Kind + " " + u.GetNamespace() + "/" + u.GetName()
+	}
+
+	vv, ok := v.versions[gvk]
+	if !ok {
+		if gvk.Group == gatewayv1.GroupName || gvk.Group == apisxv1alpha1.GroupName {
+			return []ManifestError{{
+				Object: object,
+				Field:  "apiVersion",
+				Detail: fmt.Sprintf("no CRD serves kind %s in version %s", gvk.Kind, gvk.GroupVersion()),
+			}}
+		}
+		return nil
+	}
+
+	var errs []ManifestError
+	content := u.DeepCopy().Object
+	unknownFields := structuralpruning.PruneWithOptions(content, vv.structural, true, structuralschema.UnknownFieldPathOptions{
+		TrackUnknownFieldPaths: true,
+	})
+	for _, path := range unknownFields {
+		errs = append(errs, ManifestError{Object: object, Field: path, Detail: "unknown field"})
+	}
+	structuraldefaulting.PruneNonNullableNullsWithoutDefaults(content, vv.structural)
+	structuraldefaulting.Default(content, vv.structural)
+
+	obj := &unstructured.Unstructured{Object: content}
+	if vv.namespaced && obj.GetNamespace() == "" {
+		// As when applying the manifest, the namespace defaults to the
+		// namespace of the client.
+		obj.SetNamespace(metav1.NamespaceDefault)
+	}
+	for _, err := range vv.validate(ctx, obj, nil) {
+		errs = append(errs, ManifestError{Object: object, Field: fieldPath(err), Detail: err.ErrorBody()})
+	}
+	return errs
+}
+
+// fieldPath returns the path of the field of err, or an empty string for
+// errors of the whole object.
+func fieldPath(err *field.Error) string {
+	if err.Field == "<nil>" {
+		return ""
+	}
+	return err.Field
+}
+
+// fieldLine returns the line of the field at path in node, or of its
+// deepest ancestor in node.
+func fieldLine(node *yaml.Node, path string) int {
+	line := node.Line
+	for _, elem := range splitFieldPath(path) {
+		var next *yaml.Node
+		switch node.Kind {
+		case yaml.MappingNode:
+			for i := 0; i+1 < len(node.Content); i += 2 {
+				if node.Content[i].Value == elem {
+					line, next = node.Content[i].Line, node.Content[i+1]
+					break
+				}
+			}
+		case yaml.SequenceNode:
+			if index, err := strconv.Atoi(elem); err == nil && index >= 0 && index < len(node.Content) {
+				next = node.Content[index]
+				line = next.Line
+			}
+		}
+		if next == nil {
+			break
+		}
+		node = next
+	}
+	return line
+}
+
+// splitFieldPath splits a field path, such as "spec.rules[0].matches", into
+// its field names, map keys and list indexes.
+func splitFieldPath(path string) []string {
+	var elems []string
+	for path != "" {
+		switch {
+		case path[0] == '.':
+			path = path[1:]
+		case path[0] == '[':
+			end := strings.IndexByte(path, ']')
+			if end < 0 {
+				return append(elems, path[1:])
+			}
+			elems = append(elems, path[1:end])
+			path = path[end+1:]
+		default:
+			end := strings.IndexAny(path, ".[")
+			if end < 0 {
+				end = len(path)
+			}
+			elems = append(elems, path[:end])
+			path = path[end:]
+		}
+	}
+	return elems
+}
+
+// lookupNode returns the value of key in the mapping node, or nil.
+func lookupNode(node *yaml.Node, key string) *yaml.Node {
+	if node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,148 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation_test
+
+import (
+	"context"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"sigs.k8s.io/gateway-api/crdtools/validation"
+)
+
+func TestValidateManifests(t *testing.T) {
+	v, err := validation.NewValidator(validation.StandardChannel)
+	require.NoError(t, err)
+
+	manifest := `apiVersion: v1
+kind: Service
+metadata:
+  name: svc
+spec:
+  unknown: ignored
+---
+apiVersion: gateway.networking.k8s.io/v1
+kind: HTTPRoute
+metadata:
+  name: route
+  namespace: apps
+spec:
+  rules:
+  - filters:
+    - type: CORS
+      cors:
+        allowOrigins: ["https://example.com"]
+    - type: CORS
+      cors:
+        allowOrigins: ["https://example.com"]
+  - backendRefs:
+    - name: svc
+      port: 80
+      unknown: field
+---
+apiVersion: v1
+kind: List
+items:
+- apiVersion: gateway.networking.k8s.io/v1
+  kind: GatewayClass
+  metadata:
+    name: class
+  spec:
+    controllerName: invalid
+- apiVersion: gateway.networking.x-k8s.io/v1alpha1
+  kind: XMesh
+  metadata:
+    name: mesh
+`
+	errs, err := v.ValidateManifests(context.Background(), "manifest.yaml", strings.NewReader(manifest))
+	require.NoError(t, err)
+	require.Equal(t, []validation.ManifestError{
+		{
+			File:   "manifest.yaml",
+			Line:   15,
+			Object: "HTTPRoute apps/route",
+			Field:  "spec.rules[0].filters",
+			Detail: "Invalid value: CORS filter cannot be repeated",
+		},
+		{
+			File:   "manifest.yaml",
+			Line:   25,
+			Object: "HTTPRoute apps/route",
+			Field:  "spec.rules[1].backendRefs[0].unknown",
+			Detail: "unknown field",
+		},
+		{
+			File:   "manifest.yaml",
+			Line:   35,
+			Object: "GatewayClass class",
+			Field:  "spec.controllerName",
+			Detail: "Invalid value: \"invalid\": spec.controllerName in body should match '^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*\\/[A-Za-z0-9\\/\\-._~%!$&'()*+,;=:]+$'",
+		},
+		{
+			File:   "manifest.yaml",
+			Line:   36,
+			Object: "XMesh mesh",
+			Field:  "apiVersion",
+			Detail: "no CRD serves kind XMesh in version gateway.networking.x-k8s.io/v1alpha1",
+		},
+	}, errs)
+
+	_, err = v.ValidateManifests(context.Background(), "invalid.yaml", strings.NewReader("a: [b"))
+	require.Error(t, err)
+}
+
+func TestValidateManifestsExamples(t *testing.T) {
+	for _, channel := range []validation.Channel{validation.StandardChannel, validation.ExperimentalChannel} {
+		t.Run(string(channel), func(t *testing.T) {
+			v, err := validation.NewValidator(channel)
+			require.NoError(t, err)
+
+			validateFiles := func(t *testing.T, dir string, valid bool) {
+				err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+					if err != nil || d.IsDir() || filepath.Ext(path) != ".yaml" {
+						return err
+					}
+					if channel == validation.StandardChannel && strings.Contains(path, "experimental") {
+						return nil
+					}
+					t.Run(path, func(t *testing.T) {
+						f, err := os.Open(path)
+						require.NoError(t, err)
+						defer f.Close()
+
+						errs, err := v.ValidateManifests(context.Background(), path, f)
+						require.NoError(t, err)
+						if valid {
+							require.Empty(t, errs)
+						} else {
+							require.NotEmpty(t, errs)
+						}
+					})
+					return nil
+				})
+				require.NoError(t, err)
+			}
+			validateFiles(t, filepath.Join("..", "..", "examples", string(channel)), true)
+			validateFiles(t, filepath.Join("..", "..", "hack", "invalid-examples"), false)
+		})
+	}
+}
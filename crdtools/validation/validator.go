@@ -0,0 +1,398 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package validation validates Gateway API objects against the Gateway API
+// CRDs in-process, as the API server does: it applies the defaults of the CRD
+// schemas, then validates objects against the OpenAPI schemas, including the
+// x-kubernetes-list-type invariants and the x-kubernetes-validations CEL
+// rules. Unit tests can use it to see the errors the API server would return.
+package validation
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"path"
+
+	apiextensionsinternal "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	structuralschema "k8s.io/apiextensions-apiserver/pkg/apiserver/schema"
+	"k8s.io/apiextensions-apiserver/pkg/apiserver/schema/cel"
+	structuraldefaulting "k8s.io/apiextensions-apiserver/pkg/apiserver/schema/defaulting"
+	structurallisttype "k8s.io/apiextensions-apiserver/pkg/apiserver/schema/listtype"
+	schemaobjectmeta "k8s.io/apiextensions-apiserver/pkg/apiserver/schema/objectmeta"
+	structuralpruning "k8s.io/apiextensions-apiserver/pkg/apiserver/schema/pruning"
+	apiservervalidation "k8s.io/apiextensions-apiserver/pkg/apiserver/validation"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	celconfig "k8s.io/apiserver/pkg/apis/cel"
+
+	"sigs.k8s.io/gateway-api/config/crd"
+	"sigs.k8s.io/gateway-api/pkg/client/clientset/versioned/scheme"
+)
+
+// Channel is a release channel of the Gateway API CRDs.
+type Channel string
+
+const (
+	// StandardChannel is the channel of the standard CRDs.
+	StandardChannel Channel = "standard"
+	// ExperimentalChannel is the channel of the experimental CRDs.
+	ExperimentalChannel Channel = "experimental"
+)
+
+// Validator defaults and validates Gateway API objects against the schemas of
+// a set of CRDs.
+type Validator struct {
+	versions map[schema.GroupVersionKind]*versionValidator
+}
+
+// versionValidator defaults and validates the objects of a version of a CRD.
+type versionValidator struct {
+	namespaced      bool
+	statusEnabled   bool
+	structural      *structuralschema.Structural
+	schemaValidator apiservervalidation.SchemaValidator
+	statusValidator apiservervalidation.SchemaValidator
+	celValidator    *cel.Validator
+}
+
+// NewValidator returns a Validator for the CRDs of channel bundled with this
+// module.
+func NewValidator(channel Channel) (*Validator, error) {
+	crds, err := LoadCRDs(crd.Manifests, string(channel))
+	if err != nil {
+		return nil, err
+	}
+	return NewValidatorForCRDs(crds...)
+}
+
+// NewValidatorForCRDs returns a Validator for the served versions of crds.
+func NewValidatorForCRDs(crds ...*apiextensionsv1.CustomResourceDefinition) (*Validator, error) {
+	v := &Validator{versions: map[schema.GroupVersionKind]*versionValidator{}}
+	for _, c := range crds {
+		for _, version := range c.Spec.Versions {
+			if !version.Served || version.Schema == nil {
+				continue
+			}
+			vv, err := newVersionValidator(c, &version)
+			if err != nil {
+				return nil, fmt.Errorf("CRD %s version %s: %w", c.Name, version.Name, err)
+			}
+			gvk := schema.GroupVersionKind{Group: c.Spec.Group, Version: version.Name, Kind: c.Spec.Names.Kind}
+			v.versions[gvk] = vv
+		}
+	}
+	return v, nil
+}
+
+func newVersionValidator(c *apiextensionsv1.CustomResourceDefinition, version *apiextensionsv1.CustomResourceDefinitionVersion) (*versionValidator, error) {
+	internal := &apiextensionsinternal.CustomResourceValidation{}
+	if err := apiextensionsv1.Convert_v1_CustomResourceValidation_To_apiextensions_CustomResourceValidation(version.Schema, internal, nil); err != nil {
+		return nil, fmt.Errorf("converting schema to internal version: %w", err)
+	}
+	structural, err := structuralschema.NewStructural(internal.OpenAPIV3Schema)
+	if err != nil {
+		return nil, fmt.Errorf("converting schema to structural: %w", err)
+	}
+	if err = structuraldefaulting.PruneDefaults(structural); err != nil {
+		return nil, fmt.Errorf("pruning defaults: %w", err)
+	}
+	schemaValidator, _, err := apiservervalidation.NewSchemaValidator(internal.OpenAPIV3Schema)
+	if err != nil {
+		return nil, err
+	}
+
+	vv := &versionValidator{
+		namespaced:      c.Spec.Scope == apiextensionsv1.NamespaceScoped,
+		statusEnabled:   version.Subresources != nil && version.Subresources.Status != nil,
+		structural:      structural,
+		schemaValidator: schemaValidator,
+		statusValidator: schemaValidator,
+		celValidator:    cel.NewValidator(structural, true, celconfig.PerCallLimit),
+	}
+	if status, ok := internal.OpenAPIV3Schema.Properties["status"]; ok {
+		if vv.statusValidator, _, err = apiservervalidation.NewSchemaValidator(&status); err != nil {
+			return nil, err
+		}
+	}
+	return vv, nil
+}
+
+// LoadCRDs loads the CRDs of the YAML manifests of dir in fsys. Other objects
+// in the manifests, such as ValidatingAdmissionPolicies, are ignored.
+func LoadCRDs(fsys fs.FS, dir string) ([]*apiextensionsv1.CustomResourceDefinition, error) {
+	files, err := fs.Glob(fsys, path.Join(dir, "*.yaml"))
+	if err != nil {
+		return nil, err
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no manifests found in %s", dir)
+	}
+
+	var crds []*apiextensionsv1.CustomResourceDefinition
+	for _, file := range files {
+		data, err := fs.ReadFile(fsys, file)
+		if err != nil {
+			return nil, err
+		}
+		fileCRDs, err := DecodeCRDs(bytes.NewReader(data))
+		if err != nil {
+			return nil, fmt.Errorf("decoding %s: %w", file, err)
+		}
+		crds = append(crds, fileCRDs...)
+	}
+	return crds, nil
+}
+
+// DecodeCRDs decodes the CRDs of a YAML or JSON stream of manifests. Other
+// objects are ignored.
+func DecodeCRDs(r io.Reader) ([]*apiextensionsv1.CustomResourceDefinition, error) {
+	var crds []*apiextensionsv1.CustomResourceDefinition
+	decoder := utilyaml.NewYAMLOrJSONDecoder(r, 4096)
+	for {
+		var u unstructured.Unstructured
+		if err := decoder.Decode(&u.Object); err != nil {
+			if errors.Is(err, io.EOF) {
+				return crds, nil
+			}
+			return nil, err
+		}
+		if u.GroupVersionKind() != apiextensionsv1.SchemeGroupVersion.WithKind("CustomResourceDefinition") {
+			continue
+		}
+		c := &apiextensionsv1.CustomResourceDefinition{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, c); err != nil {
+			return nil, err
+		}
+		crds = append(crds, c)
+	}
+}
+
+// Handles returns whether the Validator has a schema for the objects of gvk.
+func (v *Validator) Handles(gvk schema.GroupVersionKind) bool {
+	_, ok := v.versions[gvk]
+	return ok
+}
+
+// StatusSubresourceKinds returns the kinds the Validator has a schema for
+// whose CRD enables the status subresource.
+func (v *Validator) StatusSubresourceKinds() []schema.GroupVersionKind {
+	var gvks []schema.GroupVersionKind
+	for gvk, vv := range v.versions {
+		if vv.statusEnabled {
+			gvks = append(gvks, gvk)
+		}
+	}
+	return gvks
+}
+
+// Default applies the defaults of the schema of obj to obj, after dropping
+// the fields the schema doesn't know and the null values of non-nullable
+// fields, as the API server does when decoding objects. Objects the Validator
+// has no schema for are left untouched.
+func (v *Validator) Default(obj runtime.Object) error {
+	vv, u, err := v.prepare(obj)
+	if err != nil || vv == nil {
+		return err
+	}
+	structuraldefaulting.Default(u.Object, vv.structural)
+	return fromUnstructured(u, obj)
+}
+
+// Validate validates obj against its schema, and returns an Invalid API error
+// on failures. oldObj must be the current version of obj on updates, for the
+// CEL transition rules, or nil on creations. As the API server ignores the
+// status of objects with a status subresource on creations and updates, it
+// isn't validated, see ValidateStatus. Objects the Validator has no schema
+// for are always valid.
+func (v *Validator) Validate(ctx context.Context, obj, oldObj runtime.Object) error {
+	vv, u, err := v.prepare(obj)
+	if err != nil || vv == nil {
+		return err
+	}
+
+	var uOld *unstructured.Unstructured
+	if oldObj != nil {
+		if _, uOld, err = v.prepare(oldObj); err != nil {
+			return err
+		}
+	}
+
+	return invalid(u, vv.validate(ctx, u, uOld))
+}
+
+// validate validates u as a creation, or as an update of uOld if not nil.
+func (vv *versionValidator) validate(ctx context.Context, u, uOld *unstructured.Unstructured) field.ErrorList {
+	if vv.statusEnabled {
+		delete(u.Object, "status")
+		if uOld != nil {
+			if status, ok := uOld.Object["status"]; ok {
+				u.Object["status"] = status
+			}
+		}
+	}
+
+	var errs field.ErrorList
+	if uOld == nil {
+		errs = append(errs, validation.ValidateObjectMetaAccessor(u, vv.namespaced, validation.NameIsDNSSubdomain, field.NewPath("metadata"))...)
+		errs = append(errs, apiservervalidation.ValidateCustomResource(nil, u.Object, vv.schemaValidator)...)
+	} else {
+		errs = append(errs, validateObjectMetaUpdate(u, uOld)...)
+		errs = append(errs, apiservervalidation.ValidateCustomResourceUpdate(nil, u.Object, uOld.Object, vv.schemaValidator)...)
+	}
+	return vv.validateSchema(ctx, u, uOld, errs)
+}
+
+// ValidateStatus validates the status of obj against its schema, as on
+// updates of the status subresource, and returns an Invalid API error on
+// failures. oldObj must be the current version of obj.
+func (v *Validator) ValidateStatus(ctx context.Context, obj, oldObj runtime.Object) error {
+	vv, u, err := v.prepare(obj)
+	if err != nil || vv == nil {
+		return err
+	}
+	_, uOld, err := v.prepare(oldObj)
+	if err != nil {
+		return err
+	}
+
+	var errs field.ErrorList
+	if status, ok := u.Object["status"]; ok {
+		errs = append(errs, apiservervalidation.ValidateCustomResourceUpdate(field.NewPath("status"), status, uOld.Object["status"], vv.statusValidator)...)
+	}
+	return invalid(u, vv.validateSchema(ctx, u, uOld, errs))
+}
+
+// validateObjectMetaUpdate validates the metadata of u as an update of uOld.
+// Resource versions are not required, as they are left to the clients.
+func validateObjectMetaUpdate(u, uOld *unstructured.Unstructured) field.ErrorList {
+	var errs field.ErrorList
+	resourceVersion := field.NewPath("metadata", "resourceVersion").String()
+	for _, err := range validation.ValidateObjectMetaAccessorUpdate(u, uOld, field.NewPath("metadata")) {
+		if err.Field != resourceVersion {
+			errs = append(errs, err)
+		}
+	}
+	return errs
+}
+
+// validateSchema adds to errs the errors of the embedded objects, the list
+// invariants and the CEL rules of the schema of u. As in the API server, the
+// CEL rules are skipped when errs has errors they can't be evaluated with.
+func (vv *versionValidator) validateSchema(ctx context.Context, u, uOld *unstructured.Unstructured, errs field.ErrorList) field.ErrorList {
+	errs = append(errs, schemaobjectmeta.Validate(nil, u.Object, vv.structural, false)...)
+	if uOld == nil || len(structurallisttype.ValidateListSetsAndMaps(nil, vv.structural, uOld.Object)) == 0 {
+		errs = append(errs, structurallisttype.ValidateListSetsAndMaps(nil, vv.structural, u.Object)...)
+	}
+	if blocking := blockingError(errs); blocking != nil {
+		return append(errs, blocking)
+	}
+
+	var old any
+	if uOld != nil {
+		old = uOld.Object
+	}
+	celErrs, _ := vv.celValidator.Validate(ctx, nil, vv.structural, u.Object, old, celconfig.RuntimeCELCostBudget)
+	return append(errs, celErrs...)
+}
+
+// prepare returns the validator of the version of obj, or nil if there is
+// none, and obj as unstructured, without the unknown fields and the null
+// values of non-nullable fields, like the objects the API server decodes. An
+// empty status is dropped too, as typed objects can't omit it.
+func (v *Validator) prepare(obj runtime.Object) (*versionValidator, *unstructured.Unstructured, error) {
+	gvk, err := objectKind(obj)
+	if err != nil {
+		return nil, nil, err
+	}
+	vv, ok := v.versions[gvk]
+	if !ok {
+		return nil, nil, nil
+	}
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return nil, nil, err
+	}
+	structuralpruning.Prune(content, vv.structural, true)
+	structuraldefaulting.PruneNonNullableNullsWithoutDefaults(content, vv.structural)
+	if status, ok := content["status"].(map[string]any); ok && len(status) == 0 {
+		delete(content, "status")
+	}
+	u := &unstructured.Unstructured{Object: content}
+	u.SetGroupVersionKind(gvk)
+	return vv, u, nil
+}
+
+// objectKind returns the kind of obj, from its TypeMeta or, for typed
+// objects without one, from the Gateway API scheme.
+func objectKind(obj runtime.Object) (schema.GroupVersionKind, error) {
+	if gvk := obj.GetObjectKind().GroupVersionKind(); !gvk.Empty() {
+		return gvk, nil
+	}
+	gvks, _, err := scheme.Scheme.ObjectKinds(obj)
+	if err != nil {
+		if runtime.IsNotRegisteredError(err) {
+			return schema.GroupVersionKind{}, nil
+		}
+		return schema.GroupVersionKind{}, err
+	}
+	return gvks[0], nil
+}
+
+// fromUnstructured copies u to obj, preserving the TypeMeta of obj.
+func fromUnstructured(u *unstructured.Unstructured, obj runtime.Object) error {
+	if target, ok := obj.(*unstructured.Unstructured); ok {
+		u.SetGroupVersionKind(target.GroupVersionKind())
+		target.Object = u.Object
+		return nil
+	}
+	gvk := obj.GetObjectKind().GroupVersionKind()
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, obj); err != nil {
+		return err
+	}
+	obj.GetObjectKind().SetGroupVersionKind(gvk)
+	return nil
+}
+
+// invalid returns an Invalid API error for u with errs, or nil if errs is
+// empty.
+func invalid(u *unstructured.Unstructured, errs field.ErrorList) error {
+	if len(errs) == 0 {
+		return nil
+	}
+	return apierrors.NewInvalid(u.GroupVersionKind().GroupKind(), u.GetName(), errs)
+}
+
+// blockingError returns the error the API server reports when errs prevents
+// it from evaluating CEL rules, or nil.
+func blockingError(errs field.ErrorList) *field.Error {
+	for _, err := range errs {
+		switch err.Type {
+		case field.ErrorTypeNotSupported, field.ErrorTypeRequired, field.ErrorTypeTooLong, field.ErrorTypeTooMany, field.ErrorTypeTypeInvalid:
+			return field.Invalid(nil, nil, "some validation rules were not checked because the object was invalid; correct the existing errors to complete validation")
+		}
+	}
+	return nil
+}
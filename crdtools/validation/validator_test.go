@@ -0,0 +1,159 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/crdtools/validation"
+)
+
+func TestNewValidator(t *testing.T) {
+	for _, channel := range []validation.Channel{validation.StandardChannel, validation.ExperimentalChannel} {
+		t.Run(string(channel), func(t *testing.T) {
+			v, err := validation.NewValidator(channel)
+			require.NoError(t, err)
+			require.True(t, v.Handles(gatewayv1.SchemeGroupVersion.WithKind("HTTPRoute")))
+			require.Equal(t, channel == validation.ExperimentalChannel,
+				v.Handles(schema.GroupVersionKind{Group: "gateway.networking.x-k8s.io", Version: "v1alpha1", Kind: "XMesh"}))
+		})
+	}
+}
+
+func TestValidator(t *testing.T) {
+	v, err := validation.NewValidator(validation.StandardChannel)
+	require.NoError(t, err)
+
+	cors := gatewayv1.HTTPRouteFilter{
+		Type: gatewayv1.HTTPRouteFilterCORS,
+		CORS: &gatewayv1.HTTPCORSFilter{AllowOrigins: []gatewayv1.CORSOrigin{"https://example.com"}},
+	}
+	rewrite := gatewayv1.HTTPRouteFilter{
+		Type: gatewayv1.HTTPRouteFilterURLRewrite,
+		URLRewrite: &gatewayv1.HTTPURLRewriteFilter{Path: &gatewayv1.HTTPPathModifier{
+			Type:               gatewayv1.PrefixMatchHTTPPathModifier,
+			ReplacePrefixMatch: ptr.To("/new"),
+		}},
+	}
+	route := func(rules ...gatewayv1.HTTPRouteRule) *gatewayv1.HTTPRoute {
+		return &gatewayv1.HTTPRoute{
+			ObjectMeta: metav1.ObjectMeta{Name: "route", Namespace: "default"},
+			Spec: gatewayv1.HTTPRouteSpec{
+				CommonRouteSpec: gatewayv1.CommonRouteSpec{ParentRefs: []gatewayv1.ParentReference{{Name: "gw"}}},
+				Rules:           rules,
+			},
+		}
+	}
+
+	testCases := []struct {
+		name       string
+		obj        *gatewayv1.HTTPRoute
+		wantErrors []string
+	}{
+		{
+			name: "valid route",
+			obj:  route(gatewayv1.HTTPRouteRule{Filters: []gatewayv1.HTTPRouteFilter{cors}}),
+		},
+		{
+			name:       "repeated CORS filter",
+			obj:        route(gatewayv1.HTTPRouteRule{Filters: []gatewayv1.HTTPRouteFilter{cors, cors}}),
+			wantErrors: []string{"CORS filter cannot be repeated"},
+		},
+		{
+			name: "ReplacePrefixMatch with the default PathPrefix match",
+			obj:  route(gatewayv1.HTTPRouteRule{Filters: []gatewayv1.HTTPRouteFilter{rewrite}}),
+		},
+		{
+			name: "ReplacePrefixMatch without PathPrefix match",
+			obj: route(gatewayv1.HTTPRouteRule{
+				Matches: []gatewayv1.HTTPRouteMatch{{Path: &gatewayv1.HTTPPathMatch{
+					Type:  ptr.To(gatewayv1.PathMatchExact),
+					Value: ptr.To("/foo"),
+				}}},
+				Filters: []gatewayv1.HTTPRouteFilter{rewrite},
+			}),
+			wantErrors: []string{"When using URLRewrite filter with path.replacePrefixMatch, exactly one PathPrefix match must be specified"},
+		},
+		{
+			name: "invalid enum value",
+			obj: route(gatewayv1.HTTPRouteRule{Filters: []gatewayv1.HTTPRouteFilter{{
+				Type: "Invalid",
+			}}}),
+			wantErrors: []string{`Unsupported value: "Invalid"`},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			obj := tc.obj.DeepCopy()
+			require.NoError(t, v.Default(obj))
+			err := v.Validate(context.Background(), obj, nil)
+			if len(tc.wantErrors) == 0 {
+				require.NoError(t, err)
+				return
+			}
+			require.True(t, apierrors.IsInvalid(err), "expected an Invalid error, got %v", err)
+			for _, want := range tc.wantErrors {
+				require.ErrorContains(t, err, want)
+			}
+		})
+	}
+}
+
+func TestValidatorDefaults(t *testing.T) {
+	v, err := validation.NewValidator(validation.StandardChannel)
+	require.NoError(t, err)
+
+	route := &gatewayv1.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{Name: "route", Namespace: "default"},
+		Spec:       gatewayv1.HTTPRouteSpec{Rules: []gatewayv1.HTTPRouteRule{{}}},
+	}
+	require.NoError(t, v.Default(route))
+	require.Equal(t, []gatewayv1.HTTPRouteMatch{{Path: &gatewayv1.HTTPPathMatch{
+		Type:  ptr.To(gatewayv1.PathMatchPathPrefix),
+		Value: ptr.To("/"),
+	}}}, route.Spec.Rules[0].Matches)
+
+	// Objects the Validator has no schema for are ignored.
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "secret"}}
+	require.NoError(t, v.Default(secret))
+	require.NoError(t, v.Validate(context.Background(), secret, nil))
+}
+
+func TestValidatorTransitionRules(t *testing.T) {
+	v, err := validation.NewValidator(validation.StandardChannel)
+	require.NoError(t, err)
+
+	gatewayClass := &gatewayv1.GatewayClass{
+		ObjectMeta: metav1.ObjectMeta{Name: "class"},
+		Spec:       gatewayv1.GatewayClassSpec{ControllerName: "example.com/controller"},
+	}
+	require.NoError(t, v.Validate(context.Background(), gatewayClass, nil))
+
+	updated := gatewayClass.DeepCopy()
+	updated.Spec.ControllerName = "example.com/other-controller"
+	require.ErrorContains(t, v.Validate(context.Background(), updated, gatewayClass), "field is immutable")
+}
@@ -116,6 +116,12 @@ $GOTOOL k8s.io/code-generator/cmd/register-gen \
   ${COMMON_FLAGS} \
   ${GATEWAY_INPUT_DIRS_SPACE}
 
+echo "Generating conversions"
+$GOTOOL k8s.io/code-generator/cmd/conversion-gen \
+  --output-file zz_generated.conversion.go \
+  ${COMMON_FLAGS} \
+  ${GATEWAY_INPUT_DIRS_SPACE}
+
 echo "Generating deepcopy"
 $GOTOOL sigs.k8s.io/controller-tools/cmd/controller-gen \
   object:headerFile=${SCRIPT_ROOT}/hack/boilerplate/boilerplate.generatego.txt \
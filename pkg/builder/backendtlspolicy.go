@@ -0,0 +1,111 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builder
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// BackendTLSPolicyBuilder builds BackendTLSPolicies.
+type BackendTLSPolicyBuilder struct {
+	policy     gatewayv1.BackendTLSPolicy
+	controller gatewayv1.GatewayController
+	ancestors  []*gatewayv1.Gateway
+}
+
+// NewBackendTLSPolicy returns a builder of the BackendTLSPolicy
+// namespace/name.
+func NewBackendTLSPolicy(namespace, name string) *BackendTLSPolicyBuilder {
+	return &BackendTLSPolicyBuilder{policy: gatewayv1.BackendTLSPolicy{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: gatewayv1.GroupVersion.String(),
+			Kind:       "BackendTLSPolicy",
+		},
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+	}}
+}
+
+// TargetService adds the port sectionName of the Service name, or all its
+// ports if empty, to the targets of the BackendTLSPolicy.
+func (b *BackendTLSPolicyBuilder) TargetService(name, sectionName string) *BackendTLSPolicyBuilder {
+	ref := gatewayv1.LocalPolicyTargetReferenceWithSectionName{
+		LocalPolicyTargetReference: gatewayv1.LocalPolicyTargetReference{
+			Group: "",
+			Kind:  "Service",
+			Name:  gatewayv1.ObjectName(name),
+		},
+	}
+	if sectionName != "" {
+		ref.SectionName = ptr.To(gatewayv1.SectionName(sectionName))
+	}
+	b.policy.Spec.TargetRefs = append(b.policy.Spec.TargetRefs, ref)
+	return b
+}
+
+// Hostname sets the hostname the certificates of the targets are validated
+// against, and sent with SNI.
+func (b *BackendTLSPolicyBuilder) Hostname(hostname string) *BackendTLSPolicyBuilder {
+	b.policy.Spec.Validation.Hostname = gatewayv1.PreciseHostname(hostname)
+	return b
+}
+
+// CACertificateConfigMaps adds the ConfigMaps names of the namespace of the
+// BackendTLSPolicy to the CA certificates the certificates of the targets
+// are validated with.
+func (b *BackendTLSPolicyBuilder) CACertificateConfigMaps(names ...string) *BackendTLSPolicyBuilder {
+	for _, name := range names {
+		b.policy.Spec.Validation.CACertificateRefs = append(b.policy.Spec.Validation.CACertificateRefs, gatewayv1.LocalObjectReference{
+			Group: "",
+			Kind:  "ConfigMap",
+			Name:  gatewayv1.ObjectName(name),
+		})
+	}
+	return b
+}
+
+// WellKnownCACertificates validates the certificates of the targets with the
+// well-known CA certificates wellKnown.
+func (b *BackendTLSPolicyBuilder) WellKnownCACertificates(wellKnown gatewayv1.WellKnownCACertificatesType) *BackendTLSPolicyBuilder {
+	b.policy.Spec.Validation.WellKnownCACertificates = &wellKnown
+	return b
+}
+
+// Accepted sets the status of the BackendTLSPolicy for the Gateways
+// ancestors, accepted by controller with its references resolved.
+func (b *BackendTLSPolicyBuilder) Accepted(controller gatewayv1.GatewayController, ancestors ...*gatewayv1.Gateway) *BackendTLSPolicyBuilder {
+	b.controller = controller
+	b.ancestors = ancestors
+	return b
+}
+
+// Build returns the BackendTLSPolicy.
+func (b *BackendTLSPolicyBuilder) Build() *gatewayv1.BackendTLSPolicy {
+	policy := b.policy.DeepCopy()
+	for _, gw := range b.ancestors {
+		status := gatewayv1.PolicyAncestorStatus{
+			AncestorRef:    parentRef(policy.Namespace, gw, ""),
+			ControllerName: b.controller,
+		}
+		setTrue(&status.Conditions, gatewayv1.PolicyConditionAccepted, policy.Generation)
+		setTrue(&status.Conditions, gatewayv1.BackendTLSPolicyConditionResolvedRefs, policy.Generation)
+		policy.Status.Ancestors = append(policy.Status.Ancestors, status)
+	}
+	return policy
+}
@@ -0,0 +1,78 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package builder builds Gateway API objects fluently, e.g. in tests and
+// controllers, instead of nesting their structs and pointers:
+//
+//	gw := builder.NewGateway("infra", "gateway", "example").
+//		HTTPSListener("https", 443, "example-com-cert").
+//		Programmed("10.0.0.1").
+//		Build()
+//	route := builder.NewHTTPRoute("app", "route").
+//		AttachTo(gw, "https").
+//		Rule().MatchPathPrefix("/api").Backend("api", 8080, 10).
+//		Accepted("example.com/gateway-controller").
+//		Build()
+//
+// Unlike the applyconfigurations, the builders set the values the CRDs
+// default, such as the kind of parent and backend references, so that the
+// objects built are equal to the ones read back from the API server. The
+// builders also build the status set by implementations, with every
+// condition true.
+//
+// Build returns a new object on every call, and the builders can be reused
+// to build variants of an object.
+package builder
+
+import (
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// parentRef returns a reference to the section sectionName of gw, or to gw
+// if empty, from an object in namespace.
+func parentRef(namespace string, gw *gatewayv1.Gateway, sectionName string) gatewayv1.ParentReference {
+	ref := gatewayv1.ParentReference{
+		Group: ptr.To(gatewayv1.Group(gatewayv1.GroupName)),
+		Kind:  ptr.To(gatewayv1.Kind("Gateway")),
+		Name:  gatewayv1.ObjectName(gw.Name),
+	}
+	if gw.Namespace != namespace {
+		ref.Namespace = ptr.To(gatewayv1.Namespace(gw.Namespace))
+	}
+	if sectionName != "" {
+		ref.SectionName = ptr.To(gatewayv1.SectionName(sectionName))
+	}
+	return ref
+}
+
+// setTrue sets the condition conditionType of conditions to true, with the
+// reason of the same name. The transition time is truncated to seconds, as
+// when serialized.
+func setTrue[T ~string](conditions *[]metav1.Condition, conditionType T, generation int64) {
+	meta.SetStatusCondition(conditions, metav1.Condition{
+		Type:               string(conditionType),
+		Status:             metav1.ConditionTrue,
+		Reason:             string(conditionType),
+		ObservedGeneration: generation,
+		LastTransitionTime: metav1.NewTime(time.Now().Truncate(time.Second)),
+	})
+}
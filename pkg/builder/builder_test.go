@@ -0,0 +1,67 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builder_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/pkg/builder"
+)
+
+func TestHTTPRoute(t *testing.T) {
+	gw := builder.NewGateway("infra", "gateway", "example").HTTPSListener("https", 443, "cert").Build()
+	route := builder.NewHTTPRoute("app", "route").
+		AttachTo(gw, "https").
+		Rule().MatchPathPrefix("/api").MatchHeader("version", "2").Backend("svc", 8080, 10).Backend("canary", 8080, 1).
+		Rule().Name("static").MatchPath("/index.html").MatchMethod(gatewayv1.HTTPMethodGet).Backend("static", 80, 1).
+		Build()
+
+	require.Equal(t, []gatewayv1.ParentReference{{
+		Group:       ptr.To(gatewayv1.Group(gatewayv1.GroupName)),
+		Kind:        ptr.To(gatewayv1.Kind("Gateway")),
+		Namespace:   ptr.To(gatewayv1.Namespace("infra")),
+		Name:        "gateway",
+		SectionName: ptr.To(gatewayv1.SectionName("https")),
+	}}, route.Spec.ParentRefs)
+	require.Len(t, route.Spec.Rules, 2)
+	assert.Equal(t, []gatewayv1.HTTPRouteMatch{{
+		Path: &gatewayv1.HTTPPathMatch{Type: ptr.To(gatewayv1.PathMatchPathPrefix), Value: ptr.To("/api")},
+		Headers: []gatewayv1.HTTPHeaderMatch{{
+			Type:  ptr.To(gatewayv1.HeaderMatchExact),
+			Name:  "version",
+			Value: "2",
+		}},
+	}}, route.Spec.Rules[0].Matches)
+	assert.Len(t, route.Spec.Rules[0].BackendRefs, 2)
+	assert.Equal(t, ptr.To(int32(10)), route.Spec.Rules[0].BackendRefs[0].Weight)
+	assert.Equal(t, ptr.To(gatewayv1.SectionName("static")), route.Spec.Rules[1].Name)
+	assert.Equal(t, ptr.To(gatewayv1.HTTPMethodGet), route.Spec.Rules[1].Matches[0].Method)
+	assert.Empty(t, route.Status.Parents)
+}
+
+func TestBuildersReuse(t *testing.T) {
+	b := builder.NewHTTPRoute("default", "route")
+	first := b.Build()
+	b.Hostnames("example.com")
+	assert.Empty(t, first.Spec.Hostnames)
+	assert.Equal(t, []gatewayv1.Hostname{"example.com"}, b.Build().Spec.Hostnames)
+}
@@ -0,0 +1,171 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builder
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// GatewayBuilder builds Gateways.
+type GatewayBuilder struct {
+	gw             gatewayv1.Gateway
+	programmed     bool
+	attachedRoutes map[gatewayv1.SectionName]int32
+}
+
+// NewGateway returns a builder of the Gateway namespace/name of the
+// GatewayClass className.
+func NewGateway(namespace, name, className string) *GatewayBuilder {
+	return &GatewayBuilder{
+		gw: gatewayv1.Gateway{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: gatewayv1.GroupVersion.String(),
+				Kind:       "Gateway",
+			},
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+			Spec:       gatewayv1.GatewaySpec{GatewayClassName: gatewayv1.ObjectName(className)},
+		},
+		attachedRoutes: map[gatewayv1.SectionName]int32{},
+	}
+}
+
+// Listener adds a listener of protocol on port, allowing the routes of the
+// namespace of the Gateway.
+func (b *GatewayBuilder) Listener(name string, protocol gatewayv1.ProtocolType, port int32) *GatewayBuilder {
+	b.gw.Spec.Listeners = append(b.gw.Spec.Listeners, gatewayv1.Listener{
+		Name:     gatewayv1.SectionName(name),
+		Port:     gatewayv1.PortNumber(port),
+		Protocol: protocol,
+		AllowedRoutes: &gatewayv1.AllowedRoutes{
+			Namespaces: &gatewayv1.RouteNamespaces{From: ptr.To(gatewayv1.NamespacesFromSame)},
+		},
+	})
+	return b
+}
+
+// HTTPListener adds an HTTP listener on port.
+func (b *GatewayBuilder) HTTPListener(name string, port int32) *GatewayBuilder {
+	return b.Listener(name, gatewayv1.HTTPProtocolType, port)
+}
+
+// HTTPSListener adds an HTTPS listener on port, terminating TLS with the
+// certificates of the Secrets certificateSecrets of the namespace of the
+// Gateway.
+func (b *GatewayBuilder) HTTPSListener(name string, port int32, certificateSecrets ...string) *GatewayBuilder {
+	b.Listener(name, gatewayv1.HTTPSProtocolType, port)
+	tls := &gatewayv1.ListenerTLSConfig{Mode: ptr.To(gatewayv1.TLSModeTerminate)}
+	for _, secret := range certificateSecrets {
+		tls.CertificateRefs = append(tls.CertificateRefs, gatewayv1.SecretObjectReference{
+			Group: ptr.To(gatewayv1.Group("")),
+			Kind:  ptr.To(gatewayv1.Kind("Secret")),
+			Name:  gatewayv1.ObjectName(secret),
+		})
+	}
+	b.lastListener().TLS = tls
+	return b
+}
+
+// ListenerHostname sets the hostname of the last listener added. It panics if
+// no listener was added.
+func (b *GatewayBuilder) ListenerHostname(hostname string) *GatewayBuilder {
+	b.lastListener().Hostname = ptr.To(gatewayv1.Hostname(hostname))
+	return b
+}
+
+// AllowRoutesFrom sets the namespaces of the routes the last listener added
+// allows. It panics if no listener was added.
+func (b *GatewayBuilder) AllowRoutesFrom(from gatewayv1.FromNamespaces) *GatewayBuilder {
+	b.lastListener().AllowedRoutes.Namespaces.From = &from
+	return b
+}
+
+func (b *GatewayBuilder) lastListener() *gatewayv1.Listener {
+	if len(b.gw.Spec.Listeners) == 0 {
+		panic("builder: no listener added to the Gateway")
+	}
+	return &b.gw.Spec.Listeners[len(b.gw.Spec.Listeners)-1]
+}
+
+// Programmed sets the Accepted and Programmed conditions of the Gateway
+// status, with addresses, and the status of its listeners.
+func (b *GatewayBuilder) Programmed(addresses ...string) *GatewayBuilder {
+	b.programmed = true
+	b.gw.Status.Addresses = nil
+	for _, address := range addresses {
+		b.gw.Status.Addresses = append(b.gw.Status.Addresses, gatewayv1.GatewayStatusAddress{
+			Type:  ptr.To(gatewayv1.IPAddressType),
+			Value: address,
+		})
+	}
+	return b
+}
+
+// AttachedRoutes sets the number of routes attached to the listener name in
+// its status.
+func (b *GatewayBuilder) AttachedRoutes(name string, attachedRoutes int32) *GatewayBuilder {
+	b.attachedRoutes[gatewayv1.SectionName(name)] = attachedRoutes
+	return b
+}
+
+// Build returns the Gateway.
+func (b *GatewayBuilder) Build() *gatewayv1.Gateway {
+	gw := b.gw.DeepCopy()
+	if !b.programmed {
+		return gw
+	}
+	setTrue(&gw.Status.Conditions, gatewayv1.GatewayConditionAccepted, gw.Generation)
+	setTrue(&gw.Status.Conditions, gatewayv1.GatewayConditionProgrammed, gw.Generation)
+	for _, l := range gw.Spec.Listeners {
+		status := gatewayv1.ListenerStatus{
+			Name:           l.Name,
+			SupportedKinds: supportedKinds(l.Protocol),
+			AttachedRoutes: b.attachedRoutes[l.Name],
+		}
+		setTrue(&status.Conditions, gatewayv1.ListenerConditionAccepted, gw.Generation)
+		setTrue(&status.Conditions, gatewayv1.ListenerConditionProgrammed, gw.Generation)
+		setTrue(&status.Conditions, gatewayv1.ListenerConditionResolvedRefs, gw.Generation)
+		gw.Status.Listeners = append(gw.Status.Listeners, status)
+	}
+	return gw
+}
+
+// supportedKinds returns the kinds of the routes supported by the listeners
+// of protocol.
+func supportedKinds(protocol gatewayv1.ProtocolType) []gatewayv1.RouteGroupKind {
+	var kinds []gatewayv1.Kind
+	switch protocol {
+	case gatewayv1.HTTPProtocolType, gatewayv1.HTTPSProtocolType:
+		kinds = []gatewayv1.Kind{"HTTPRoute", "GRPCRoute"}
+	case gatewayv1.TLSProtocolType:
+		kinds = []gatewayv1.Kind{"TLSRoute"}
+	case gatewayv1.TCPProtocolType:
+		kinds = []gatewayv1.Kind{"TCPRoute"}
+	case gatewayv1.UDPProtocolType:
+		kinds = []gatewayv1.Kind{"UDPRoute"}
+	}
+	groupKinds := []gatewayv1.RouteGroupKind{}
+	for _, kind := range kinds {
+		groupKinds = append(groupKinds, gatewayv1.RouteGroupKind{
+			Group: ptr.To(gatewayv1.Group(gatewayv1.GroupName)),
+			Kind:  kind,
+		})
+	}
+	return groupKinds
+}
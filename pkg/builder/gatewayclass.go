@@ -0,0 +1,73 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builder
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/pkg/features"
+)
+
+// GatewayClassBuilder builds GatewayClasses.
+type GatewayClassBuilder struct {
+	gwc      gatewayv1.GatewayClass
+	accepted bool
+}
+
+// NewGatewayClass returns a builder of the GatewayClass name of the
+// controller controllerName.
+func NewGatewayClass(name string, controllerName gatewayv1.GatewayController) *GatewayClassBuilder {
+	return &GatewayClassBuilder{gwc: gatewayv1.GatewayClass{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: gatewayv1.GroupVersion.String(),
+			Kind:       "GatewayClass",
+		},
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec:       gatewayv1.GatewayClassSpec{ControllerName: controllerName},
+	}}
+}
+
+// Description sets the description of the GatewayClass.
+func (b *GatewayClassBuilder) Description(description string) *GatewayClassBuilder {
+	b.gwc.Spec.Description = &description
+	return b
+}
+
+// Accepted sets the Accepted condition of the GatewayClass status.
+func (b *GatewayClassBuilder) Accepted() *GatewayClassBuilder {
+	b.accepted = true
+	return b
+}
+
+// SupportedFeatures adds feats to the supported features of the GatewayClass
+// status.
+func (b *GatewayClassBuilder) SupportedFeatures(feats ...features.FeatureName) *GatewayClassBuilder {
+	for _, feat := range feats {
+		b.gwc.Status.SupportedFeatures = append(b.gwc.Status.SupportedFeatures, gatewayv1.SupportedFeature{Name: gatewayv1.FeatureName(feat)})
+	}
+	return b
+}
+
+// Build returns the GatewayClass.
+func (b *GatewayClassBuilder) Build() *gatewayv1.GatewayClass {
+	gwc := b.gwc.DeepCopy()
+	if b.accepted {
+		setTrue(&gwc.Status.Conditions, gatewayv1.GatewayClassConditionStatusAccepted, gwc.Generation)
+	}
+	return gwc
+}
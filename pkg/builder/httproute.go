@@ -0,0 +1,206 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builder
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// HTTPRouteBuilder builds HTTPRoutes.
+type HTTPRouteBuilder struct {
+	route      gatewayv1.HTTPRoute
+	controller gatewayv1.GatewayController
+}
+
+// NewHTTPRoute returns a builder of the HTTPRoute namespace/name.
+func NewHTTPRoute(namespace, name string) *HTTPRouteBuilder {
+	return &HTTPRouteBuilder{route: gatewayv1.HTTPRoute{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: gatewayv1.GroupVersion.String(),
+			Kind:       "HTTPRoute",
+		},
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+	}}
+}
+
+// AttachTo attaches the HTTPRoute to the listener sectionName of gw, or to
+// all its listeners if empty.
+func (b *HTTPRouteBuilder) AttachTo(gw *gatewayv1.Gateway, sectionName string) *HTTPRouteBuilder {
+	b.route.Spec.ParentRefs = append(b.route.Spec.ParentRefs, parentRef(b.route.Namespace, gw, sectionName))
+	return b
+}
+
+// Hostnames adds hostnames to the hostnames of the HTTPRoute.
+func (b *HTTPRouteBuilder) Hostnames(hostnames ...string) *HTTPRouteBuilder {
+	for _, hostname := range hostnames {
+		b.route.Spec.Hostnames = append(b.route.Spec.Hostnames, gatewayv1.Hostname(hostname))
+	}
+	return b
+}
+
+// Rule adds a rule to the HTTPRoute, and returns its builder. A rule without
+// matches matches all the requests.
+func (b *HTTPRouteBuilder) Rule() *HTTPRouteRuleBuilder {
+	b.route.Spec.Rules = append(b.route.Spec.Rules, gatewayv1.HTTPRouteRule{})
+	return &HTTPRouteRuleBuilder{HTTPRouteBuilder: b, index: len(b.route.Spec.Rules) - 1}
+}
+
+// Accepted sets the status of the HTTPRoute for every parent, accepted by
+// controller with its references resolved.
+func (b *HTTPRouteBuilder) Accepted(controller gatewayv1.GatewayController) *HTTPRouteBuilder {
+	b.controller = controller
+	return b
+}
+
+// Build returns the HTTPRoute.
+func (b *HTTPRouteBuilder) Build() *gatewayv1.HTTPRoute {
+	route := b.route.DeepCopy()
+	if len(route.Spec.Rules) == 0 {
+		route.Spec.Rules = []gatewayv1.HTTPRouteRule{{}}
+	}
+	for i := range route.Spec.Rules {
+		if len(route.Spec.Rules[i].Matches) == 0 {
+			route.Spec.Rules[i].Matches = []gatewayv1.HTTPRouteMatch{{Path: pathPrefix("/")}}
+		}
+	}
+	if b.controller != "" {
+		route.Status.Parents = routeParentStatuses(route.Spec.ParentRefs, b.controller, route.Generation)
+	}
+	return route
+}
+
+// HTTPRouteRuleBuilder builds the rules of HTTPRoutes. The methods of the
+// HTTPRouteBuilder can be called on it to continue building the HTTPRoute.
+type HTTPRouteRuleBuilder struct {
+	*HTTPRouteBuilder
+	index int
+}
+
+func (b *HTTPRouteRuleBuilder) rule() *gatewayv1.HTTPRouteRule {
+	return &b.route.Spec.Rules[b.index]
+}
+
+// Name sets the name of the rule.
+func (b *HTTPRouteRuleBuilder) Name(name string) *HTTPRouteRuleBuilder {
+	b.rule().Name = ptr.To(gatewayv1.SectionName(name))
+	return b
+}
+
+// MatchPathPrefix adds a match of the requests whose path starts with prefix
+// to the rule.
+func (b *HTTPRouteRuleBuilder) MatchPathPrefix(prefix string) *HTTPRouteRuleBuilder {
+	b.rule().Matches = append(b.rule().Matches, gatewayv1.HTTPRouteMatch{Path: pathPrefix(prefix)})
+	return b
+}
+
+// MatchPath adds a match of the requests whose path is path to the rule.
+func (b *HTTPRouteRuleBuilder) MatchPath(path string) *HTTPRouteRuleBuilder {
+	b.rule().Matches = append(b.rule().Matches, gatewayv1.HTTPRouteMatch{Path: &gatewayv1.HTTPPathMatch{
+		Type:  ptr.To(gatewayv1.PathMatchExact),
+		Value: &path,
+	}})
+	return b
+}
+
+// MatchHeader requires the header name to be value in the last match of the
+// rule, or in a new match of all paths if there is none.
+func (b *HTTPRouteRuleBuilder) MatchHeader(name, value string) *HTTPRouteRuleBuilder {
+	match := b.lastMatch()
+	match.Headers = append(match.Headers, gatewayv1.HTTPHeaderMatch{
+		Type:  ptr.To(gatewayv1.HeaderMatchExact),
+		Name:  gatewayv1.HTTPHeaderName(name),
+		Value: value,
+	})
+	return b
+}
+
+// MatchQueryParam requires the query parameter name to be value in the last
+// match of the rule, or in a new match of all paths if there is none.
+func (b *HTTPRouteRuleBuilder) MatchQueryParam(name, value string) *HTTPRouteRuleBuilder {
+	match := b.lastMatch()
+	match.QueryParams = append(match.QueryParams, gatewayv1.HTTPQueryParamMatch{
+		Type:  ptr.To(gatewayv1.QueryParamMatchExact),
+		Name:  gatewayv1.HTTPHeaderName(name),
+		Value: value,
+	})
+	return b
+}
+
+// MatchMethod requires the method of the requests to be method in the last
+// match of the rule, or in a new match of all paths if there is none.
+func (b *HTTPRouteRuleBuilder) MatchMethod(method gatewayv1.HTTPMethod) *HTTPRouteRuleBuilder {
+	b.lastMatch().Method = &method
+	return b
+}
+
+func (b *HTTPRouteRuleBuilder) lastMatch() *gatewayv1.HTTPRouteMatch {
+	rule := b.rule()
+	if len(rule.Matches) == 0 {
+		rule.Matches = append(rule.Matches, gatewayv1.HTTPRouteMatch{Path: pathPrefix("/")})
+	}
+	return &rule.Matches[len(rule.Matches)-1]
+}
+
+// Filter adds filter to the filters of the rule.
+func (b *HTTPRouteRuleBuilder) Filter(filter gatewayv1.HTTPRouteFilter) *HTTPRouteRuleBuilder {
+	b.rule().Filters = append(b.rule().Filters, filter)
+	return b
+}
+
+// Backend adds the port of the Service name of the namespace of the HTTPRoute
+// to the backends of the rule, with weight.
+func (b *HTTPRouteRuleBuilder) Backend(name string, port, weight int32) *HTTPRouteRuleBuilder {
+	b.rule().BackendRefs = append(b.rule().BackendRefs, gatewayv1.HTTPBackendRef{BackendRef: serviceBackendRef(name, port, weight)})
+	return b
+}
+
+// pathPrefix returns a match of the paths starting with prefix.
+func pathPrefix(prefix string) *gatewayv1.HTTPPathMatch {
+	return &gatewayv1.HTTPPathMatch{
+		Type:  ptr.To(gatewayv1.PathMatchPathPrefix),
+		Value: &prefix,
+	}
+}
+
+// serviceBackendRef returns a reference to the port of the Service name.
+func serviceBackendRef(name string, port, weight int32) gatewayv1.BackendRef {
+	return gatewayv1.BackendRef{
+		BackendObjectReference: gatewayv1.BackendObjectReference{
+			Group: ptr.To(gatewayv1.Group("")),
+			Kind:  ptr.To(gatewayv1.Kind("Service")),
+			Name:  gatewayv1.ObjectName(name),
+			Port:  ptr.To(gatewayv1.PortNumber(port)),
+		},
+		Weight: &weight,
+	}
+}
+
+// routeParentStatuses returns the status of the parents of a route, accepted
+// by controller with its references resolved.
+func routeParentStatuses(parentRefs []gatewayv1.ParentReference, controller gatewayv1.GatewayController, generation int64) []gatewayv1.RouteParentStatus {
+	statuses := []gatewayv1.RouteParentStatus{}
+	for _, ref := range parentRefs {
+		status := gatewayv1.RouteParentStatus{ParentRef: ref, ControllerName: controller}
+		setTrue(&status.Conditions, gatewayv1.RouteConditionAccepted, generation)
+		setTrue(&status.Conditions, gatewayv1.RouteConditionResolvedRefs, generation)
+		statuses = append(statuses, status)
+	}
+	return statuses
+}
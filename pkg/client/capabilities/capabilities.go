@@ -0,0 +1,275 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package capabilities inspects the Gateway API CRDs installed in a cluster,
+// so that controllers can enable optional reconcilers, and tests can skip
+// checks, depending on what the installed bundle supports: which kinds and
+// versions are served, which fields and enum values their schemas allow, and
+// which features GatewayClasses advertise.
+package capabilities
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	apisxv1alpha1 "sigs.k8s.io/gateway-api/apisx/v1alpha1"
+	"sigs.k8s.io/gateway-api/pkg/consts"
+	"sigs.k8s.io/gateway-api/pkg/features"
+)
+
+// Groups are the API groups of the Gateway API CRDs.
+var Groups = sets.New(gatewayv1.GroupName, apisxv1alpha1.GroupName)
+
+// Bundle describes the installed Gateway API CRDs. The zero value describes
+// a cluster without any Gateway API CRDs installed.
+type Bundle struct {
+	crds map[schema.GroupKind]*apiextensionsv1.CustomResourceDefinition
+}
+
+// NewBundle returns a Bundle describing the given CRDs. CRDs of other API
+// groups than the Gateway API ones are ignored.
+func NewBundle(crds ...*apiextensionsv1.CustomResourceDefinition) *Bundle {
+	b := &Bundle{crds: map[schema.GroupKind]*apiextensionsv1.CustomResourceDefinition{}}
+	for _, crd := range crds {
+		if Groups.Has(crd.Spec.Group) {
+			b.crds[schema.GroupKind{Group: crd.Spec.Group, Kind: crd.Spec.Names.Kind}] = crd
+		}
+	}
+	return b
+}
+
+// Discover lists the CRDs installed in the cluster and returns a Bundle
+// describing the Gateway API ones. The scheme of the client must include the
+// apiextensions.k8s.io/v1 types.
+func Discover(ctx context.Context, c client.Reader) (*Bundle, error) {
+	list := &apiextensionsv1.CustomResourceDefinitionList{}
+	if err := c.List(ctx, list); err != nil {
+		return nil, err
+	}
+	crds := make([]*apiextensionsv1.CustomResourceDefinition, len(list.Items))
+	for i := range list.Items {
+		crds[i] = &list.Items[i]
+	}
+	return NewBundle(crds...), nil
+}
+
+// CRDs returns the installed Gateway API CRDs, sorted by name.
+func (b *Bundle) CRDs() []*apiextensionsv1.CustomResourceDefinition {
+	res := make([]*apiextensionsv1.CustomResourceDefinition, 0, len(b.crds))
+	for _, crd := range b.crds {
+		res = append(res, crd)
+	}
+	slices.SortFunc(res, func(a, b *apiextensionsv1.CustomResourceDefinition) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return res
+}
+
+// CRD returns the installed CRD defining the given kind, if any.
+func (b *Bundle) CRD(gk schema.GroupKind) (*apiextensionsv1.CustomResourceDefinition, bool) {
+	crd, ok := b.crds[gk]
+	return crd, ok
+}
+
+// Installed returns whether a CRD defining the given kind is installed,
+// whether it serves any version or not.
+func (b *Bundle) Installed(gk schema.GroupKind) bool {
+	_, ok := b.crds[gk]
+	return ok
+}
+
+// Served returns whether the given kind is served in the given version.
+func (b *Bundle) Served(gvk schema.GroupVersionKind) bool {
+	v := b.version(gvk)
+	return v != nil && v.Served
+}
+
+// ServedVersions returns the versions in which the given kind is served, in
+// the order of the CRD.
+func (b *Bundle) ServedVersions(gk schema.GroupKind) []string {
+	var res []string
+	if crd, ok := b.crds[gk]; ok {
+		for _, v := range crd.Spec.Versions {
+			if v.Served {
+				res = append(res, v.Name)
+			}
+		}
+	}
+	return res
+}
+
+// StorageVersion returns the version in which the given kind is stored, or an
+// empty string if it is not installed.
+func (b *Bundle) StorageVersion(gk schema.GroupKind) string {
+	if crd, ok := b.crds[gk]; ok {
+		for _, v := range crd.Spec.Versions {
+			if v.Storage {
+				return v.Name
+			}
+		}
+	}
+	return ""
+}
+
+// Namespaced returns whether the given kind is installed and namespaced.
+func (b *Bundle) Namespaced(gk schema.GroupKind) bool {
+	crd, ok := b.crds[gk]
+	return ok && crd.Spec.Scope == apiextensionsv1.NamespaceScoped
+}
+
+// BundleVersion returns the bundle version shared by all the installed CRDs,
+// or an empty string if they have none or different ones.
+func (b *Bundle) BundleVersion() string {
+	return b.sharedAnnotation(consts.BundleVersionAnnotation)
+}
+
+// Channel returns the channel shared by all the installed CRDs, or an empty
+// string if they have none or different ones.
+func (b *Bundle) Channel() string {
+	return b.sharedAnnotation(consts.ChannelAnnotation)
+}
+
+func (b *Bundle) sharedAnnotation(key string) string {
+	values := sets.New[string]()
+	for _, crd := range b.crds {
+		values.Insert(crd.Annotations[key])
+	}
+	if values.Len() != 1 {
+		return ""
+	}
+	return values.UnsortedList()[0]
+}
+
+// Schema returns the OpenAPI schema of the given kind in the given version, or
+// nil if the version is not served or has no schema.
+func (b *Bundle) Schema(gvk schema.GroupVersionKind) *apiextensionsv1.JSONSchemaProps {
+	v := b.version(gvk)
+	if v == nil || !v.Served || v.Schema == nil {
+		return nil
+	}
+	return v.Schema.OpenAPIV3Schema
+}
+
+// HasField returns whether the schema of the given kind in the given version
+// has the field at the given dot-separated path, such as
+// "spec.rules.filters.externalAuth". Lists and maps are traversed implicitly,
+// so the path doesn't mention their items.
+func (b *Bundle) HasField(gvk schema.GroupVersionKind, path string) bool {
+	return b.field(gvk, path) != nil
+}
+
+// EnumValues returns the values allowed by the enum of the field at the given
+// path in the schema of the given kind, as for HasField. It returns nil if the
+// field doesn't exist or isn't an enum of strings.
+func (b *Bundle) EnumValues(gvk schema.GroupVersionKind, path string) []string {
+	s := b.field(gvk, path)
+	if s == nil {
+		return nil
+	}
+	var res []string
+	for _, e := range s.Enum {
+		// enum values of strings are JSON strings
+		if raw := strings.TrimSpace(string(e.Raw)); len(raw) >= 2 && raw[0] == '"' {
+			res = append(res, strings.Trim(raw, `"`))
+		}
+	}
+	return res
+}
+
+// HasEnumValue returns whether the enum of the field at the given path in the
+// schema of the given kind, as for HasField, allows the given value.
+func (b *Bundle) HasEnumValue(gvk schema.GroupVersionKind, path, value string) bool {
+	return slices.Contains(b.EnumValues(gvk, path), value)
+}
+
+func (b *Bundle) version(gvk schema.GroupVersionKind) *apiextensionsv1.CustomResourceDefinitionVersion {
+	crd, ok := b.crds[gvk.GroupKind()]
+	if !ok {
+		return nil
+	}
+	idx := slices.IndexFunc(crd.Spec.Versions, func(v apiextensionsv1.CustomResourceDefinitionVersion) bool {
+		return v.Name == gvk.Version
+	})
+	if idx == -1 {
+		return nil
+	}
+	return &crd.Spec.Versions[idx]
+}
+
+func (b *Bundle) field(gvk schema.GroupVersionKind, path string) *apiextensionsv1.JSONSchemaProps {
+	s := items(b.Schema(gvk))
+	for name := range strings.SplitSeq(path, ".") {
+		if s == nil {
+			return nil
+		}
+		prop, ok := s.Properties[name]
+		if !ok {
+			return nil
+		}
+		s = items(&prop)
+	}
+	return s
+}
+
+// items returns the schema of the items of the given schema, recursively, if
+// it is a list or a map, or the schema itself otherwise.
+func items(s *apiextensionsv1.JSONSchemaProps) *apiextensionsv1.JSONSchemaProps {
+	for s != nil {
+		switch {
+		case s.Items != nil && s.Items.Schema != nil:
+			s = s.Items.Schema
+		case s.AdditionalProperties != nil && s.AdditionalProperties.Schema != nil:
+			s = s.AdditionalProperties.Schema
+		default:
+			return s
+		}
+	}
+	return nil
+}
+
+// GatewayClassSupportedFeatures returns the features the given GatewayClass
+// advertises in its status.
+func GatewayClassSupportedFeatures(gwc *gatewayv1.GatewayClass) sets.Set[features.FeatureName] {
+	res := sets.New[features.FeatureName]()
+	for _, f := range gwc.Status.SupportedFeatures {
+		res.Insert(features.FeatureName(f.Name))
+	}
+	return res
+}
+
+// GatewayClassesSupporting returns the names, sorted, of the GatewayClasses
+// advertising all the given features in their status.
+func GatewayClassesSupporting(ctx context.Context, c client.Reader, feats ...features.FeatureName) ([]string, error) {
+	list := &gatewayv1.GatewayClassList{}
+	if err := c.List(ctx, list); err != nil {
+		return nil, err
+	}
+	var res []string
+	for i := range list.Items {
+		if GatewayClassSupportedFeatures(&list.Items[i]).HasAll(feats...) {
+			res = append(res, list.Items[i].Name)
+		}
+	}
+	slices.Sort(res)
+	return res, nil
+}
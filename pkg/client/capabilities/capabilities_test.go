@@ -0,0 +1,192 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package capabilities_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"io/fs"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/config/crd"
+	"sigs.k8s.io/gateway-api/pkg/client/capabilities"
+	"sigs.k8s.io/gateway-api/pkg/consts"
+	"sigs.k8s.io/gateway-api/pkg/features"
+)
+
+var (
+	httpRouteV1           = schema.GroupVersionKind{Group: gatewayv1.GroupName, Version: "v1", Kind: "HTTPRoute"}
+	listenerSetV1         = schema.GroupVersionKind{Group: gatewayv1.GroupName, Version: "v1", Kind: "ListenerSet"}
+	xBackendTrafficPolicy = schema.GroupKind{Group: "gateway.networking.x-k8s.io", Kind: "XBackendTrafficPolicy"}
+)
+
+// loadCRDs returns the bundled CRDs of channel.
+func loadCRDs(t *testing.T, channel string) []*apiextensionsv1.CustomResourceDefinition {
+	t.Helper()
+	files, err := fs.Glob(crd.Manifests, path.Join(channel, "*.yaml"))
+	require.NoError(t, err)
+	require.NotEmpty(t, files)
+
+	var crds []*apiextensionsv1.CustomResourceDefinition
+	for _, file := range files {
+		data, readErr := fs.ReadFile(crd.Manifests, file)
+		require.NoError(t, readErr)
+		decoder := utilyaml.NewYAMLOrJSONDecoder(bytes.NewReader(data), 4096)
+		for {
+			c := &apiextensionsv1.CustomResourceDefinition{}
+			decodeErr := decoder.Decode(c)
+			if errors.Is(decodeErr, io.EOF) {
+				break
+			}
+			require.NoError(t, decodeErr, file)
+			if c.Kind == "CustomResourceDefinition" {
+				crds = append(crds, c)
+			}
+		}
+	}
+	return crds
+}
+
+func bundle(t *testing.T, channel string) *capabilities.Bundle {
+	t.Helper()
+	return capabilities.NewBundle(loadCRDs(t, channel)...)
+}
+
+func TestBundle(t *testing.T) {
+	standard := bundle(t, "standard")
+	experimental := bundle(t, "experimental")
+
+	for _, b := range []*capabilities.Bundle{standard, experimental} {
+		assert.Equal(t, consts.BundleVersion, b.BundleVersion())
+		assert.True(t, b.Served(listenerSetV1))
+		assert.True(t, b.Namespaced(listenerSetV1.GroupKind()))
+		assert.False(t, b.Namespaced(schema.GroupKind{Group: gatewayv1.GroupName, Kind: "GatewayClass"}))
+		assert.Equal(t, "v1", b.StorageVersion(httpRouteV1.GroupKind()))
+		assert.True(t, b.HasField(httpRouteV1, "spec.rules.matches.path.value"))
+		assert.False(t, b.HasField(httpRouteV1, "spec.rules.unknown"))
+		assert.True(t, b.HasEnumValue(httpRouteV1, "spec.rules.filters.type", "RequestMirror"))
+	}
+
+	assert.Equal(t, "standard", standard.Channel())
+	assert.False(t, standard.Installed(xBackendTrafficPolicy))
+	assert.False(t, standard.HasEnumValue(httpRouteV1, "spec.rules.filters.type", "ExternalAuth"))
+	assert.False(t, standard.HasField(httpRouteV1, "spec.rules.filters.externalAuth"))
+
+	assert.Equal(t, "experimental", experimental.Channel())
+	assert.True(t, experimental.Installed(xBackendTrafficPolicy))
+	assert.True(t, experimental.HasEnumValue(httpRouteV1, "spec.rules.filters.type", "ExternalAuth"))
+	assert.True(t, experimental.HasField(httpRouteV1, "spec.rules.filters.externalAuth"))
+
+	assert.Nil(t, standard.Schema(schema.GroupVersionKind{Group: gatewayv1.GroupName, Version: "v2", Kind: "HTTPRoute"}))
+	assert.Empty(t, standard.EnumValues(httpRouteV1, "spec.rules.matches.path.value"))
+}
+
+func TestBundleMixed(t *testing.T) {
+	b := capabilities.NewBundle(
+		&apiextensionsv1.CustomResourceDefinition{
+			ObjectMeta: metav1.ObjectMeta{Name: "gateways.gateway.networking.k8s.io", Annotations: map[string]string{
+				consts.BundleVersionAnnotation: "v1.0.0",
+				consts.ChannelAnnotation:       "standard",
+			}},
+			Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+				Group: gatewayv1.GroupName,
+				Names: apiextensionsv1.CustomResourceDefinitionNames{Kind: "Gateway"},
+				Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+					{Name: "v1", Served: true, Storage: true},
+					{Name: "v1beta1", Served: false},
+				},
+			},
+		},
+		&apiextensionsv1.CustomResourceDefinition{
+			ObjectMeta: metav1.ObjectMeta{Name: "httproutes.gateway.networking.k8s.io", Annotations: map[string]string{
+				consts.BundleVersionAnnotation: "v1.1.0",
+				consts.ChannelAnnotation:       "standard",
+			}},
+			Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+				Group: gatewayv1.GroupName,
+				Names: apiextensionsv1.CustomResourceDefinitionNames{Kind: "HTTPRoute"},
+			},
+		},
+		&apiextensionsv1.CustomResourceDefinition{
+			ObjectMeta: metav1.ObjectMeta{Name: "foos.example.com"},
+			Spec:       apiextensionsv1.CustomResourceDefinitionSpec{Group: "example.com"},
+		},
+	)
+
+	assert.Empty(t, b.BundleVersion())
+	assert.Equal(t, "standard", b.Channel())
+	assert.Len(t, b.CRDs(), 2)
+	assert.Equal(t, "gateways.gateway.networking.k8s.io", b.CRDs()[0].Name)
+	gateway := schema.GroupKind{Group: gatewayv1.GroupName, Kind: "Gateway"}
+	assert.Equal(t, []string{"v1"}, b.ServedVersions(gateway))
+	assert.False(t, b.Served(gateway.WithVersion("v1beta1")))
+	assert.False(t, b.HasField(gateway.WithVersion("v1"), "spec"))
+	assert.True(t, b.Installed(schema.GroupKind{Group: gatewayv1.GroupName, Kind: "HTTPRoute"}))
+	assert.Empty(t, b.StorageVersion(schema.GroupKind{Group: gatewayv1.GroupName, Kind: "HTTPRoute"}))
+}
+
+func TestDiscover(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, apiextensionsv1.AddToScheme(scheme))
+	require.NoError(t, gatewayv1.Install(scheme))
+
+	crds := loadCRDs(t, "standard")
+	objs := []runtime.Object{
+		&gatewayv1.GatewayClass{
+			ObjectMeta: metav1.ObjectMeta{Name: "b"},
+			Status: gatewayv1.GatewayClassStatus{SupportedFeatures: []gatewayv1.SupportedFeature{
+				{Name: gatewayv1.FeatureName(features.SupportGateway)},
+				{Name: gatewayv1.FeatureName(features.SupportHTTPRoute)},
+			}},
+		},
+		&gatewayv1.GatewayClass{
+			ObjectMeta: metav1.ObjectMeta{Name: "a"},
+			Status: gatewayv1.GatewayClassStatus{SupportedFeatures: []gatewayv1.SupportedFeature{
+				{Name: gatewayv1.FeatureName(features.SupportHTTPRoute)},
+			}},
+		},
+	}
+	for _, c := range crds {
+		objs = append(objs, c)
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(objs...).Build()
+
+	b, err := capabilities.Discover(context.Background(), c)
+	require.NoError(t, err)
+	assert.Equal(t, "standard", b.Channel())
+	assert.True(t, b.Served(listenerSetV1))
+
+	names, err := capabilities.GatewayClassesSupporting(context.Background(), c, features.SupportHTTPRoute)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "b"}, names)
+	names, err = capabilities.GatewayClassesSupporting(context.Background(), c, features.SupportGateway, features.SupportHTTPRoute)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"b"}, names)
+}
@@ -0,0 +1,452 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package indexers provides indexes of Gateway API resources by the resources
+// they reference, such as "HTTPRoutes by parent Gateway", so that controllers
+// don't have to implement them. The indexes can be added to the generated
+// informers as cache.Indexers, or registered with a controller-runtime
+// FieldIndexer.
+//
+// Every index function supports all the versions of the kinds it indexes,
+// and returns no keys for other objects, so the same indexes can be added to
+// the informer of any kind.
+package indexers
+
+import (
+	"fmt"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gatewayv1alpha3 "sigs.k8s.io/gateway-api/apis/v1alpha3"
+	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+const (
+	// ParentGatewayIndex indexes Routes and ListenerSets by the namespaced
+	// names, as "namespace/name", of the Gateways they reference as parents.
+	ParentGatewayIndex = "parentGateway"
+
+	// ParentListenerSetIndex indexes Routes by the namespaced names, as
+	// "namespace/name", of the ListenerSets they reference as parents.
+	ParentListenerSetIndex = "parentListenerSet"
+
+	// BackendServiceIndex indexes Routes by the namespaced names, as
+	// "namespace/name", of the Services they reference as backends, including
+	// the backends of their RequestMirror and ExternalAuth filters.
+	BackendServiceIndex = "backendService"
+
+	// CertificateSecretIndex indexes Gateways and ListenerSets by the
+	// namespaced names, as "namespace/name", of the Secrets their listeners
+	// reference as certificates.
+	CertificateSecretIndex = "certificateSecret"
+
+	// FromNamespaceIndex indexes ReferenceGrants by the namespaces they grant
+	// references from.
+	FromNamespaceIndex = "fromNamespace"
+
+	// TargetServiceIndex indexes BackendTLSPolicies by the namespaced names,
+	// as "namespace/name", of the Services they target.
+	TargetServiceIndex = "targetService"
+)
+
+// Indexers returns all the indexes of this package.
+func Indexers() cache.Indexers {
+	return cache.Indexers{
+		ParentGatewayIndex:     ParentGatewayIndexFunc,
+		ParentListenerSetIndex: ParentListenerSetIndexFunc,
+		BackendServiceIndex:    BackendServiceIndexFunc,
+		CertificateSecretIndex: CertificateSecretIndexFunc,
+		FromNamespaceIndex:     FromNamespaceIndexFunc,
+		TargetServiceIndex:     TargetServiceIndexFunc,
+	}
+}
+
+// IndexerFunc adapts an index function of this package to controller-runtime,
+// whose client.IndexerFunc is IndexerFunc[client.Object], e.g.:
+//
+//	mgr.GetFieldIndexer().IndexField(ctx, &gatewayv1.HTTPRoute{}, indexers.ParentGatewayIndex,
+//		indexers.IndexerFunc[client.Object](indexers.ParentGatewayIndexFunc))
+func IndexerFunc[O any](indexFunc cache.IndexFunc) func(obj O) []string {
+	return func(obj O) []string {
+		// The index functions of this package never fail.
+		keys, _ := indexFunc(obj)
+		return keys
+	}
+}
+
+// ByIndex returns the objects of the indexer whose index named indexName
+// contains key.
+func ByIndex[T any](indexer cache.Indexer, indexName, key string) ([]T, error) {
+	objs, err := indexer.ByIndex(indexName, key)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]T, 0, len(objs))
+	for _, obj := range objs {
+		t, ok := obj.(T)
+		if !ok {
+			return nil, fmt.Errorf("unexpected object of type %T in index %s", obj, indexName)
+		}
+		ret = append(ret, t)
+	}
+	return ret, nil
+}
+
+// ByIndexOrScan returns the objects of the indexer whose index named
+// indexName contains key, like ByIndex, or the objects returned by scan when
+// no such index is registered on the indexer. The NewIndexed*Lister listers
+// use it so that the indexes don't have to be added to their informers.
+func ByIndexOrScan[T any](indexer cache.Indexer, indexName, key string, scan func() ([]T, error)) ([]T, error) {
+	if _, ok := indexer.GetIndexers()[indexName]; !ok {
+		return scan()
+	}
+	return ByIndex[T](indexer, indexName, key)
+}
+
+// Filter returns the objects whose keys, as returned by keysFunc, contain
+// key. It is meant for listers of informers the indexes were not added to.
+func Filter[T any](objs []T, keysFunc func(obj any) []string, key string) []T {
+	var ret []T
+	for _, obj := range objs {
+		if slices.Contains(keysFunc(obj), key) {
+			ret = append(ret, obj)
+		}
+	}
+	return ret
+}
+
+// ParentGatewayIndexFunc is the cache.IndexFunc of ParentGatewayIndex.
+func ParentGatewayIndexFunc(obj any) ([]string, error) {
+	return ParentGatewayKeys(obj), nil
+}
+
+// ParentListenerSetIndexFunc is the cache.IndexFunc of
+// ParentListenerSetIndex.
+func ParentListenerSetIndexFunc(obj any) ([]string, error) {
+	return ParentListenerSetKeys(obj), nil
+}
+
+// BackendServiceIndexFunc is the cache.IndexFunc of BackendServiceIndex.
+func BackendServiceIndexFunc(obj any) ([]string, error) {
+	return BackendServiceKeys(obj), nil
+}
+
+// CertificateSecretIndexFunc is the cache.IndexFunc of
+// CertificateSecretIndex.
+func CertificateSecretIndexFunc(obj any) ([]string, error) {
+	return CertificateSecretKeys(obj), nil
+}
+
+// FromNamespaceIndexFunc is the cache.IndexFunc of FromNamespaceIndex.
+func FromNamespaceIndexFunc(obj any) ([]string, error) {
+	return FromNamespaceKeys(obj), nil
+}
+
+// TargetServiceIndexFunc is the cache.IndexFunc of TargetServiceIndex.
+func TargetServiceIndexFunc(obj any) ([]string, error) {
+	return TargetServiceKeys(obj), nil
+}
+
+// ParentGatewayKeys returns the keys of obj in ParentGatewayIndex.
+func ParentGatewayKeys(obj any) []string {
+	if ls, ok := obj.(*gatewayv1.ListenerSet); ok {
+		ref := ls.Spec.ParentRef
+		return parentKeys(ls.Namespace, "Gateway", []gatewayv1.ParentReference{{
+			Group:     ref.Group,
+			Kind:      ref.Kind,
+			Namespace: ref.Namespace,
+			Name:      ref.Name,
+		}})
+	}
+	namespace, refs := routeParentRefs(obj)
+	return parentKeys(namespace, "Gateway", refs)
+}
+
+// ParentListenerSetKeys returns the keys of obj in ParentListenerSetIndex.
+func ParentListenerSetKeys(obj any) []string {
+	namespace, refs := routeParentRefs(obj)
+	return parentKeys(namespace, "ListenerSet", refs)
+}
+
+// BackendServiceKeys returns the keys of obj in BackendServiceIndex.
+func BackendServiceKeys(obj any) []string {
+	switch o := obj.(type) {
+	case *gatewayv1.HTTPRoute:
+		return serviceKeys(o.Namespace, httpRouteBackendRefs(&o.Spec))
+	case *gatewayv1beta1.HTTPRoute:
+		return serviceKeys(o.Namespace, httpRouteBackendRefs(&o.Spec))
+	case *gatewayv1.GRPCRoute:
+		return serviceKeys(o.Namespace, grpcRouteBackendRefs(&o.Spec))
+	case *gatewayv1alpha2.GRPCRoute:
+		return serviceKeys(o.Namespace, grpcRouteBackendRefs(&o.Spec))
+	case *gatewayv1.TCPRoute:
+		return serviceKeys(o.Namespace, ruleBackendRefs(o.Spec.Rules, func(r gatewayv1.TCPRouteRule) []gatewayv1.BackendRef { return r.BackendRefs }))
+	case *gatewayv1alpha2.TCPRoute:
+		return serviceKeys(o.Namespace, ruleBackendRefs(o.Spec.Rules, func(r gatewayv1alpha2.TCPRouteRule) []gatewayv1.BackendRef { return r.BackendRefs }))
+	case *gatewayv1.TLSRoute:
+		return serviceKeys(o.Namespace, ruleBackendRefs(o.Spec.Rules, func(r gatewayv1.TLSRouteRule) []gatewayv1.BackendRef { return r.BackendRefs }))
+	case *gatewayv1alpha2.TLSRoute:
+		return serviceKeys(o.Namespace, ruleBackendRefs(o.Spec.Rules, func(r gatewayv1alpha2.TLSRouteRule) []gatewayv1.BackendRef { return r.BackendRefs }))
+	case *gatewayv1alpha3.TLSRoute:
+		return serviceKeys(o.Namespace, ruleBackendRefs(o.Spec.Rules, func(r gatewayv1.TLSRouteRule) []gatewayv1.BackendRef { return r.BackendRefs }))
+	case *gatewayv1.UDPRoute:
+		return serviceKeys(o.Namespace, ruleBackendRefs(o.Spec.Rules, func(r gatewayv1.UDPRouteRule) []gatewayv1.BackendRef { return r.BackendRefs }))
+	case *gatewayv1alpha2.UDPRoute:
+		return serviceKeys(o.Namespace, ruleBackendRefs(o.Spec.Rules, func(r gatewayv1alpha2.UDPRouteRule) []gatewayv1.BackendRef { return r.BackendRefs }))
+	}
+	return nil
+}
+
+// CertificateSecretKeys returns the keys of obj in CertificateSecretIndex.
+func CertificateSecretKeys(obj any) []string {
+	switch o := obj.(type) {
+	case *gatewayv1.Gateway:
+		return certificateSecretKeys(o.Namespace, gatewayListenersTLS(o.Spec.Listeners)...)
+	case *gatewayv1beta1.Gateway:
+		return certificateSecretKeys(o.Namespace, gatewayListenersTLS(o.Spec.Listeners)...)
+	case *gatewayv1.ListenerSet:
+		tls := make([]*gatewayv1.ListenerTLSConfig, 0, len(o.Spec.Listeners))
+		for _, l := range o.Spec.Listeners {
+			tls = append(tls, l.TLS)
+		}
+		return certificateSecretKeys(o.Namespace, tls...)
+	}
+	return nil
+}
+
+// FromNamespaceKeys returns the keys of obj in FromNamespaceIndex.
+func FromNamespaceKeys(obj any) []string {
+	var from []gatewayv1.ReferenceGrantFrom
+	switch o := obj.(type) {
+	case *gatewayv1.ReferenceGrant:
+		from = o.Spec.From
+	case *gatewayv1beta1.ReferenceGrant:
+		from = o.Spec.From
+	case *gatewayv1alpha2.ReferenceGrant:
+		from = o.Spec.From
+	}
+
+	var keys []string
+	for _, f := range from {
+		keys = appendKey(keys, string(f.Namespace))
+	}
+	return keys
+}
+
+// TargetServiceKeys returns the keys of obj in TargetServiceIndex.
+func TargetServiceKeys(obj any) []string {
+	var (
+		namespace  string
+		targetRefs []gatewayv1.LocalPolicyTargetReferenceWithSectionName
+	)
+	switch o := obj.(type) {
+	case *gatewayv1.BackendTLSPolicy:
+		namespace, targetRefs = o.Namespace, o.Spec.TargetRefs
+	case *gatewayv1alpha3.BackendTLSPolicy:
+		namespace, targetRefs = o.Namespace, o.Spec.TargetRefs
+	}
+
+	var keys []string
+	for _, ref := range targetRefs {
+		if ref.Group == "" && ref.Kind == "Service" {
+			keys = appendKey(keys, namespacedName(namespace, ref.Name))
+		}
+	}
+	return keys
+}
+
+// routeParentRefs returns the namespace and the parentRefs of a Route, or
+// nothing if obj is not a Route.
+func routeParentRefs(obj any) (string, []gatewayv1.ParentReference) {
+	switch o := obj.(type) {
+	case *gatewayv1.HTTPRoute:
+		return o.Namespace, o.Spec.ParentRefs
+	case *gatewayv1beta1.HTTPRoute:
+		return o.Namespace, o.Spec.ParentRefs
+	case *gatewayv1.GRPCRoute:
+		return o.Namespace, o.Spec.ParentRefs
+	case *gatewayv1alpha2.GRPCRoute:
+		return o.Namespace, o.Spec.ParentRefs
+	case *gatewayv1.TCPRoute:
+		return o.Namespace, o.Spec.ParentRefs
+	case *gatewayv1alpha2.TCPRoute:
+		return o.Namespace, o.Spec.ParentRefs
+	case *gatewayv1.TLSRoute:
+		return o.Namespace, o.Spec.ParentRefs
+	case *gatewayv1alpha2.TLSRoute:
+		return o.Namespace, o.Spec.ParentRefs
+	case *gatewayv1alpha3.TLSRoute:
+		return o.Namespace, o.Spec.ParentRefs
+	case *gatewayv1.UDPRoute:
+		return o.Namespace, o.Spec.ParentRefs
+	case *gatewayv1alpha2.UDPRoute:
+		return o.Namespace, o.Spec.ParentRefs
+	}
+	return "", nil
+}
+
+// parentKeys returns the keys of the parents of the given kind referenced by
+// refs, which belong to an object in namespace. Parent references default to
+// the Gateway kind.
+func parentKeys(namespace, kind string, refs []gatewayv1.ParentReference) []string {
+	var keys []string
+	for _, ref := range refs {
+		if ref.Group != nil && *ref.Group != gatewayv1.GroupName {
+			continue
+		}
+		refKind := "Gateway"
+		if ref.Kind != nil {
+			refKind = string(*ref.Kind)
+		}
+		if refKind != kind {
+			continue
+		}
+		keys = appendKey(keys, namespacedName(refNamespace(namespace, ref.Namespace), ref.Name))
+	}
+	return keys
+}
+
+// serviceKeys returns the keys of the Services referenced by refs, which
+// belong to an object in namespace.
+func serviceKeys(namespace string, refs []gatewayv1.BackendObjectReference) []string {
+	var keys []string
+	for _, ref := range refs {
+		if ref.Group != nil && *ref.Group != "" {
+			continue
+		}
+		if ref.Kind != nil && *ref.Kind != "Service" {
+			continue
+		}
+		keys = appendKey(keys, namespacedName(refNamespace(namespace, ref.Namespace), ref.Name))
+	}
+	return keys
+}
+
+// gatewayListenersTLS returns the TLS configurations of the listeners of a
+// Gateway.
+func gatewayListenersTLS(listeners []gatewayv1.Listener) []*gatewayv1.ListenerTLSConfig {
+	tls := make([]*gatewayv1.ListenerTLSConfig, 0, len(listeners))
+	for _, l := range listeners {
+		tls = append(tls, l.TLS)
+	}
+	return tls
+}
+
+// certificateSecretKeys returns the keys of the Secrets referenced as
+// certificates by the TLS configurations of the listeners of a Gateway or
+// ListenerSet in namespace.
+func certificateSecretKeys(namespace string, tls ...*gatewayv1.ListenerTLSConfig) []string {
+	var keys []string
+	for _, t := range tls {
+		if t == nil {
+			continue
+		}
+		for _, ref := range t.CertificateRefs {
+			if ref.Group != nil && *ref.Group != "" {
+				continue
+			}
+			if ref.Kind != nil && *ref.Kind != "Secret" {
+				continue
+			}
+			keys = appendKey(keys, namespacedName(refNamespace(namespace, ref.Namespace), ref.Name))
+		}
+	}
+	return keys
+}
+
+// httpRouteBackendRefs returns the backends of the rules, backendRefs and
+// filters of an HTTPRoute.
+func httpRouteBackendRefs(spec *gatewayv1.HTTPRouteSpec) []gatewayv1.BackendObjectReference {
+	var refs []gatewayv1.BackendObjectReference
+	filterRefs := func(filters []gatewayv1.HTTPRouteFilter) {
+		for _, f := range filters {
+			if f.RequestMirror != nil {
+				refs = append(refs, f.RequestMirror.BackendRef)
+			}
+			if f.ExternalAuth != nil {
+				refs = append(refs, f.ExternalAuth.BackendRef)
+			}
+		}
+	}
+	for _, rule := range spec.Rules {
+		filterRefs(rule.Filters)
+		for _, ref := range rule.BackendRefs {
+			refs = append(refs, ref.BackendObjectReference)
+			filterRefs(ref.Filters)
+		}
+	}
+	return refs
+}
+
+// grpcRouteBackendRefs returns the backends of the rules, backendRefs and
+// filters of a GRPCRoute.
+func grpcRouteBackendRefs(spec *gatewayv1.GRPCRouteSpec) []gatewayv1.BackendObjectReference {
+	var refs []gatewayv1.BackendObjectReference
+	filterRefs := func(filters []gatewayv1.GRPCRouteFilter) {
+		for _, f := range filters {
+			if f.RequestMirror != nil {
+				refs = append(refs, f.RequestMirror.BackendRef)
+			}
+		}
+	}
+	for _, rule := range spec.Rules {
+		filterRefs(rule.Filters)
+		for _, ref := range rule.BackendRefs {
+			refs = append(refs, ref.BackendObjectReference)
+			filterRefs(ref.Filters)
+		}
+	}
+	return refs
+}
+
+// ruleBackendRefs returns the backends of the rules of a TCPRoute, TLSRoute
+// or UDPRoute.
+func ruleBackendRefs[R any](rules []R, backendRefs func(R) []gatewayv1.BackendRef) []gatewayv1.BackendObjectReference {
+	var refs []gatewayv1.BackendObjectReference
+	for _, rule := range rules {
+		for _, ref := range backendRefs(rule) {
+			refs = append(refs, ref.BackendObjectReference)
+		}
+	}
+	return refs
+}
+
+// refNamespace returns the namespace of a reference from an object in
+// namespace, which defaults to the namespace of the object.
+func refNamespace(namespace string, refNamespace *gatewayv1.Namespace) string {
+	if refNamespace != nil {
+		return string(*refNamespace)
+	}
+	return namespace
+}
+
+func namespacedName(namespace string, name gatewayv1.ObjectName) string {
+	return types.NamespacedName{Namespace: namespace, Name: string(name)}.String()
+}
+
+// appendKey appends key to keys unless it is already there, as an object
+// referencing the same resource several times must be indexed only once
+// under its key.
+func appendKey(keys []string, key string) []string {
+	if slices.Contains(keys, key) {
+		return keys
+	}
+	return append(keys, key)
+}
@@ -0,0 +1,238 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package indexers_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/utils/ptr"
+
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+	"sigs.k8s.io/gateway-api/pkg/client/indexers"
+	listersv1 "sigs.k8s.io/gateway-api/pkg/client/listers/apis/v1"
+)
+
+func TestKeys(t *testing.T) {
+	httpRouteSpec := gatewayv1.HTTPRouteSpec{
+		CommonRouteSpec: gatewayv1.CommonRouteSpec{
+			ParentRefs: []gatewayv1.ParentReference{
+				{Name: "gw"},
+				{Name: "gw", SectionName: ptr.To(gatewayv1.SectionName("http"))},
+				{Name: "other-gw", Namespace: ptr.To(gatewayv1.Namespace("infra"))},
+				{Name: "ls", Kind: ptr.To(gatewayv1.Kind("ListenerSet"))},
+				{Name: "svc", Group: ptr.To(gatewayv1.Group("")), Kind: ptr.To(gatewayv1.Kind("Service"))},
+			},
+		},
+		Rules: []gatewayv1.HTTPRouteRule{{
+			Filters: []gatewayv1.HTTPRouteFilter{{
+				Type:          gatewayv1.HTTPRouteFilterRequestMirror,
+				RequestMirror: &gatewayv1.HTTPRequestMirrorFilter{BackendRef: gatewayv1.BackendObjectReference{Name: "mirror"}},
+			}},
+			BackendRefs: []gatewayv1.HTTPBackendRef{
+				{BackendRef: gatewayv1.BackendRef{BackendObjectReference: gatewayv1.BackendObjectReference{Name: "svc"}}},
+				{BackendRef: gatewayv1.BackendRef{BackendObjectReference: gatewayv1.BackendObjectReference{Name: "svc", Namespace: ptr.To(gatewayv1.Namespace("backends"))}}},
+				{BackendRef: gatewayv1.BackendRef{BackendObjectReference: gatewayv1.BackendObjectReference{Name: "backend", Group: ptr.To(gatewayv1.Group("example.com")), Kind: ptr.To(gatewayv1.Kind("Backend"))}}},
+			},
+		}},
+	}
+	tcpRouteSpec := gatewayv1alpha2.TCPRouteSpec{
+		CommonRouteSpec: gatewayv1.CommonRouteSpec{ParentRefs: []gatewayv1.ParentReference{{Name: "gw"}}},
+		Rules: []gatewayv1alpha2.TCPRouteRule{{
+			BackendRefs: []gatewayv1.BackendRef{{BackendObjectReference: gatewayv1.BackendObjectReference{Name: "svc"}}},
+		}},
+	}
+	gatewaySpec := gatewayv1.GatewaySpec{
+		Listeners: []gatewayv1.Listener{
+			{Name: "http"},
+			{Name: "https", TLS: &gatewayv1.ListenerTLSConfig{CertificateRefs: []gatewayv1.SecretObjectReference{
+				{Name: "cert"},
+				{Name: "cert", Namespace: ptr.To(gatewayv1.Namespace("certs"))},
+			}}},
+			{Name: "https-other", TLS: &gatewayv1.ListenerTLSConfig{CertificateRefs: []gatewayv1.SecretObjectReference{
+				{Name: "cert"},
+			}}},
+		},
+	}
+	referenceGrantSpec := gatewayv1.ReferenceGrantSpec{
+		From: []gatewayv1.ReferenceGrantFrom{
+			{Group: gatewayv1.GroupName, Kind: "HTTPRoute", Namespace: "a"},
+			{Group: gatewayv1.GroupName, Kind: "GRPCRoute", Namespace: "a"},
+			{Group: gatewayv1.GroupName, Kind: "HTTPRoute", Namespace: "b"},
+		},
+	}
+	meta := metav1.ObjectMeta{Name: "obj", Namespace: "ns"}
+
+	testCases := []struct {
+		name     string
+		obj      any
+		keysFunc func(any) []string
+		expected []string
+	}{
+		{
+			name:     "v1 HTTPRoute parent Gateways",
+			obj:      &gatewayv1.HTTPRoute{ObjectMeta: meta, Spec: httpRouteSpec},
+			keysFunc: indexers.ParentGatewayKeys,
+			expected: []string{"ns/gw", "infra/other-gw"},
+		},
+		{
+			name:     "v1beta1 HTTPRoute backend Services",
+			obj:      &gatewayv1beta1.HTTPRoute{ObjectMeta: meta, Spec: httpRouteSpec},
+			keysFunc: indexers.BackendServiceKeys,
+			expected: []string{"ns/mirror", "ns/svc", "backends/svc"},
+		},
+		{
+			name:     "v1 HTTPRoute parent ListenerSets",
+			obj:      &gatewayv1.HTTPRoute{ObjectMeta: meta, Spec: httpRouteSpec},
+			keysFunc: indexers.ParentListenerSetKeys,
+			expected: []string{"ns/ls"},
+		},
+		{
+			name:     "v1alpha2 TCPRoute parent Gateways",
+			obj:      &gatewayv1alpha2.TCPRoute{ObjectMeta: meta, Spec: tcpRouteSpec},
+			keysFunc: indexers.ParentGatewayKeys,
+			expected: []string{"ns/gw"},
+		},
+		{
+			name:     "v1alpha2 TCPRoute backend Services",
+			obj:      &gatewayv1alpha2.TCPRoute{ObjectMeta: meta, Spec: tcpRouteSpec},
+			keysFunc: indexers.BackendServiceKeys,
+			expected: []string{"ns/svc"},
+		},
+		{
+			name: "ListenerSet parent Gateway",
+			obj: &gatewayv1.ListenerSet{ObjectMeta: meta, Spec: gatewayv1.ListenerSetSpec{
+				ParentRef: gatewayv1.ParentGatewayReference{Name: "gw", Namespace: ptr.To(gatewayv1.Namespace("infra"))},
+			}},
+			keysFunc: indexers.ParentGatewayKeys,
+			expected: []string{"infra/gw"},
+		},
+		{
+			name:     "v1beta1 Gateway certificate Secrets",
+			obj:      &gatewayv1beta1.Gateway{ObjectMeta: meta, Spec: gatewaySpec},
+			keysFunc: indexers.CertificateSecretKeys,
+			expected: []string{"ns/cert", "certs/cert"},
+		},
+		{
+			name: "ListenerSet certificate Secrets",
+			obj: &gatewayv1.ListenerSet{ObjectMeta: meta, Spec: gatewayv1.ListenerSetSpec{
+				Listeners: []gatewayv1.ListenerEntry{
+					{Name: "http"},
+					{Name: "https", TLS: &gatewayv1.ListenerTLSConfig{CertificateRefs: []gatewayv1.SecretObjectReference{
+						{Name: "cert", Namespace: ptr.To(gatewayv1.Namespace("certs"))},
+					}}},
+				},
+			}},
+			keysFunc: indexers.CertificateSecretKeys,
+			expected: []string{"certs/cert"},
+		},
+		{
+			name:     "v1alpha2 ReferenceGrant from namespaces",
+			obj:      &gatewayv1alpha2.ReferenceGrant{ObjectMeta: meta, Spec: referenceGrantSpec},
+			keysFunc: indexers.FromNamespaceKeys,
+			expected: []string{"a", "b"},
+		},
+		{
+			name: "BackendTLSPolicy target Services",
+			obj: &gatewayv1.BackendTLSPolicy{ObjectMeta: meta, Spec: gatewayv1.BackendTLSPolicySpec{
+				TargetRefs: []gatewayv1.LocalPolicyTargetReferenceWithSectionName{
+					{LocalPolicyTargetReference: gatewayv1.LocalPolicyTargetReference{Group: "", Kind: "Service", Name: "svc"}},
+					{LocalPolicyTargetReference: gatewayv1.LocalPolicyTargetReference{Group: "example.com", Kind: "Backend", Name: "backend"}},
+				},
+			}},
+			keysFunc: indexers.TargetServiceKeys,
+			expected: []string{"ns/svc"},
+		},
+		{
+			name:     "unsupported object",
+			obj:      &gatewayv1.GatewayClass{ObjectMeta: meta},
+			keysFunc: indexers.ParentGatewayKeys,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, tc.keysFunc(tc.obj))
+		})
+	}
+}
+
+func TestIndexers(t *testing.T) {
+	route := func(namespace, name, gateway string) *gatewayv1.HTTPRoute {
+		return &gatewayv1.HTTPRoute{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Spec: gatewayv1.HTTPRouteSpec{
+				CommonRouteSpec: gatewayv1.CommonRouteSpec{
+					ParentRefs: []gatewayv1.ParentReference{{Name: gatewayv1.ObjectName(gateway)}},
+				},
+			},
+		}
+	}
+
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, indexers.Indexers())
+	require.NoError(t, indexer.Add(route("a", "route-1", "gw")))
+	require.NoError(t, indexer.Add(route("a", "route-2", "other-gw")))
+	require.NoError(t, indexer.Add(route("b", "route-3", "gw")))
+
+	routes, err := indexers.ByIndex[*gatewayv1.HTTPRoute](indexer, indexers.ParentGatewayIndex, "a/gw")
+	require.NoError(t, err)
+	require.Len(t, routes, 1)
+	require.Equal(t, "route-1", routes[0].Name)
+
+	lister := listersv1.NewHTTPRouteLister(indexer)
+	routes, err = lister.ListByParentGateway(types.NamespacedName{Namespace: "b", Name: "gw"})
+	require.NoError(t, err)
+	require.Len(t, routes, 1)
+	require.Equal(t, "route-3", routes[0].Name)
+
+	indexerFunc := indexers.IndexerFunc[metav1.Object](indexers.ParentGatewayIndexFunc)
+	require.Equal(t, []string{"a/other-gw"}, indexerFunc(route("a", "route-2", "other-gw")))
+
+	// Indexed listers query the registered index, which here keys every
+	// HTTPRoute by its own namespaced name, and scan the HTTPRoutes for the
+	// others.
+	indexer = cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{
+		indexers.ParentGatewayIndex: func(obj any) ([]string, error) {
+			key, keyErr := cache.MetaNamespaceKeyFunc(obj)
+			return []string{key}, keyErr
+		},
+	})
+	require.NoError(t, indexer.Add(route("a", "route-1", "gw")))
+	require.NoError(t, indexer.Add(route("b", "route-3", "gw")))
+
+	lister = listersv1.NewIndexedHTTPRouteLister(indexer)
+	routes, err = lister.ListByParentGateway(types.NamespacedName{Namespace: "b", Name: "route-3"})
+	require.NoError(t, err)
+	require.Len(t, routes, 1)
+	require.Equal(t, "route-3", routes[0].Name)
+
+	routes, err = lister.ListByBackendService(types.NamespacedName{Namespace: "a", Name: "svc"})
+	require.NoError(t, err)
+	require.Empty(t, routes)
+
+	indexer = cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	require.NoError(t, indexer.Add(route("a", "route-1", "gw")))
+	routes, err = listersv1.NewIndexedHTTPRouteLister(indexer).ListByParentGateway(types.NamespacedName{Namespace: "a", Name: "gw"})
+	require.NoError(t, err)
+	require.Len(t, routes, 1)
+	require.Equal(t, "route-1", routes[0].Name)
+}
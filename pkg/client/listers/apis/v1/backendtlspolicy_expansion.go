@@ -0,0 +1,73 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	labels "k8s.io/apimachinery/pkg/labels"
+	types "k8s.io/apimachinery/pkg/types"
+	cache "k8s.io/client-go/tools/cache"
+	apisv1 "sigs.k8s.io/gateway-api/apis/v1"
+	indexers "sigs.k8s.io/gateway-api/pkg/client/indexers"
+)
+
+// BackendTLSPolicyListerExpansion allows custom methods to be added to
+// BackendTLSPolicyLister.
+type BackendTLSPolicyListerExpansion interface {
+	// ListByTargetService lists the BackendTLSPolicies targeting the given Service.
+	ListByTargetService(service types.NamespacedName) ([]*apisv1.BackendTLSPolicy, error)
+}
+
+// BackendTLSPolicyNamespaceListerExpansion allows custom methods to be added to
+// BackendTLSPolicyNamespaceLister.
+type BackendTLSPolicyNamespaceListerExpansion interface{}
+
+// NewIndexedBackendTLSPolicyLister returns a BackendTLSPolicyLister whose
+// ListBy* methods look the BackendTLSPolicies up in the indexes of the indexers
+// package registered on indexer, e.g. with indexers.Indexers(), and only scan
+// the BackendTLSPolicies for the indexes which are not registered.
+func NewIndexedBackendTLSPolicyLister(indexer cache.Indexer) BackendTLSPolicyLister {
+	return &indexedBackendTLSPolicyLister{BackendTLSPolicyLister: NewBackendTLSPolicyLister(indexer), indexer: indexer}
+}
+
+// indexedBackendTLSPolicyLister implements the BackendTLSPolicyLister
+// interface, querying the indexes of its indexer.
+type indexedBackendTLSPolicyLister struct {
+	BackendTLSPolicyLister
+	indexer cache.Indexer
+}
+
+// ListByTargetService lists the BackendTLSPolicies targeting the given Service.
+// It scans all the BackendTLSPolicies of the lister, listers returned by
+// NewIndexedBackendTLSPolicyLister look them up in the
+// indexers.TargetServiceIndex index instead. Objects returned here must be
+// treated as read-only.
+func (s *backendTLSPolicyLister) ListByTargetService(service types.NamespacedName) ([]*apisv1.BackendTLSPolicy, error) {
+	objs, err := s.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	return indexers.Filter(objs, indexers.TargetServiceKeys, service.String()), nil
+}
+
+// ListByTargetService lists the BackendTLSPolicies targeting the given Service.
+// It looks them up in the indexers.TargetServiceIndex index when it is
+// registered. Objects returned here must be treated as read-only.
+func (s *indexedBackendTLSPolicyLister) ListByTargetService(service types.NamespacedName) ([]*apisv1.BackendTLSPolicy, error) {
+	return indexers.ByIndexOrScan(s.indexer, indexers.TargetServiceIndex, service.String(), func() ([]*apisv1.BackendTLSPolicy, error) {
+		return s.BackendTLSPolicyLister.ListByTargetService(service)
+	})
+}
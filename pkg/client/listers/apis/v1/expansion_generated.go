@@ -18,78 +18,6 @@ limitations under the License.
 
 package v1
 
-// BackendTLSPolicyListerExpansion allows custom methods to be added to
-// BackendTLSPolicyLister.
-type BackendTLSPolicyListerExpansion interface{}
-
-// BackendTLSPolicyNamespaceListerExpansion allows custom methods to be added to
-// BackendTLSPolicyNamespaceLister.
-type BackendTLSPolicyNamespaceListerExpansion interface{}
-
-// GRPCRouteListerExpansion allows custom methods to be added to
-// GRPCRouteLister.
-type GRPCRouteListerExpansion interface{}
-
-// GRPCRouteNamespaceListerExpansion allows custom methods to be added to
-// GRPCRouteNamespaceLister.
-type GRPCRouteNamespaceListerExpansion interface{}
-
-// GatewayListerExpansion allows custom methods to be added to
-// GatewayLister.
-type GatewayListerExpansion interface{}
-
-// GatewayNamespaceListerExpansion allows custom methods to be added to
-// GatewayNamespaceLister.
-type GatewayNamespaceListerExpansion interface{}
-
 // GatewayClassListerExpansion allows custom methods to be added to
 // GatewayClassLister.
 type GatewayClassListerExpansion interface{}
-
-// HTTPRouteListerExpansion allows custom methods to be added to
-// HTTPRouteLister.
-type HTTPRouteListerExpansion interface{}
-
-// HTTPRouteNamespaceListerExpansion allows custom methods to be added to
-// HTTPRouteNamespaceLister.
-type HTTPRouteNamespaceListerExpansion interface{}
-
-// ListenerSetListerExpansion allows custom methods to be added to
-// ListenerSetLister.
-type ListenerSetListerExpansion interface{}
-
-// ListenerSetNamespaceListerExpansion allows custom methods to be added to
-// ListenerSetNamespaceLister.
-type ListenerSetNamespaceListerExpansion interface{}
-
-// ReferenceGrantListerExpansion allows custom methods to be added to
-// ReferenceGrantLister.
-type ReferenceGrantListerExpansion interface{}
-
-// ReferenceGrantNamespaceListerExpansion allows custom methods to be added to
-// ReferenceGrantNamespaceLister.
-type ReferenceGrantNamespaceListerExpansion interface{}
-
-// TCPRouteListerExpansion allows custom methods to be added to
-// TCPRouteLister.
-type TCPRouteListerExpansion interface{}
-
-// TCPRouteNamespaceListerExpansion allows custom methods to be added to
-// TCPRouteNamespaceLister.
-type TCPRouteNamespaceListerExpansion interface{}
-
-// TLSRouteListerExpansion allows custom methods to be added to
-// TLSRouteLister.
-type TLSRouteListerExpansion interface{}
-
-// TLSRouteNamespaceListerExpansion allows custom methods to be added to
-// TLSRouteNamespaceLister.
-type TLSRouteNamespaceListerExpansion interface{}
-
-// UDPRouteListerExpansion allows custom methods to be added to
-// UDPRouteLister.
-type UDPRouteListerExpansion interface{}
-
-// UDPRouteNamespaceListerExpansion allows custom methods to be added to
-// UDPRouteNamespaceLister.
-type UDPRouteNamespaceListerExpansion interface{}
@@ -0,0 +1,74 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	labels "k8s.io/apimachinery/pkg/labels"
+	types "k8s.io/apimachinery/pkg/types"
+	cache "k8s.io/client-go/tools/cache"
+	apisv1 "sigs.k8s.io/gateway-api/apis/v1"
+	indexers "sigs.k8s.io/gateway-api/pkg/client/indexers"
+)
+
+// GatewayListerExpansion allows custom methods to be added to
+// GatewayLister.
+type GatewayListerExpansion interface {
+	// ListByCertificateSecret lists the Gateways whose listeners reference the given Secret as a certificate.
+	ListByCertificateSecret(secret types.NamespacedName) ([]*apisv1.Gateway, error)
+}
+
+// GatewayNamespaceListerExpansion allows custom methods to be added to
+// GatewayNamespaceLister.
+type GatewayNamespaceListerExpansion interface{}
+
+// NewIndexedGatewayLister returns a GatewayLister whose ListBy* methods look
+// the Gateways up in the indexes of the indexers package registered on indexer,
+// e.g. with indexers.Indexers(), and only scan the Gateways for the indexes
+// which are not registered.
+func NewIndexedGatewayLister(indexer cache.Indexer) GatewayLister {
+	return &indexedGatewayLister{GatewayLister: NewGatewayLister(indexer), indexer: indexer}
+}
+
+// indexedGatewayLister implements the GatewayLister interface, querying the
+// indexes of its indexer.
+type indexedGatewayLister struct {
+	GatewayLister
+	indexer cache.Indexer
+}
+
+// ListByCertificateSecret lists the Gateways whose listeners reference the
+// given Secret as a certificate. It scans all the Gateways of the lister,
+// listers returned by NewIndexedGatewayLister look them up in the
+// indexers.CertificateSecretIndex index instead. Objects returned here must be
+// treated as read-only.
+func (s *gatewayLister) ListByCertificateSecret(secret types.NamespacedName) ([]*apisv1.Gateway, error) {
+	objs, err := s.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	return indexers.Filter(objs, indexers.CertificateSecretKeys, secret.String()), nil
+}
+
+// ListByCertificateSecret lists the Gateways whose listeners reference the
+// given Secret as a certificate. It looks them up in the
+// indexers.CertificateSecretIndex index when it is registered. Objects returned
+// here must be treated as read-only.
+func (s *indexedGatewayLister) ListByCertificateSecret(secret types.NamespacedName) ([]*apisv1.Gateway, error) {
+	return indexers.ByIndexOrScan(s.indexer, indexers.CertificateSecretIndex, secret.String(), func() ([]*apisv1.Gateway, error) {
+		return s.GatewayLister.ListByCertificateSecret(secret)
+	})
+}
@@ -0,0 +1,95 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	labels "k8s.io/apimachinery/pkg/labels"
+	types "k8s.io/apimachinery/pkg/types"
+	cache "k8s.io/client-go/tools/cache"
+	apisv1 "sigs.k8s.io/gateway-api/apis/v1"
+	indexers "sigs.k8s.io/gateway-api/pkg/client/indexers"
+)
+
+// GRPCRouteListerExpansion allows custom methods to be added to
+// GRPCRouteLister.
+type GRPCRouteListerExpansion interface {
+	// ListByParentGateway lists the GRPCRoutes attached to the given Gateway.
+	ListByParentGateway(gateway types.NamespacedName) ([]*apisv1.GRPCRoute, error)
+	// ListByBackendService lists the GRPCRoutes referencing the given Service as a backend.
+	ListByBackendService(service types.NamespacedName) ([]*apisv1.GRPCRoute, error)
+}
+
+// GRPCRouteNamespaceListerExpansion allows custom methods to be added to
+// GRPCRouteNamespaceLister.
+type GRPCRouteNamespaceListerExpansion interface{}
+
+// NewIndexedGRPCRouteLister returns a GRPCRouteLister whose ListBy* methods
+// look the GRPCRoutes up in the indexes of the indexers package registered on
+// indexer, e.g. with indexers.Indexers(), and only scan the GRPCRoutes for the
+// indexes which are not registered.
+func NewIndexedGRPCRouteLister(indexer cache.Indexer) GRPCRouteLister {
+	return &indexedGRPCRouteLister{GRPCRouteLister: NewGRPCRouteLister(indexer), indexer: indexer}
+}
+
+// indexedGRPCRouteLister implements the GRPCRouteLister interface, querying the
+// indexes of its indexer.
+type indexedGRPCRouteLister struct {
+	GRPCRouteLister
+	indexer cache.Indexer
+}
+
+// ListByParentGateway lists the GRPCRoutes attached to the given Gateway. It
+// scans all the GRPCRoutes of the lister, listers returned by
+// NewIndexedGRPCRouteLister look them up in the indexers.ParentGatewayIndex
+// index instead. Objects returned here must be treated as read-only.
+func (s *gRPCRouteLister) ListByParentGateway(gateway types.NamespacedName) ([]*apisv1.GRPCRoute, error) {
+	objs, err := s.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	return indexers.Filter(objs, indexers.ParentGatewayKeys, gateway.String()), nil
+}
+
+// ListByBackendService lists the GRPCRoutes referencing the given Service as a
+// backend. It scans all the GRPCRoutes of the lister, listers returned by
+// NewIndexedGRPCRouteLister look them up in the indexers.BackendServiceIndex
+// index instead. Objects returned here must be treated as read-only.
+func (s *gRPCRouteLister) ListByBackendService(service types.NamespacedName) ([]*apisv1.GRPCRoute, error) {
+	objs, err := s.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	return indexers.Filter(objs, indexers.BackendServiceKeys, service.String()), nil
+}
+
+// ListByParentGateway lists the GRPCRoutes attached to the given Gateway. It
+// looks them up in the indexers.ParentGatewayIndex index when it is registered.
+// Objects returned here must be treated as read-only.
+func (s *indexedGRPCRouteLister) ListByParentGateway(gateway types.NamespacedName) ([]*apisv1.GRPCRoute, error) {
+	return indexers.ByIndexOrScan(s.indexer, indexers.ParentGatewayIndex, gateway.String(), func() ([]*apisv1.GRPCRoute, error) {
+		return s.GRPCRouteLister.ListByParentGateway(gateway)
+	})
+}
+
+// ListByBackendService lists the GRPCRoutes referencing the given Service as a
+// backend. It looks them up in the indexers.BackendServiceIndex index when it
+// is registered. Objects returned here must be treated as read-only.
+func (s *indexedGRPCRouteLister) ListByBackendService(service types.NamespacedName) ([]*apisv1.GRPCRoute, error) {
+	return indexers.ByIndexOrScan(s.indexer, indexers.BackendServiceIndex, service.String(), func() ([]*apisv1.GRPCRoute, error) {
+		return s.GRPCRouteLister.ListByBackendService(service)
+	})
+}
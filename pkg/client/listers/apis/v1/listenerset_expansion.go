@@ -0,0 +1,97 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	labels "k8s.io/apimachinery/pkg/labels"
+	types "k8s.io/apimachinery/pkg/types"
+	cache "k8s.io/client-go/tools/cache"
+	apisv1 "sigs.k8s.io/gateway-api/apis/v1"
+	indexers "sigs.k8s.io/gateway-api/pkg/client/indexers"
+)
+
+// ListenerSetListerExpansion allows custom methods to be added to
+// ListenerSetLister.
+type ListenerSetListerExpansion interface {
+	// ListByParentGateway lists the ListenerSets attached to the given Gateway.
+	ListByParentGateway(gateway types.NamespacedName) ([]*apisv1.ListenerSet, error)
+	// ListByCertificateSecret lists the ListenerSets whose listeners reference the given Secret as a certificate.
+	ListByCertificateSecret(secret types.NamespacedName) ([]*apisv1.ListenerSet, error)
+}
+
+// ListenerSetNamespaceListerExpansion allows custom methods to be added to
+// ListenerSetNamespaceLister.
+type ListenerSetNamespaceListerExpansion interface{}
+
+// NewIndexedListenerSetLister returns a ListenerSetLister whose ListBy* methods
+// look the ListenerSets up in the indexes of the indexers package registered on
+// indexer, e.g. with indexers.Indexers(), and only scan the ListenerSets for
+// the indexes which are not registered.
+func NewIndexedListenerSetLister(indexer cache.Indexer) ListenerSetLister {
+	return &indexedListenerSetLister{ListenerSetLister: NewListenerSetLister(indexer), indexer: indexer}
+}
+
+// indexedListenerSetLister implements the ListenerSetLister interface, querying
+// the indexes of its indexer.
+type indexedListenerSetLister struct {
+	ListenerSetLister
+	indexer cache.Indexer
+}
+
+// ListByParentGateway lists the ListenerSets attached to the given Gateway. It
+// scans all the ListenerSets of the lister, listers returned by
+// NewIndexedListenerSetLister look them up in the indexers.ParentGatewayIndex
+// index instead. Objects returned here must be treated as read-only.
+func (s *listenerSetLister) ListByParentGateway(gateway types.NamespacedName) ([]*apisv1.ListenerSet, error) {
+	objs, err := s.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	return indexers.Filter(objs, indexers.ParentGatewayKeys, gateway.String()), nil
+}
+
+// ListByCertificateSecret lists the ListenerSets whose listeners reference the
+// given Secret as a certificate. It scans all the ListenerSets of the lister,
+// listers returned by NewIndexedListenerSetLister look them up in the
+// indexers.CertificateSecretIndex index instead. Objects returned here must be
+// treated as read-only.
+func (s *listenerSetLister) ListByCertificateSecret(secret types.NamespacedName) ([]*apisv1.ListenerSet, error) {
+	objs, err := s.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	return indexers.Filter(objs, indexers.CertificateSecretKeys, secret.String()), nil
+}
+
+// ListByParentGateway lists the ListenerSets attached to the given Gateway. It
+// looks them up in the indexers.ParentGatewayIndex index when it is registered.
+// Objects returned here must be treated as read-only.
+func (s *indexedListenerSetLister) ListByParentGateway(gateway types.NamespacedName) ([]*apisv1.ListenerSet, error) {
+	return indexers.ByIndexOrScan(s.indexer, indexers.ParentGatewayIndex, gateway.String(), func() ([]*apisv1.ListenerSet, error) {
+		return s.ListenerSetLister.ListByParentGateway(gateway)
+	})
+}
+
+// ListByCertificateSecret lists the ListenerSets whose listeners reference the
+// given Secret as a certificate. It looks them up in the
+// indexers.CertificateSecretIndex index when it is registered. Objects returned
+// here must be treated as read-only.
+func (s *indexedListenerSetLister) ListByCertificateSecret(secret types.NamespacedName) ([]*apisv1.ListenerSet, error) {
+	return indexers.ByIndexOrScan(s.indexer, indexers.CertificateSecretIndex, secret.String(), func() ([]*apisv1.ListenerSet, error) {
+		return s.ListenerSetLister.ListByCertificateSecret(secret)
+	})
+}
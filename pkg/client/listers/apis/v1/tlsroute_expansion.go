@@ -0,0 +1,95 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	labels "k8s.io/apimachinery/pkg/labels"
+	types "k8s.io/apimachinery/pkg/types"
+	cache "k8s.io/client-go/tools/cache"
+	apisv1 "sigs.k8s.io/gateway-api/apis/v1"
+	indexers "sigs.k8s.io/gateway-api/pkg/client/indexers"
+)
+
+// TLSRouteListerExpansion allows custom methods to be added to
+// TLSRouteLister.
+type TLSRouteListerExpansion interface {
+	// ListByParentGateway lists the TLSRoutes attached to the given Gateway.
+	ListByParentGateway(gateway types.NamespacedName) ([]*apisv1.TLSRoute, error)
+	// ListByBackendService lists the TLSRoutes referencing the given Service as a backend.
+	ListByBackendService(service types.NamespacedName) ([]*apisv1.TLSRoute, error)
+}
+
+// TLSRouteNamespaceListerExpansion allows custom methods to be added to
+// TLSRouteNamespaceLister.
+type TLSRouteNamespaceListerExpansion interface{}
+
+// NewIndexedTLSRouteLister returns a TLSRouteLister whose ListBy* methods look
+// the TLSRoutes up in the indexes of the indexers package registered on
+// indexer, e.g. with indexers.Indexers(), and only scan the TLSRoutes for the
+// indexes which are not registered.
+func NewIndexedTLSRouteLister(indexer cache.Indexer) TLSRouteLister {
+	return &indexedTLSRouteLister{TLSRouteLister: NewTLSRouteLister(indexer), indexer: indexer}
+}
+
+// indexedTLSRouteLister implements the TLSRouteLister interface, querying the
+// indexes of its indexer.
+type indexedTLSRouteLister struct {
+	TLSRouteLister
+	indexer cache.Indexer
+}
+
+// ListByParentGateway lists the TLSRoutes attached to the given Gateway. It
+// scans all the TLSRoutes of the lister, listers returned by
+// NewIndexedTLSRouteLister look them up in the indexers.ParentGatewayIndex
+// index instead. Objects returned here must be treated as read-only.
+func (s *tLSRouteLister) ListByParentGateway(gateway types.NamespacedName) ([]*apisv1.TLSRoute, error) {
+	objs, err := s.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	return indexers.Filter(objs, indexers.ParentGatewayKeys, gateway.String()), nil
+}
+
+// ListByBackendService lists the TLSRoutes referencing the given Service as a
+// backend. It scans all the TLSRoutes of the lister, listers returned by
+// NewIndexedTLSRouteLister look them up in the indexers.BackendServiceIndex
+// index instead. Objects returned here must be treated as read-only.
+func (s *tLSRouteLister) ListByBackendService(service types.NamespacedName) ([]*apisv1.TLSRoute, error) {
+	objs, err := s.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	return indexers.Filter(objs, indexers.BackendServiceKeys, service.String()), nil
+}
+
+// ListByParentGateway lists the TLSRoutes attached to the given Gateway. It
+// looks them up in the indexers.ParentGatewayIndex index when it is registered.
+// Objects returned here must be treated as read-only.
+func (s *indexedTLSRouteLister) ListByParentGateway(gateway types.NamespacedName) ([]*apisv1.TLSRoute, error) {
+	return indexers.ByIndexOrScan(s.indexer, indexers.ParentGatewayIndex, gateway.String(), func() ([]*apisv1.TLSRoute, error) {
+		return s.TLSRouteLister.ListByParentGateway(gateway)
+	})
+}
+
+// ListByBackendService lists the TLSRoutes referencing the given Service as a
+// backend. It looks them up in the indexers.BackendServiceIndex index when it
+// is registered. Objects returned here must be treated as read-only.
+func (s *indexedTLSRouteLister) ListByBackendService(service types.NamespacedName) ([]*apisv1.TLSRoute, error) {
+	return indexers.ByIndexOrScan(s.indexer, indexers.BackendServiceIndex, service.String(), func() ([]*apisv1.TLSRoute, error) {
+		return s.TLSRouteLister.ListByBackendService(service)
+	})
+}
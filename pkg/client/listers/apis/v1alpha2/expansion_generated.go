@@ -17,43 +17,3 @@ limitations under the License.
 // Code generated by lister-gen. DO NOT EDIT.
 
 package v1alpha2
-
-// GRPCRouteListerExpansion allows custom methods to be added to
-// GRPCRouteLister.
-type GRPCRouteListerExpansion interface{}
-
-// GRPCRouteNamespaceListerExpansion allows custom methods to be added to
-// GRPCRouteNamespaceLister.
-type GRPCRouteNamespaceListerExpansion interface{}
-
-// ReferenceGrantListerExpansion allows custom methods to be added to
-// ReferenceGrantLister.
-type ReferenceGrantListerExpansion interface{}
-
-// ReferenceGrantNamespaceListerExpansion allows custom methods to be added to
-// ReferenceGrantNamespaceLister.
-type ReferenceGrantNamespaceListerExpansion interface{}
-
-// TCPRouteListerExpansion allows custom methods to be added to
-// TCPRouteLister.
-type TCPRouteListerExpansion interface{}
-
-// TCPRouteNamespaceListerExpansion allows custom methods to be added to
-// TCPRouteNamespaceLister.
-type TCPRouteNamespaceListerExpansion interface{}
-
-// TLSRouteListerExpansion allows custom methods to be added to
-// TLSRouteLister.
-type TLSRouteListerExpansion interface{}
-
-// TLSRouteNamespaceListerExpansion allows custom methods to be added to
-// TLSRouteNamespaceLister.
-type TLSRouteNamespaceListerExpansion interface{}
-
-// UDPRouteListerExpansion allows custom methods to be added to
-// UDPRouteLister.
-type UDPRouteListerExpansion interface{}
-
-// UDPRouteNamespaceListerExpansion allows custom methods to be added to
-// UDPRouteNamespaceLister.
-type UDPRouteNamespaceListerExpansion interface{}
@@ -18,30 +18,6 @@ limitations under the License.
 
 package v1beta1
 
-// GatewayListerExpansion allows custom methods to be added to
-// GatewayLister.
-type GatewayListerExpansion interface{}
-
-// GatewayNamespaceListerExpansion allows custom methods to be added to
-// GatewayNamespaceLister.
-type GatewayNamespaceListerExpansion interface{}
-
 // GatewayClassListerExpansion allows custom methods to be added to
 // GatewayClassLister.
 type GatewayClassListerExpansion interface{}
-
-// HTTPRouteListerExpansion allows custom methods to be added to
-// HTTPRouteLister.
-type HTTPRouteListerExpansion interface{}
-
-// HTTPRouteNamespaceListerExpansion allows custom methods to be added to
-// HTTPRouteNamespaceLister.
-type HTTPRouteNamespaceListerExpansion interface{}
-
-// ReferenceGrantListerExpansion allows custom methods to be added to
-// ReferenceGrantLister.
-type ReferenceGrantListerExpansion interface{}
-
-// ReferenceGrantNamespaceListerExpansion allows custom methods to be added to
-// ReferenceGrantNamespaceLister.
-type ReferenceGrantNamespaceListerExpansion interface{}
@@ -0,0 +1,95 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	labels "k8s.io/apimachinery/pkg/labels"
+	types "k8s.io/apimachinery/pkg/types"
+	cache "k8s.io/client-go/tools/cache"
+	apisv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+	indexers "sigs.k8s.io/gateway-api/pkg/client/indexers"
+)
+
+// HTTPRouteListerExpansion allows custom methods to be added to
+// HTTPRouteLister.
+type HTTPRouteListerExpansion interface {
+	// ListByParentGateway lists the HTTPRoutes attached to the given Gateway.
+	ListByParentGateway(gateway types.NamespacedName) ([]*apisv1beta1.HTTPRoute, error)
+	// ListByBackendService lists the HTTPRoutes referencing the given Service as a backend.
+	ListByBackendService(service types.NamespacedName) ([]*apisv1beta1.HTTPRoute, error)
+}
+
+// HTTPRouteNamespaceListerExpansion allows custom methods to be added to
+// HTTPRouteNamespaceLister.
+type HTTPRouteNamespaceListerExpansion interface{}
+
+// NewIndexedHTTPRouteLister returns an HTTPRouteLister whose ListBy* methods
+// look the HTTPRoutes up in the indexes of the indexers package registered on
+// indexer, e.g. with indexers.Indexers(), and only scan the HTTPRoutes for the
+// indexes which are not registered.
+func NewIndexedHTTPRouteLister(indexer cache.Indexer) HTTPRouteLister {
+	return &indexedHTTPRouteLister{HTTPRouteLister: NewHTTPRouteLister(indexer), indexer: indexer}
+}
+
+// indexedHTTPRouteLister implements the HTTPRouteLister interface, querying the
+// indexes of its indexer.
+type indexedHTTPRouteLister struct {
+	HTTPRouteLister
+	indexer cache.Indexer
+}
+
+// ListByParentGateway lists the HTTPRoutes attached to the given Gateway. It
+// scans all the HTTPRoutes of the lister, listers returned by
+// NewIndexedHTTPRouteLister look them up in the indexers.ParentGatewayIndex
+// index instead. Objects returned here must be treated as read-only.
+func (s *hTTPRouteLister) ListByParentGateway(gateway types.NamespacedName) ([]*apisv1beta1.HTTPRoute, error) {
+	objs, err := s.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	return indexers.Filter(objs, indexers.ParentGatewayKeys, gateway.String()), nil
+}
+
+// ListByBackendService lists the HTTPRoutes referencing the given Service as a
+// backend. It scans all the HTTPRoutes of the lister, listers returned by
+// NewIndexedHTTPRouteLister look them up in the indexers.BackendServiceIndex
+// index instead. Objects returned here must be treated as read-only.
+func (s *hTTPRouteLister) ListByBackendService(service types.NamespacedName) ([]*apisv1beta1.HTTPRoute, error) {
+	objs, err := s.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	return indexers.Filter(objs, indexers.BackendServiceKeys, service.String()), nil
+}
+
+// ListByParentGateway lists the HTTPRoutes attached to the given Gateway. It
+// looks them up in the indexers.ParentGatewayIndex index when it is registered.
+// Objects returned here must be treated as read-only.
+func (s *indexedHTTPRouteLister) ListByParentGateway(gateway types.NamespacedName) ([]*apisv1beta1.HTTPRoute, error) {
+	return indexers.ByIndexOrScan(s.indexer, indexers.ParentGatewayIndex, gateway.String(), func() ([]*apisv1beta1.HTTPRoute, error) {
+		return s.HTTPRouteLister.ListByParentGateway(gateway)
+	})
+}
+
+// ListByBackendService lists the HTTPRoutes referencing the given Service as a
+// backend. It looks them up in the indexers.BackendServiceIndex index when it
+// is registered. Objects returned here must be treated as read-only.
+func (s *indexedHTTPRouteLister) ListByBackendService(service types.NamespacedName) ([]*apisv1beta1.HTTPRoute, error) {
+	return indexers.ByIndexOrScan(s.indexer, indexers.BackendServiceIndex, service.String(), func() ([]*apisv1beta1.HTTPRoute, error) {
+		return s.HTTPRouteLister.ListByBackendService(service)
+	})
+}
@@ -0,0 +1,72 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	labels "k8s.io/apimachinery/pkg/labels"
+	cache "k8s.io/client-go/tools/cache"
+	apisv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+	indexers "sigs.k8s.io/gateway-api/pkg/client/indexers"
+)
+
+// ReferenceGrantListerExpansion allows custom methods to be added to
+// ReferenceGrantLister.
+type ReferenceGrantListerExpansion interface {
+	// ListByFromNamespace lists the ReferenceGrants granting references from the given namespace.
+	ListByFromNamespace(namespace string) ([]*apisv1beta1.ReferenceGrant, error)
+}
+
+// ReferenceGrantNamespaceListerExpansion allows custom methods to be added to
+// ReferenceGrantNamespaceLister.
+type ReferenceGrantNamespaceListerExpansion interface{}
+
+// NewIndexedReferenceGrantLister returns a ReferenceGrantLister whose ListBy*
+// methods look the ReferenceGrants up in the indexes of the indexers package
+// registered on indexer, e.g. with indexers.Indexers(), and only scan the
+// ReferenceGrants for the indexes which are not registered.
+func NewIndexedReferenceGrantLister(indexer cache.Indexer) ReferenceGrantLister {
+	return &indexedReferenceGrantLister{ReferenceGrantLister: NewReferenceGrantLister(indexer), indexer: indexer}
+}
+
+// indexedReferenceGrantLister implements the ReferenceGrantLister interface,
+// querying the indexes of its indexer.
+type indexedReferenceGrantLister struct {
+	ReferenceGrantLister
+	indexer cache.Indexer
+}
+
+// ListByFromNamespace lists the ReferenceGrants granting references from the
+// given namespace. It scans all the ReferenceGrants of the lister, listers
+// returned by NewIndexedReferenceGrantLister look them up in the
+// indexers.FromNamespaceIndex index instead. Objects returned here must be
+// treated as read-only.
+func (s *referenceGrantLister) ListByFromNamespace(namespace string) ([]*apisv1beta1.ReferenceGrant, error) {
+	objs, err := s.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	return indexers.Filter(objs, indexers.FromNamespaceKeys, namespace), nil
+}
+
+// ListByFromNamespace lists the ReferenceGrants granting references from the
+// given namespace. It looks them up in the indexers.FromNamespaceIndex index
+// when it is registered. Objects returned here must be treated as read-only.
+func (s *indexedReferenceGrantLister) ListByFromNamespace(namespace string) ([]*apisv1beta1.ReferenceGrant, error) {
+	return indexers.ByIndexOrScan(s.indexer, indexers.FromNamespaceIndex, namespace, func() ([]*apisv1beta1.ReferenceGrant, error) {
+		return s.ReferenceGrantLister.ListByFromNamespace(namespace)
+	})
+}
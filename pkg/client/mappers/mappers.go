@@ -0,0 +1,496 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package mappers maps changes to the resources Gateway API objects depend on,
+// such as Secrets, Services and Namespaces, to the Gateway API objects to
+// reconcile, so that controllers track their dependencies consistently.
+//
+// The map functions are built on the generated listers, and can be used as
+// client-go event handlers with EventHandler, or as controller-runtime
+// handler.MapFuncs with HandlerMapFunc.
+package mappers
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/cache"
+
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+	"sigs.k8s.io/gateway-api/pkg/client/indexers"
+	listersv1 "sigs.k8s.io/gateway-api/pkg/client/listers/apis/v1"
+)
+
+// MapFunc maps a changed object to the namespaced names of the objects to
+// reconcile.
+type MapFunc func(obj any) []types.NamespacedName
+
+// RouteLister is implemented by the generated listers of all the Route kinds,
+// e.g. listersv1.HTTPRouteLister is a RouteLister[*gatewayv1.HTTPRoute].
+type RouteLister[T metav1.Object] interface {
+	List(selector labels.Selector) ([]T, error)
+	ListByBackendService(service types.NamespacedName) ([]T, error)
+}
+
+// EventHandler returns a client-go event handler calling enqueue with the
+// namespaced names the added, updated and deleted objects are mapped to by
+// mapFuncs, e.g.:
+//
+//	secretInformer.AddEventHandler(mappers.EventHandler(
+//		func(nn types.NamespacedName) { queue.Add(nn.String()) },
+//		mappers.GatewaysForSecret(gatewayLister, referenceGrantLister)))
+//
+// Both the old and the new version of updated objects are mapped, as an
+// update may remove a dependency.
+func EventHandler(enqueue func(types.NamespacedName), mapFuncs ...MapFunc) cache.ResourceEventHandlerFuncs {
+	handle := func(objs ...any) {
+		var nns []types.NamespacedName
+		for _, obj := range objs {
+			if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			for _, mapFunc := range mapFuncs {
+				for _, nn := range mapFunc(obj) {
+					if !slices.Contains(nns, nn) {
+						nns = append(nns, nn)
+					}
+				}
+			}
+		}
+		for _, nn := range nns {
+			enqueue(nn)
+		}
+	}
+	return cache.ResourceEventHandlerFuncs{
+		AddFunc:    func(obj any) { handle(obj) },
+		UpdateFunc: func(oldObj, newObj any) { handle(oldObj, newObj) },
+		DeleteFunc: func(obj any) { handle(obj) },
+	}
+}
+
+// HandlerMapFunc adapts mapFunc to controller-runtime, whose handler.MapFunc
+// is HandlerMapFunc[client.Object, reconcile.Request], e.g.:
+//
+//	handler.EnqueueRequestsFromMapFunc(mappers.HandlerMapFunc[client.Object, reconcile.Request](
+//		mappers.GatewaysForGatewayClass(gatewayLister)))
+func HandlerMapFunc[O any, R ~struct{ types.NamespacedName }](mapFunc MapFunc) func(ctx context.Context, obj O) []R {
+	return func(_ context.Context, obj O) []R {
+		nns := mapFunc(obj)
+		if len(nns) == 0 {
+			return nil
+		}
+		requests := make([]R, 0, len(nns))
+		for _, nn := range nns {
+			requests = append(requests, R(struct{ types.NamespacedName }{nn}))
+		}
+		return requests
+	}
+}
+
+// GatewaysForGatewayClass maps a GatewayClass to the Gateways of the class.
+func GatewaysForGatewayClass(gateways listersv1.GatewayLister) MapFunc {
+	return func(obj any) []types.NamespacedName {
+		class, ok := objectName(obj)
+		if !ok {
+			return nil
+		}
+		gws, err := gateways.List(labels.Everything())
+		if err != nil {
+			utilruntime.HandleError(fmt.Errorf("listing Gateways of GatewayClass %s: %w", class.Name, err))
+			return nil
+		}
+		var nns []types.NamespacedName
+		for _, gw := range gws {
+			if string(gw.Spec.GatewayClassName) == class.Name {
+				nns = append(nns, namespacedName(gw))
+			}
+		}
+		return nns
+	}
+}
+
+// GatewaysForSecret maps a Secret to the Gateways whose listeners reference it
+// as a certificate, from the namespace of the Secret or with a ReferenceGrant
+// permitting the reference. If referenceGrants is nil, references from other
+// namespaces are mapped regardless of ReferenceGrants.
+func GatewaysForSecret(gateways listersv1.GatewayLister, referenceGrants listersv1.ReferenceGrantLister) MapFunc {
+	return func(obj any) []types.NamespacedName {
+		secret, ok := objectName(obj)
+		if !ok {
+			return nil
+		}
+		gws, err := gateways.ListByCertificateSecret(secret)
+		if err != nil {
+			utilruntime.HandleError(fmt.Errorf("listing Gateways referencing Secret %s: %w", secret, err))
+			return nil
+		}
+		return permittedReferrers(gws, "Gateway", secret, referenceGrants)
+	}
+}
+
+// ListenerSetsForSecret maps a Secret to the ListenerSets whose listeners
+// reference it as a certificate, from the namespace of the Secret or with a
+// ReferenceGrant permitting the reference. If referenceGrants is nil,
+// references from other namespaces are mapped regardless of ReferenceGrants.
+func ListenerSetsForSecret(listenerSets listersv1.ListenerSetLister, referenceGrants listersv1.ReferenceGrantLister) MapFunc {
+	return func(obj any) []types.NamespacedName {
+		secret, ok := objectName(obj)
+		if !ok {
+			return nil
+		}
+		lss, err := listenerSets.ListByCertificateSecret(secret)
+		if err != nil {
+			utilruntime.HandleError(fmt.Errorf("listing ListenerSets referencing Secret %s: %w", secret, err))
+			return nil
+		}
+		return permittedReferrers(lss, "ListenerSet", secret, referenceGrants)
+	}
+}
+
+// GatewaysForReferenceGrant maps a ReferenceGrant to the Gateways it may
+// permit, or have permitted, to reference Secrets as certificates: the
+// Gateways of the namespaces it grants references from whose listeners
+// reference Secrets of its namespace.
+func GatewaysForReferenceGrant(gateways listersv1.GatewayLister) MapFunc {
+	return func(obj any) []types.NamespacedName {
+		var nns []types.NamespacedName
+		for _, namespace := range grantedNamespaces(obj, "Gateway") {
+			gws, err := gateways.Gateways(namespace).List(labels.Everything())
+			if err != nil {
+				utilruntime.HandleError(fmt.Errorf("listing Gateways in namespace %s: %w", namespace, err))
+				continue
+			}
+			nns = append(nns, referrersInNamespace(gws, grantNamespace(obj))...)
+		}
+		return nns
+	}
+}
+
+// ListenerSetsForReferenceGrant maps a ReferenceGrant to the ListenerSets it
+// may permit, or have permitted, to reference Secrets as certificates: the
+// ListenerSets of the namespaces it grants references from whose listeners
+// reference Secrets of its namespace.
+func ListenerSetsForReferenceGrant(listenerSets listersv1.ListenerSetLister) MapFunc {
+	return func(obj any) []types.NamespacedName {
+		var nns []types.NamespacedName
+		for _, namespace := range grantedNamespaces(obj, "ListenerSet") {
+			lss, err := listenerSets.ListenerSets(namespace).List(labels.Everything())
+			if err != nil {
+				utilruntime.HandleError(fmt.Errorf("listing ListenerSets in namespace %s: %w", namespace, err))
+				continue
+			}
+			nns = append(nns, referrersInNamespace(lss, grantNamespace(obj))...)
+		}
+		return nns
+	}
+}
+
+// ListenerSetsForNamespace maps a Namespace to its ListenerSets whose parent
+// Gateway selects the namespaces ListenerSets may be attached from with a
+// label selector, as changing the labels of the Namespace may attach or
+// detach them.
+func ListenerSetsForNamespace(listenerSets listersv1.ListenerSetLister, gateways listersv1.GatewayLister) MapFunc {
+	return func(obj any) []types.NamespacedName {
+		namespace, ok := objectName(obj)
+		if !ok {
+			return nil
+		}
+		selecting, err := gatewaysSelectingListenerSets(gateways)
+		if err != nil {
+			utilruntime.HandleError(err)
+			return nil
+		}
+		if selecting.Len() == 0 {
+			return nil
+		}
+		lss, err := listenerSets.ListenerSets(namespace.Name).List(labels.Everything())
+		if err != nil {
+			utilruntime.HandleError(fmt.Errorf("listing ListenerSets in namespace %s: %w", namespace.Name, err))
+			return nil
+		}
+		var nns []types.NamespacedName
+		for _, ls := range lss {
+			if selecting.HasAny(indexers.ParentGatewayKeys(ls)...) {
+				nns = append(nns, namespacedName(ls))
+			}
+		}
+		return nns
+	}
+}
+
+// RoutesForNamespace maps a Namespace to its Routes of the kind listed by
+// routes attached to a Gateway or ListenerSet listener which selects the
+// namespaces Routes may be attached from with a label selector, as changing
+// the labels of the Namespace may attach or detach them. The type of the
+// Routes must be given explicitly, e.g.:
+//
+//	mappers.RoutesForNamespace[*gatewayv1.HTTPRoute](httpRouteLister, gatewayLister, listenerSetLister)
+//
+// If listenerSets is nil, only Gateway listeners are considered.
+func RoutesForNamespace[T metav1.Object](routes RouteLister[T], gateways listersv1.GatewayLister, listenerSets listersv1.ListenerSetLister) MapFunc {
+	return func(obj any) []types.NamespacedName {
+		namespace, ok := objectName(obj)
+		if !ok {
+			return nil
+		}
+		selectingGateways, selectingListenerSets, err := parentsSelectingRoutes(gateways, listenerSets)
+		if err != nil {
+			utilruntime.HandleError(err)
+			return nil
+		}
+		if selectingGateways.Len() == 0 && selectingListenerSets.Len() == 0 {
+			return nil
+		}
+		rs, err := routes.List(labels.Everything())
+		if err != nil {
+			utilruntime.HandleError(fmt.Errorf("listing Routes: %w", err))
+			return nil
+		}
+		var nns []types.NamespacedName
+		for _, r := range rs {
+			if r.GetNamespace() != namespace.Name {
+				continue
+			}
+			if selectingGateways.HasAny(indexers.ParentGatewayKeys(r)...) ||
+				selectingListenerSets.HasAny(indexers.ParentListenerSetKeys(r)...) {
+				nns = append(nns, namespacedName(r))
+			}
+		}
+		return nns
+	}
+}
+
+// RoutesForService maps a Service to the Routes of the kind listed by routes
+// referencing it as a backend. The type of the Routes must be given
+// explicitly, e.g.:
+//
+//	mappers.RoutesForService[*gatewayv1.HTTPRoute](httpRouteLister)
+func RoutesForService[T metav1.Object](routes RouteLister[T]) MapFunc {
+	return func(obj any) []types.NamespacedName {
+		service, ok := objectName(obj)
+		if !ok {
+			return nil
+		}
+		rs, err := routes.ListByBackendService(service)
+		if err != nil {
+			utilruntime.HandleError(fmt.Errorf("listing Routes referencing Service %s: %w", service, err))
+			return nil
+		}
+		return namespacedNames(rs)
+	}
+}
+
+// BackendTLSPoliciesForService maps a Service to the BackendTLSPolicies
+// targeting it.
+func BackendTLSPoliciesForService(policies listersv1.BackendTLSPolicyLister) MapFunc {
+	return func(obj any) []types.NamespacedName {
+		service, ok := objectName(obj)
+		if !ok {
+			return nil
+		}
+		ps, err := policies.ListByTargetService(service)
+		if err != nil {
+			utilruntime.HandleError(fmt.Errorf("listing BackendTLSPolicies targeting Service %s: %w", service, err))
+			return nil
+		}
+		return namespacedNames(ps)
+	}
+}
+
+// permittedReferrers returns the namespaced names of the objects of kind
+// referencing secret, from its namespace or with a ReferenceGrant permitting
+// it.
+func permittedReferrers[T metav1.Object](objs []T, kind string, secret types.NamespacedName, referenceGrants listersv1.ReferenceGrantLister) []types.NamespacedName {
+	var nns []types.NamespacedName
+	for _, obj := range objs {
+		if obj.GetNamespace() == secret.Namespace || referenceGrants == nil {
+			nns = append(nns, namespacedName(obj))
+			continue
+		}
+		permitted, err := secretReferencePermitted(referenceGrants, kind, obj.GetNamespace(), secret)
+		if err != nil {
+			utilruntime.HandleError(err)
+			continue
+		}
+		if permitted {
+			nns = append(nns, namespacedName(obj))
+		}
+	}
+	return nns
+}
+
+// secretReferencePermitted returns whether a ReferenceGrant permits objects of
+// kind in namespace to reference secret.
+func secretReferencePermitted(referenceGrants listersv1.ReferenceGrantLister, kind, namespace string, secret types.NamespacedName) (bool, error) {
+	grants, err := referenceGrants.ReferenceGrants(secret.Namespace).List(labels.Everything())
+	if err != nil {
+		return false, fmt.Errorf("listing ReferenceGrants in namespace %s: %w", secret.Namespace, err)
+	}
+	for _, grant := range grants {
+		from := slices.ContainsFunc(grant.Spec.From, func(f gatewayv1.ReferenceGrantFrom) bool {
+			return f.Group == gatewayv1.GroupName && string(f.Kind) == kind && string(f.Namespace) == namespace
+		})
+		to := slices.ContainsFunc(grant.Spec.To, func(t gatewayv1.ReferenceGrantTo) bool {
+			return t.Group == "" && t.Kind == "Secret" && (t.Name == nil || string(*t.Name) == secret.Name)
+		})
+		if from && to {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// grantedNamespaces returns the namespaces a ReferenceGrant grants references
+// to Secrets from for objects of kind.
+func grantedNamespaces(obj any, kind string) []string {
+	var spec *gatewayv1.ReferenceGrantSpec
+	switch o := obj.(type) {
+	case *gatewayv1.ReferenceGrant:
+		spec = &o.Spec
+	case *gatewayv1beta1.ReferenceGrant:
+		spec = &o.Spec
+	default:
+		return nil
+	}
+	if !slices.ContainsFunc(spec.To, func(t gatewayv1.ReferenceGrantTo) bool {
+		return t.Group == "" && t.Kind == "Secret"
+	}) {
+		return nil
+	}
+	var namespaces []string
+	for _, f := range spec.From {
+		if f.Group == gatewayv1.GroupName && string(f.Kind) == kind && !slices.Contains(namespaces, string(f.Namespace)) {
+			namespaces = append(namespaces, string(f.Namespace))
+		}
+	}
+	return namespaces
+}
+
+// grantNamespace returns the namespace of a ReferenceGrant.
+func grantNamespace(obj any) string {
+	if o, err := meta.Accessor(obj); err == nil {
+		return o.GetNamespace()
+	}
+	return ""
+}
+
+// referrersInNamespace returns the namespaced names of the objects
+// referencing Secrets of namespace as certificates.
+func referrersInNamespace[T metav1.Object](objs []T, namespace string) []types.NamespacedName {
+	var nns []types.NamespacedName
+	for _, obj := range objs {
+		if slices.ContainsFunc(indexers.CertificateSecretKeys(obj), func(key string) bool {
+			ns, _, err := cache.SplitMetaNamespaceKey(key)
+			return err == nil && ns == namespace
+		}) {
+			nns = append(nns, namespacedName(obj))
+		}
+	}
+	return nns
+}
+
+// gatewaysSelectingListenerSets returns the keys of the Gateways allowing
+// ListenerSets from the namespaces selected by a label selector.
+func gatewaysSelectingListenerSets(gateways listersv1.GatewayLister) (sets.Set[string], error) {
+	gws, err := gateways.List(labels.Everything())
+	if err != nil {
+		return nil, fmt.Errorf("listing Gateways: %w", err)
+	}
+	keys := sets.New[string]()
+	for _, gw := range gws {
+		if allowed := gw.Spec.AllowedListeners; allowed != nil && allowed.Namespaces != nil &&
+			fromSelector(allowed.Namespaces.From) {
+			keys.Insert(namespacedName(gw).String())
+		}
+	}
+	return keys, nil
+}
+
+// parentsSelectingRoutes returns the keys of the Gateways and ListenerSets
+// with a listener allowing Routes from the namespaces selected by a label
+// selector.
+func parentsSelectingRoutes(gateways listersv1.GatewayLister, listenerSets listersv1.ListenerSetLister) (sets.Set[string], sets.Set[string], error) {
+	gws, err := gateways.List(labels.Everything())
+	if err != nil {
+		return nil, nil, fmt.Errorf("listing Gateways: %w", err)
+	}
+	gatewayKeys := sets.New[string]()
+	for _, gw := range gws {
+		for _, l := range gw.Spec.Listeners {
+			if selectsRoutes(l.AllowedRoutes) {
+				gatewayKeys.Insert(namespacedName(gw).String())
+				break
+			}
+		}
+	}
+
+	listenerSetKeys := sets.New[string]()
+	if listenerSets == nil {
+		return gatewayKeys, listenerSetKeys, nil
+	}
+	lss, err := listenerSets.List(labels.Everything())
+	if err != nil {
+		return nil, nil, fmt.Errorf("listing ListenerSets: %w", err)
+	}
+	for _, ls := range lss {
+		for _, l := range ls.Spec.Listeners {
+			if selectsRoutes(l.AllowedRoutes) {
+				listenerSetKeys.Insert(namespacedName(ls).String())
+				break
+			}
+		}
+	}
+	return gatewayKeys, listenerSetKeys, nil
+}
+
+func selectsRoutes(allowed *gatewayv1.AllowedRoutes) bool {
+	return allowed != nil && allowed.Namespaces != nil && fromSelector(allowed.Namespaces.From)
+}
+
+func fromSelector(from *gatewayv1.FromNamespaces) bool {
+	return from != nil && *from == gatewayv1.NamespacesFromSelector
+}
+
+// objectName returns the namespaced name of obj, or false if obj is not a
+// Kubernetes object.
+func objectName(obj any) (types.NamespacedName, bool) {
+	o, err := meta.Accessor(obj)
+	if err != nil {
+		utilruntime.HandleError(fmt.Errorf("mapping object of type %T: %w", obj, err))
+		return types.NamespacedName{}, false
+	}
+	return types.NamespacedName{Namespace: o.GetNamespace(), Name: o.GetName()}, true
+}
+
+func namespacedName(obj metav1.Object) types.NamespacedName {
+	return types.NamespacedName{Namespace: obj.GetNamespace(), Name: obj.GetName()}
+}
+
+func namespacedNames[T metav1.Object](objs []T) []types.NamespacedName {
+	var nns []types.NamespacedName
+	for _, obj := range objs {
+		nns = append(nns, namespacedName(obj))
+	}
+	return nns
+}
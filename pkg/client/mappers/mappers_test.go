@@ -0,0 +1,243 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mappers_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/utils/ptr"
+
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	listersv1 "sigs.k8s.io/gateway-api/pkg/client/listers/apis/v1"
+	"sigs.k8s.io/gateway-api/pkg/client/mappers"
+)
+
+func newIndexer(t *testing.T, objs ...runtime.Object) cache.Indexer {
+	t.Helper()
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	for _, obj := range objs {
+		require.NoError(t, indexer.Add(obj))
+	}
+	return indexer
+}
+
+func nn(namespace, name string) types.NamespacedName {
+	return types.NamespacedName{Namespace: namespace, Name: name}
+}
+
+func gateway(namespace, name string, certificateRefs ...gatewayv1.SecretObjectReference) *gatewayv1.Gateway {
+	return &gatewayv1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Spec: gatewayv1.GatewaySpec{
+			GatewayClassName: "class",
+			Listeners: []gatewayv1.Listener{{
+				Name: "https",
+				TLS:  &gatewayv1.ListenerTLSConfig{CertificateRefs: certificateRefs},
+			}},
+		},
+	}
+}
+
+func httpRoute(namespace, name string, parentRefs ...gatewayv1.ParentReference) *gatewayv1.HTTPRoute {
+	return &gatewayv1.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Spec: gatewayv1.HTTPRouteSpec{
+			CommonRouteSpec: gatewayv1.CommonRouteSpec{ParentRefs: parentRefs},
+			Rules: []gatewayv1.HTTPRouteRule{{
+				BackendRefs: []gatewayv1.HTTPBackendRef{{BackendRef: gatewayv1.BackendRef{
+					BackendObjectReference: gatewayv1.BackendObjectReference{Name: "svc"},
+				}}},
+			}},
+		},
+	}
+}
+
+func secret(namespace, name string) *corev1.Secret {
+	return &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace}}
+}
+
+func namespace(name string) *corev1.Namespace {
+	return &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name}}
+}
+
+func TestSecretMappers(t *testing.T) {
+	certsRef := gatewayv1.SecretObjectReference{Name: "cert", Namespace: ptr.To(gatewayv1.Namespace("certs"))}
+	gateways := listersv1.NewGatewayLister(newIndexer(t,
+		gateway("certs", "same-namespace", gatewayv1.SecretObjectReference{Name: "cert"}),
+		gateway("granted", "gw", certsRef),
+		gateway("not-granted", "gw", certsRef),
+		gateway("granted", "other-cert", gatewayv1.SecretObjectReference{Name: "other", Namespace: ptr.To(gatewayv1.Namespace("certs"))}),
+	))
+	listenerSets := listersv1.NewListenerSetLister(newIndexer(t,
+		&gatewayv1.ListenerSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "ls", Namespace: "granted"},
+			Spec: gatewayv1.ListenerSetSpec{Listeners: []gatewayv1.ListenerEntry{{
+				Name: "https",
+				TLS:  &gatewayv1.ListenerTLSConfig{CertificateRefs: []gatewayv1.SecretObjectReference{certsRef}},
+			}}},
+		},
+	))
+	grant := &gatewayv1.ReferenceGrant{
+		ObjectMeta: metav1.ObjectMeta{Name: "grant", Namespace: "certs"},
+		Spec: gatewayv1.ReferenceGrantSpec{
+			From: []gatewayv1.ReferenceGrantFrom{
+				{Group: gatewayv1.GroupName, Kind: "Gateway", Namespace: "granted"},
+				{Group: gatewayv1.GroupName, Kind: "ListenerSet", Namespace: "granted"},
+			},
+			To: []gatewayv1.ReferenceGrantTo{{Group: "", Kind: "Secret", Name: ptr.To(gatewayv1.ObjectName("cert"))}},
+		},
+	}
+	referenceGrants := listersv1.NewReferenceGrantLister(newIndexer(t, grant))
+
+	require.ElementsMatch(t,
+		[]types.NamespacedName{nn("certs", "same-namespace"), nn("granted", "gw")},
+		mappers.GatewaysForSecret(gateways, referenceGrants)(secret("certs", "cert")))
+	require.Empty(t, mappers.GatewaysForSecret(gateways, referenceGrants)(secret("certs", "other")))
+	require.ElementsMatch(t,
+		[]types.NamespacedName{nn("certs", "same-namespace"), nn("granted", "gw"), nn("not-granted", "gw")},
+		mappers.GatewaysForSecret(gateways, nil)(secret("certs", "cert")))
+	require.Equal(t,
+		[]types.NamespacedName{nn("granted", "ls")},
+		mappers.ListenerSetsForSecret(listenerSets, referenceGrants)(secret("certs", "cert")))
+
+	require.ElementsMatch(t,
+		[]types.NamespacedName{nn("granted", "gw"), nn("granted", "other-cert")},
+		mappers.GatewaysForReferenceGrant(gateways)(grant))
+	require.Equal(t,
+		[]types.NamespacedName{nn("granted", "ls")},
+		mappers.ListenerSetsForReferenceGrant(listenerSets)(grant))
+}
+
+func TestNamespaceMappers(t *testing.T) {
+	selector := &gatewayv1.RouteNamespaces{
+		From:     ptr.To(gatewayv1.NamespacesFromSelector),
+		Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"routes": "allowed"}},
+	}
+	selecting := gateway("infra", "selecting")
+	selecting.Spec.Listeners[0].AllowedRoutes = &gatewayv1.AllowedRoutes{Namespaces: selector}
+	selecting.Spec.AllowedListeners = &gatewayv1.AllowedListeners{Namespaces: &gatewayv1.ListenerNamespaces{
+		From: ptr.To(gatewayv1.NamespacesFromSelector),
+	}}
+	gateways := listersv1.NewGatewayLister(newIndexer(t, selecting, gateway("infra", "all")))
+	listenerSets := listersv1.NewListenerSetLister(newIndexer(t,
+		&gatewayv1.ListenerSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "ls", Namespace: "apps"},
+			Spec: gatewayv1.ListenerSetSpec{
+				ParentRef: gatewayv1.ParentGatewayReference{Name: "selecting", Namespace: ptr.To(gatewayv1.Namespace("infra"))},
+				Listeners: []gatewayv1.ListenerEntry{{Name: "http", AllowedRoutes: &gatewayv1.AllowedRoutes{Namespaces: selector}}},
+			},
+		},
+		&gatewayv1.ListenerSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "other-ls", Namespace: "apps"},
+			Spec: gatewayv1.ListenerSetSpec{
+				ParentRef: gatewayv1.ParentGatewayReference{Name: "all", Namespace: ptr.To(gatewayv1.Namespace("infra"))},
+			},
+		},
+	))
+	routes := listersv1.NewHTTPRouteLister(newIndexer(t,
+		httpRoute("apps", "selecting", gatewayv1.ParentReference{Name: "selecting", Namespace: ptr.To(gatewayv1.Namespace("infra"))}),
+		httpRoute("apps", "listener-set", gatewayv1.ParentReference{Name: "ls", Kind: ptr.To(gatewayv1.Kind("ListenerSet"))}),
+		httpRoute("apps", "all", gatewayv1.ParentReference{Name: "all", Namespace: ptr.To(gatewayv1.Namespace("infra"))}),
+		httpRoute("other", "selecting", gatewayv1.ParentReference{Name: "selecting", Namespace: ptr.To(gatewayv1.Namespace("infra"))}),
+	))
+
+	require.ElementsMatch(t,
+		[]types.NamespacedName{nn("apps", "selecting"), nn("apps", "listener-set")},
+		mappers.RoutesForNamespace[*gatewayv1.HTTPRoute](routes, gateways, listenerSets)(namespace("apps")))
+	require.Equal(t,
+		[]types.NamespacedName{nn("apps", "selecting")},
+		mappers.RoutesForNamespace[*gatewayv1.HTTPRoute](routes, gateways, nil)(namespace("apps")))
+	require.Equal(t,
+		[]types.NamespacedName{nn("apps", "ls")},
+		mappers.ListenerSetsForNamespace(listenerSets, gateways)(namespace("apps")))
+
+	notSelecting := listersv1.NewGatewayLister(newIndexer(t, gateway("infra", "all")))
+	require.Empty(t, mappers.RoutesForNamespace[*gatewayv1.HTTPRoute](routes, notSelecting, nil)(namespace("apps")))
+	require.Empty(t, mappers.ListenerSetsForNamespace(listenerSets, notSelecting)(namespace("apps")))
+}
+
+func TestServiceMappers(t *testing.T) {
+	routes := listersv1.NewHTTPRouteLister(newIndexer(t, httpRoute("apps", "route"), httpRoute("other", "route")))
+	policies := listersv1.NewBackendTLSPolicyLister(newIndexer(t, &gatewayv1.BackendTLSPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "policy", Namespace: "apps"},
+		Spec: gatewayv1.BackendTLSPolicySpec{
+			TargetRefs: []gatewayv1.LocalPolicyTargetReferenceWithSectionName{{
+				LocalPolicyTargetReference: gatewayv1.LocalPolicyTargetReference{Group: "", Kind: "Service", Name: "svc"},
+			}},
+		},
+	}))
+	service := &corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "svc", Namespace: "apps"}}
+
+	require.Equal(t,
+		[]types.NamespacedName{nn("apps", "route")},
+		mappers.RoutesForService[*gatewayv1.HTTPRoute](routes)(service))
+	require.Equal(t,
+		[]types.NamespacedName{nn("apps", "policy")},
+		mappers.BackendTLSPoliciesForService(policies)(service))
+}
+
+func TestGatewaysForGatewayClass(t *testing.T) {
+	other := gateway("infra", "other")
+	other.Spec.GatewayClassName = "other"
+	gateways := listersv1.NewGatewayLister(newIndexer(t, gateway("infra", "gw"), gateway("apps", "gw"), other))
+
+	require.ElementsMatch(t,
+		[]types.NamespacedName{nn("infra", "gw"), nn("apps", "gw")},
+		mappers.GatewaysForGatewayClass(gateways)(&gatewayv1.GatewayClass{ObjectMeta: metav1.ObjectMeta{Name: "class"}}))
+}
+
+func TestEventHandler(t *testing.T) {
+	gateways := listersv1.NewGatewayLister(newIndexer(t,
+		gateway("certs", "gw", gatewayv1.SecretObjectReference{Name: "cert"}, gatewayv1.SecretObjectReference{Name: "other"}),
+		gateway("certs", "other", gatewayv1.SecretObjectReference{Name: "other"}),
+	))
+
+	var enqueued []types.NamespacedName
+	handler := mappers.EventHandler(func(nn types.NamespacedName) {
+		enqueued = append(enqueued, nn)
+	}, mappers.GatewaysForSecret(gateways, nil))
+
+	handler.OnAdd(secret("certs", "cert"), false)
+	require.Equal(t, []types.NamespacedName{nn("certs", "gw")}, enqueued)
+
+	enqueued = nil
+	handler.OnUpdate(secret("certs", "cert"), secret("certs", "other"))
+	require.Equal(t, []types.NamespacedName{nn("certs", "gw"), nn("certs", "other")}, enqueued)
+
+	enqueued = nil
+	handler.OnDelete(cache.DeletedFinalStateUnknown{Key: "certs/cert", Obj: secret("certs", "cert")})
+	require.Equal(t, []types.NamespacedName{nn("certs", "gw")}, enqueued)
+}
+
+func TestHandlerMapFunc(t *testing.T) {
+	type request struct{ types.NamespacedName }
+
+	gateways := listersv1.NewGatewayLister(newIndexer(t, gateway("infra", "gw")))
+	mapFunc := mappers.HandlerMapFunc[metav1.Object, request](mappers.GatewaysForGatewayClass(gateways))
+
+	require.Equal(t,
+		[]request{{nn("infra", "gw")}},
+		mapFunc(context.Background(), &gatewayv1.GatewayClass{ObjectMeta: metav1.ObjectMeta{Name: "class"}}))
+	require.Nil(t, mapFunc(context.Background(), &gatewayv1.GatewayClass{ObjectMeta: metav1.ObjectMeta{Name: "other"}}))
+}
@@ -0,0 +1,248 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package statusapply applies the status of Gateway API objects with
+// server-side apply and the generated apply configurations, for controllers
+// sharing objects with other controllers.
+//
+// The conditions, listeners and supported features of Gateways, ListenerSets
+// and XMeshes are maps: each controller applies only the entries it sets,
+// with its own field manager, and the entries of other controllers, e.g. the
+// conditions set by policy controllers, are kept.
+//
+// The parents of routes and the ancestors of policies are atomic lists shared
+// by the controllers, each one owning the entries of its controller name.
+// Server-side apply can't merge them, so a controller applies the whole list:
+// the entries of the other controllers as read from the object, and its own.
+// The resource version of the object is applied too, so that an apply racing
+// with another controller fails with a conflict, and is retried on the latest
+// object, instead of dropping the entries of the other controller.
+package statusapply
+
+import (
+	"context"
+	"encoding/json"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	apisxv1alpha1 "sigs.k8s.io/gateway-api/apisx/v1alpha1"
+	applyv1 "sigs.k8s.io/gateway-api/applyconfiguration/apis/v1"
+	applyxv1alpha1 "sigs.k8s.io/gateway-api/applyconfiguration/apisx/v1alpha1"
+	"sigs.k8s.io/gateway-api/pkg/client/clientset/versioned"
+)
+
+// Applier applies the status of Gateway API objects as a controller.
+type Applier struct {
+	client         versioned.Interface
+	controllerName gatewayv1.GatewayController
+	fieldManager   string
+}
+
+// NewApplier returns an Applier applying status as the controller
+// controllerName, with the field manager fieldManager.
+func NewApplier(client versioned.Interface, controllerName gatewayv1.GatewayController, fieldManager string) *Applier {
+	return &Applier{client: client, controllerName: controllerName, fieldManager: fieldManager}
+}
+
+func (a *Applier) applyOptions() metav1.ApplyOptions {
+	return metav1.ApplyOptions{FieldManager: a.fieldManager, Force: true}
+}
+
+// GatewayStatus applies status to the Gateway namespace/name.
+func (a *Applier) GatewayStatus(ctx context.Context, namespace, name string, status *applyv1.GatewayStatusApplyConfiguration) (*gatewayv1.Gateway, error) {
+	return a.client.GatewayV1().Gateways(namespace).ApplyStatus(ctx, applyv1.Gateway(name, namespace).WithStatus(status), a.applyOptions())
+}
+
+// ListenerSetStatus applies status to the ListenerSet namespace/name.
+func (a *Applier) ListenerSetStatus(ctx context.Context, namespace, name string, status *applyv1.ListenerSetStatusApplyConfiguration) (*gatewayv1.ListenerSet, error) {
+	return a.client.GatewayV1().ListenerSets(namespace).ApplyStatus(ctx, applyv1.ListenerSet(name, namespace).WithStatus(status), a.applyOptions())
+}
+
+// XMeshStatus applies status to the XMesh name.
+func (a *Applier) XMeshStatus(ctx context.Context, name string, status *applyxv1alpha1.MeshStatusApplyConfiguration) (*apisxv1alpha1.XMesh, error) {
+	return a.client.ExperimentalV1alpha1().XMeshes().ApplyStatus(ctx, applyxv1alpha1.XMesh(name).WithStatus(status), a.applyOptions())
+}
+
+// HTTPRouteParents applies parents as the parents of the controller in the
+// status of the HTTPRoute namespace/name, replacing the previous ones.
+func (a *Applier) HTTPRouteParents(ctx context.Context, namespace, name string, parents ...*applyv1.RouteParentStatusApplyConfiguration) (*gatewayv1.HTTPRoute, error) {
+	return applyShared(ctx, a.client.GatewayV1().HTTPRoutes(namespace), name, "parents", a.applyOptions(), func(route *gatewayv1.HTTPRoute) (*applyv1.HTTPRouteApplyConfiguration, int, error) {
+		merged, err := RouteParents(route.Status.Parents, a.controllerName, parents...)
+		return applyv1.HTTPRoute(name, namespace).
+			WithResourceVersion(route.ResourceVersion).
+			WithStatus(applyv1.HTTPRouteStatus().WithParents(merged...)), len(merged), err
+	})
+}
+
+// GRPCRouteParents applies parents as the parents of the controller in the
+// status of the GRPCRoute namespace/name, replacing the previous ones.
+func (a *Applier) GRPCRouteParents(ctx context.Context, namespace, name string, parents ...*applyv1.RouteParentStatusApplyConfiguration) (*gatewayv1.GRPCRoute, error) {
+	return applyShared(ctx, a.client.GatewayV1().GRPCRoutes(namespace), name, "parents", a.applyOptions(), func(route *gatewayv1.GRPCRoute) (*applyv1.GRPCRouteApplyConfiguration, int, error) {
+		merged, err := RouteParents(route.Status.Parents, a.controllerName, parents...)
+		return applyv1.GRPCRoute(name, namespace).
+			WithResourceVersion(route.ResourceVersion).
+			WithStatus(applyv1.GRPCRouteStatus().WithParents(merged...)), len(merged), err
+	})
+}
+
+// TCPRouteParents applies parents as the parents of the controller in the
+// status of the TCPRoute namespace/name, replacing the previous ones.
+func (a *Applier) TCPRouteParents(ctx context.Context, namespace, name string, parents ...*applyv1.RouteParentStatusApplyConfiguration) (*gatewayv1.TCPRoute, error) {
+	return applyShared(ctx, a.client.GatewayV1().TCPRoutes(namespace), name, "parents", a.applyOptions(), func(route *gatewayv1.TCPRoute) (*applyv1.TCPRouteApplyConfiguration, int, error) {
+		merged, err := RouteParents(route.Status.Parents, a.controllerName, parents...)
+		return applyv1.TCPRoute(name, namespace).
+			WithResourceVersion(route.ResourceVersion).
+			WithStatus(applyv1.TCPRouteStatus().WithParents(merged...)), len(merged), err
+	})
+}
+
+// TLSRouteParents applies parents as the parents of the controller in the
+// status of the TLSRoute namespace/name, replacing the previous ones.
+func (a *Applier) TLSRouteParents(ctx context.Context, namespace, name string, parents ...*applyv1.RouteParentStatusApplyConfiguration) (*gatewayv1.TLSRoute, error) {
+	return applyShared(ctx, a.client.GatewayV1().TLSRoutes(namespace), name, "parents", a.applyOptions(), func(route *gatewayv1.TLSRoute) (*applyv1.TLSRouteApplyConfiguration, int, error) {
+		merged, err := RouteParents(route.Status.Parents, a.controllerName, parents...)
+		return applyv1.TLSRoute(name, namespace).
+			WithResourceVersion(route.ResourceVersion).
+			WithStatus(applyv1.TLSRouteStatus().WithParents(merged...)), len(merged), err
+	})
+}
+
+// UDPRouteParents applies parents as the parents of the controller in the
+// status of the UDPRoute namespace/name, replacing the previous ones.
+func (a *Applier) UDPRouteParents(ctx context.Context, namespace, name string, parents ...*applyv1.RouteParentStatusApplyConfiguration) (*gatewayv1.UDPRoute, error) {
+	return applyShared(ctx, a.client.GatewayV1().UDPRoutes(namespace), name, "parents", a.applyOptions(), func(route *gatewayv1.UDPRoute) (*applyv1.UDPRouteApplyConfiguration, int, error) {
+		merged, err := RouteParents(route.Status.Parents, a.controllerName, parents...)
+		return applyv1.UDPRoute(name, namespace).
+			WithResourceVersion(route.ResourceVersion).
+			WithStatus(applyv1.UDPRouteStatus().WithParents(merged...)), len(merged), err
+	})
+}
+
+// BackendTLSPolicyAncestors applies ancestors as the ancestors of the
+// controller in the status of the BackendTLSPolicy namespace/name, replacing
+// the previous ones.
+func (a *Applier) BackendTLSPolicyAncestors(ctx context.Context, namespace, name string, ancestors ...*applyv1.PolicyAncestorStatusApplyConfiguration) (*gatewayv1.BackendTLSPolicy, error) {
+	return applyShared(ctx, a.client.GatewayV1().BackendTLSPolicies(namespace), name, "ancestors", a.applyOptions(), func(policy *gatewayv1.BackendTLSPolicy) (*applyv1.BackendTLSPolicyApplyConfiguration, int, error) {
+		merged, err := PolicyAncestors(policy.Status.Ancestors, a.controllerName, ancestors...)
+		return applyv1.BackendTLSPolicy(name, namespace).
+			WithResourceVersion(policy.ResourceVersion).
+			WithStatus(applyv1.PolicyStatus().WithAncestors(merged...)), len(merged), err
+	})
+}
+
+// XBackendTrafficPolicyAncestors applies ancestors as the ancestors of the
+// controller in the status of the XBackendTrafficPolicy namespace/name,
+// replacing the previous ones.
+func (a *Applier) XBackendTrafficPolicyAncestors(ctx context.Context, namespace, name string, ancestors ...*applyv1.PolicyAncestorStatusApplyConfiguration) (*apisxv1alpha1.XBackendTrafficPolicy, error) {
+	return applyShared(ctx, a.client.ExperimentalV1alpha1().XBackendTrafficPolicies(namespace), name, "ancestors", a.applyOptions(), func(policy *apisxv1alpha1.XBackendTrafficPolicy) (*applyxv1alpha1.XBackendTrafficPolicyApplyConfiguration, int, error) {
+		merged, err := PolicyAncestors(policy.Status.Ancestors, a.controllerName, ancestors...)
+		return applyxv1alpha1.XBackendTrafficPolicy(name, namespace).
+			WithResourceVersion(policy.ResourceVersion).
+			WithStatus(applyv1.PolicyStatus().WithAncestors(merged...)), len(merged), err
+	})
+}
+
+// RouteParents returns the route parent statuses to apply as the controller
+// controllerName, given the current ones: the parents of the other
+// controllers, followed by parents, with their controller name set.
+func RouteParents(current []gatewayv1.RouteParentStatus, controllerName gatewayv1.GatewayController, parents ...*applyv1.RouteParentStatusApplyConfiguration) ([]*applyv1.RouteParentStatusApplyConfiguration, error) {
+	return merge(current, func(p gatewayv1.RouteParentStatus) gatewayv1.GatewayController { return p.ControllerName },
+		controllerName, parents, (*applyv1.RouteParentStatusApplyConfiguration).WithControllerName)
+}
+
+// PolicyAncestors returns the policy ancestor statuses to apply as the
+// controller controllerName, given the current ones: the ancestors of the
+// other controllers, followed by ancestors, with their controller name set.
+func PolicyAncestors(current []gatewayv1.PolicyAncestorStatus, controllerName gatewayv1.GatewayController, ancestors ...*applyv1.PolicyAncestorStatusApplyConfiguration) ([]*applyv1.PolicyAncestorStatusApplyConfiguration, error) {
+	return merge(current, func(a gatewayv1.PolicyAncestorStatus) gatewayv1.GatewayController { return a.ControllerName },
+		controllerName, ancestors, (*applyv1.PolicyAncestorStatusApplyConfiguration).WithControllerName)
+}
+
+// merge returns the entries of current whose controller isn't controllerName,
+// as apply configurations, followed by own with controllerName set.
+func merge[T, AC any](
+	current []T,
+	controllerOf func(T) gatewayv1.GatewayController,
+	controllerName gatewayv1.GatewayController,
+	own []*AC,
+	withControllerName func(*AC, gatewayv1.GatewayController) *AC,
+) ([]*AC, error) {
+	var merged []*AC
+	for _, entry := range current {
+		if controllerOf(entry) == controllerName {
+			continue
+		}
+		// The entries of other controllers are applied unchanged.
+		data, err := json.Marshal(entry)
+		if err != nil {
+			return nil, err
+		}
+		ac := new(AC)
+		if err := json.Unmarshal(data, ac); err != nil {
+			return nil, err
+		}
+		merged = append(merged, ac)
+	}
+	for _, ac := range own {
+		merged = append(merged, withControllerName(ac, controllerName))
+	}
+	return merged, nil
+}
+
+// statusClient is the part of the typed clients of the clientset used to
+// apply shared status.
+type statusClient[T metav1.Object, AC any] interface {
+	Get(ctx context.Context, name string, opts metav1.GetOptions) (T, error)
+	ApplyStatus(ctx context.Context, ac AC, opts metav1.ApplyOptions) (T, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (T, error)
+}
+
+// applyShared applies the status built by build from the latest version of
+// the object name, retrying on conflicts. build returns the apply
+// configuration, and the length of the shared list listField of the status.
+func applyShared[T metav1.Object, AC any, C statusClient[T, AC]](ctx context.Context, c C, name, listField string, opts metav1.ApplyOptions, build func(T) (AC, int, error)) (T, error) {
+	var res T
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		current, err := c.Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		ac, n, err := build(current)
+		if err != nil {
+			return err
+		}
+		if n > 0 {
+			res, err = c.ApplyStatus(ctx, ac, opts)
+			return err
+		}
+
+		// The list is required, but apply configurations omit empty lists:
+		// it's emptied with a patch instead.
+		patch, err := json.Marshal(map[string]any{
+			"metadata": map[string]any{"resourceVersion": current.GetResourceVersion()},
+			"status":   map[string]any{listField: []any{}},
+		})
+		if err != nil {
+			return err
+		}
+		res, err = c.Patch(ctx, name, types.MergePatchType, patch, metav1.PatchOptions{FieldManager: opts.FieldManager}, "status")
+		return err
+	})
+	return res, err
+}
@@ -0,0 +1,86 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package statusapply_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1ac "k8s.io/client-go/applyconfigurations/meta/v1"
+	"k8s.io/utils/ptr"
+
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	applyv1 "sigs.k8s.io/gateway-api/applyconfiguration/apis/v1"
+	"sigs.k8s.io/gateway-api/pkg/client/statusapply"
+)
+
+func TestRouteParents(t *testing.T) {
+	other := gatewayv1.RouteParentStatus{
+		ParentRef:      gatewayv1.ParentReference{Name: "other", SectionName: ptr.To(gatewayv1.SectionName("http"))},
+		ControllerName: "example.com/other",
+		Conditions: []metav1.Condition{{
+			Type:               string(gatewayv1.RouteConditionAccepted),
+			Status:             metav1.ConditionTrue,
+			Reason:             string(gatewayv1.RouteReasonAccepted),
+			LastTransitionTime: metav1.Unix(1, 0),
+		}},
+	}
+	mine := gatewayv1.RouteParentStatus{
+		ParentRef:      gatewayv1.ParentReference{Name: "stale"},
+		ControllerName: "example.com/mine",
+	}
+
+	parents, err := statusapply.RouteParents([]gatewayv1.RouteParentStatus{mine, other}, "example.com/mine",
+		applyv1.RouteParentStatus().WithParentRef(applyv1.ParentReference().WithName("gw")))
+	require.NoError(t, err)
+	require.Len(t, parents, 2)
+	assert.Equal(t, &applyv1.RouteParentStatusApplyConfiguration{
+		ParentRef:      &applyv1.ParentReferenceApplyConfiguration{Name: ptr.To(gatewayv1.ObjectName("other")), SectionName: ptr.To(gatewayv1.SectionName("http"))},
+		ControllerName: ptr.To(gatewayv1.GatewayController("example.com/other")),
+		Conditions: []metav1ac.ConditionApplyConfiguration{*metav1ac.Condition().
+			WithType(string(gatewayv1.RouteConditionAccepted)).
+			WithStatus(metav1.ConditionTrue).
+			WithReason(string(gatewayv1.RouteReasonAccepted)).
+			WithMessage("").
+			WithLastTransitionTime(metav1.Unix(1, 0))},
+	}, parents[0])
+	assert.Equal(t, applyv1.RouteParentStatus().
+		WithParentRef(applyv1.ParentReference().WithName("gw")).
+		WithControllerName("example.com/mine"), parents[1])
+
+	parents, err = statusapply.RouteParents([]gatewayv1.RouteParentStatus{mine}, "example.com/mine")
+	require.NoError(t, err)
+	assert.Empty(t, parents)
+}
+
+func TestPolicyAncestors(t *testing.T) {
+	current := []gatewayv1.PolicyAncestorStatus{
+		{AncestorRef: gatewayv1.ParentReference{Name: "a"}, ControllerName: "example.com/a"},
+		{AncestorRef: gatewayv1.ParentReference{Name: "b"}, ControllerName: "example.com/b"},
+		{AncestorRef: gatewayv1.ParentReference{Name: "c"}, ControllerName: "example.com/a"},
+	}
+	ancestors, err := statusapply.PolicyAncestors(current, "example.com/b")
+	require.NoError(t, err)
+	var names []gatewayv1.ObjectName
+	for _, ancestor := range ancestors {
+		names = append(names, *ancestor.AncestorRef.Name)
+		assert.Equal(t, gatewayv1.GatewayController("example.com/a"), *ancestor.ControllerName)
+	}
+	assert.Equal(t, []gatewayv1.ObjectName{"a", "c"}, names)
+}
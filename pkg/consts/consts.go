@@ -25,6 +25,11 @@ const (
 	// the installed Gateway API channel.
 	ChannelAnnotation = "gateway.networking.k8s.io/channel"
 
+	// ConversionDataAnnotation is the annotation key used by the conversion
+	// webhook to keep the fields of older versions which can't be represented
+	// in newer ones, so that they are restored when converting back.
+	ConversionDataAnnotation = "gateway.networking.k8s.io/conversion-data"
+
 	// BundleVersion is the value used for the "gateway.networking.k8s.io/bundle-version" annotation.
 	// These value must be updated during the release process.
 	BundleVersion = "v0.0.0-dev"
@@ -61,6 +61,7 @@ var (
 			Insert(TLSRouteExtendedFeatures.UnsortedList()...).
 			Insert(TCPRouteExtendedFeatures.UnsortedList()...).
 			Insert(UDPRouteFeatures.UnsortedList()...).
+			Insert(UDPRouteExtendedFeatures.UnsortedList()...).
 			Insert(MeshCoreFeatures.UnsortedList()...).
 			Insert(MeshExtendedFeatures.UnsortedList()...).
 			Insert(GRPCRouteCoreFeatures.UnsortedList()...).
@@ -87,6 +87,15 @@ const (
 	// SupportGatewayFrontendClientCertificateValidationInsecureFallback option indicates support
 	// for the `AllowInsecureFallback` client certificate validation mode.
 	SupportGatewayFrontendClientCertificateValidationInsecureFallback FeatureName = "GatewayFrontendClientCertificateValidationInsecureFallback"
+
+	// SupportGatewayHTTP3 option indicates support for serving HTTPS listeners
+	// over HTTP/3 (QUIC), on the UDP port matching the listener port.
+	SupportGatewayHTTP3 FeatureName = "GatewayHTTP3"
+
+	// SupportGatewayHTTPSListenerMultipleCertificates option indicates support
+	// for HTTPS listeners with both an RSA and an ECDSA certificate, the one
+	// served being selected by the capabilities of the client.
+	SupportGatewayHTTPSListenerMultipleCertificates FeatureName = "GatewayHTTPSListenerMultipleCertificates"
 )
 
 var (
@@ -143,6 +152,18 @@ var (
 		Name:    SupportGatewayFrontendClientCertificateValidationInsecureFallback,
 		Channel: FeatureChannelStandard,
 	}
+
+	// GatewayHTTP3Feature contains metadata for the GatewayHTTP3 feature.
+	GatewayHTTP3Feature = Feature{
+		Name:    SupportGatewayHTTP3,
+		Channel: FeatureChannelExperimental,
+	}
+
+	// GatewayHTTPSListenerMultipleCertificatesFeature contains metadata for the GatewayHTTPSListenerMultipleCertificates feature.
+	GatewayHTTPSListenerMultipleCertificatesFeature = Feature{
+		Name:    SupportGatewayHTTPSListenerMultipleCertificates,
+		Channel: FeatureChannelStandard,
+	}
 )
 
 // GatewayExtendedFeatures are extra generic features that implementations may
@@ -157,5 +178,7 @@ var GatewayExtendedFeatures = sets.New(
 	GatewayBackendClientCertificateFeature,
 	GatewayFrontendClientCertificateValidationFeature,
 	GatewayFrontendClientCertificateValidationInsecureFallbackFeature,
+	GatewayHTTP3Feature,
+	GatewayHTTPSListenerMultipleCertificatesFeature,
 	ListenerSetFeature,
 )
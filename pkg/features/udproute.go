@@ -36,3 +36,28 @@ var UDPRouteFeature = Feature{
 // UDPRouteFeatures includes all SupportedFeatures needed to be conformant with
 // the UDPRoute resource.
 var UDPRouteFeatures = sets.New(UDPRouteFeature)
+
+// -----------------------------------------------------------------------------
+// Features - UDPRoute Conformance (Extended)
+// -----------------------------------------------------------------------------
+
+const (
+	// SupportUDPRouteFlowAffinity option indicates support for sending all
+	// the datagrams of a UDP flow, identified by its 5-tuple, to the same
+	// backend endpoint.
+	SupportUDPRouteFlowAffinity FeatureName = "UDPRouteFlowAffinity"
+)
+
+// UDPRouteFlowAffinityFeature contains metadata for the UDPRouteFlowAffinity
+// feature.
+var UDPRouteFlowAffinityFeature = Feature{
+	Name:    SupportUDPRouteFlowAffinity,
+	Channel: FeatureChannelExperimental,
+}
+
+// UDPRouteExtendedFeatures includes all extended features for UDPRoute
+// conformance and can be used to opt-in to run all UDPRoute extended feature
+// tests. This does not include any Core Features.
+var UDPRouteExtendedFeatures = sets.New(
+	UDPRouteFlowAffinityFeature,
+)
@@ -0,0 +1,211 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conversion_test
+
+import (
+	"context"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/envtest"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+	"sigs.k8s.io/gateway-api/apis/v1alpha3"
+	"sigs.k8s.io/gateway-api/crdtools/conversion"
+	"sigs.k8s.io/gateway-api/pkg/consts"
+)
+
+// newClient starts a test environment with the experimental CRDs, converted
+// by the conversion webhook, and returns a client for it.
+func newClient(t *testing.T) client.Client {
+	t.Helper()
+	scheme, err := conversion.NewScheme()
+	require.NoError(t, err)
+
+	// The CRDs are configured by envtest to use the webhook at
+	// conversion.Path, which is only called for the kinds served in several
+	// versions.
+	testEnv := &envtest.Environment{
+		ErrorIfCRDPathMissing:       true,
+		DownloadBinaryAssets:        true,
+		DownloadBinaryAssetsVersion: os.Getenv("K8S_VERSION"),
+		CRDInstallOptions: envtest.CRDInstallOptions{
+			Paths:           []string{filepath.Join("..", "..", "config", "crd", "experimental")},
+			CleanUpAfterUse: true,
+		},
+		WebhookInstallOptions: envtest.WebhookInstallOptions{
+			IgnoreSchemeConvertible: true,
+		},
+	}
+	restConfig, err := testEnv.Start()
+	require.NoError(t, err, "Error initializing test environment")
+	t.Cleanup(func() {
+		require.NoError(t, testEnv.Stop())
+	})
+
+	handler, err := conversion.NewWebhookHandler()
+	require.NoError(t, err)
+	opts := testEnv.WebhookInstallOptions
+	server := webhook.NewServer(webhook.Options{
+		Host:    opts.LocalServingHost,
+		Port:    opts.LocalServingPort,
+		CertDir: opts.LocalServingCertDir,
+	})
+	server.Register(conversion.Path, handler)
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	go func() {
+		if serveErr := server.Start(ctx); serveErr != nil {
+			t.Errorf("Error serving the conversion webhook: %v", serveErr)
+		}
+	}()
+	addr := net.JoinHostPort(opts.LocalServingHost, strconv.Itoa(opts.LocalServingPort))
+	err = wait.PollUntilContextTimeout(ctx, 100*time.Millisecond, 10*time.Second, true, func(context.Context) (bool, error) {
+		conn, dialErr := net.Dial("tcp", addr)
+		if dialErr != nil {
+			return false, nil //nolint:nilerr // the webhook is not listening yet
+		}
+		return true, conn.Close()
+	})
+	require.NoError(t, err, "Error waiting for the conversion webhook")
+
+	c, err := client.New(restConfig, client.Options{Scheme: scheme})
+	require.NoError(t, err)
+	return c
+}
+
+func backendRef(name string) v1.BackendRef {
+	return v1.BackendRef{BackendObjectReference: v1.BackendObjectReference{
+		Name: v1.ObjectName(name),
+		Port: new(v1.PortNumber(443)),
+	}}
+}
+
+func TestConversionWebhook(t *testing.T) {
+	c := newClient(t)
+	ctx := context.Background()
+	parentRefs := []v1.ParentReference{{Name: "gateway"}}
+
+	t.Run("TLSRoute rules beyond the v1 maximum round trip", func(t *testing.T) {
+		route := &v1alpha2.TLSRoute{
+			ObjectMeta: metav1.ObjectMeta{Name: "tls", Namespace: metav1.NamespaceDefault},
+			Spec: v1alpha2.TLSRouteSpec{
+				CommonRouteSpec: v1.CommonRouteSpec{ParentRefs: parentRefs},
+				Hostnames:       []v1.Hostname{"foo.example.com"},
+				Rules: []v1alpha2.TLSRouteRule{
+					{Name: new(v1.SectionName("a")), BackendRefs: []v1.BackendRef{backendRef("a")}},
+					{Name: new(v1.SectionName("b")), BackendRefs: []v1.BackendRef{backendRef("b")}},
+				},
+			},
+		}
+		require.NoError(t, c.Create(ctx, route))
+		key := client.ObjectKeyFromObject(route)
+
+		hub := &v1.TLSRoute{}
+		require.NoError(t, c.Get(ctx, key, hub))
+		require.Len(t, hub.Spec.Rules, 1)
+		assert.Equal(t, v1.SectionName("a"), *hub.Spec.Rules[0].Name)
+		assert.Contains(t, hub.Annotations, consts.ConversionDataAnnotation)
+
+		v1alpha3Route := &v1alpha3.TLSRoute{}
+		require.NoError(t, c.Get(ctx, key, v1alpha3Route))
+		assert.Len(t, v1alpha3Route.Spec.Rules, 1)
+		assert.Contains(t, v1alpha3Route.Annotations, consts.ConversionDataAnnotation)
+
+		// updates in v1 keep the rules it can't represent
+		hub.Spec.Hostnames = []v1.Hostname{"bar.example.com"}
+		require.NoError(t, c.Update(ctx, hub))
+
+		res := &v1alpha2.TLSRoute{}
+		require.NoError(t, c.Get(ctx, key, res))
+		assert.Equal(t, []v1.Hostname{"bar.example.com"}, res.Spec.Hostnames)
+		assert.Equal(t, route.Spec.Rules, res.Spec.Rules)
+		assert.NotContains(t, res.Annotations, consts.ConversionDataAnnotation)
+	})
+
+	t.Run("TCPRoute and UDPRoute rules round trip", func(t *testing.T) {
+		tcpRoute := &v1alpha2.TCPRoute{
+			ObjectMeta: metav1.ObjectMeta{Name: "tcp", Namespace: metav1.NamespaceDefault},
+			Spec: v1alpha2.TCPRouteSpec{
+				CommonRouteSpec: v1.CommonRouteSpec{ParentRefs: parentRefs},
+				Rules: []v1alpha2.TCPRouteRule{
+					{BackendRefs: []v1.BackendRef{backendRef("a")}},
+					{BackendRefs: []v1.BackendRef{backendRef("b")}},
+				},
+			},
+		}
+		require.NoError(t, c.Create(ctx, tcpRoute))
+		tcpRes := &v1alpha2.TCPRoute{}
+		require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(tcpRoute), tcpRes))
+		assert.Equal(t, tcpRoute.Spec, tcpRes.Spec)
+
+		udpRoute := &v1alpha2.UDPRoute{
+			ObjectMeta: metav1.ObjectMeta{Name: "udp", Namespace: metav1.NamespaceDefault},
+			Spec: v1alpha2.UDPRouteSpec{
+				CommonRouteSpec: v1.CommonRouteSpec{ParentRefs: parentRefs},
+				Rules: []v1alpha2.UDPRouteRule{
+					{BackendRefs: []v1.BackendRef{backendRef("a")}},
+					{BackendRefs: []v1.BackendRef{backendRef("b")}},
+				},
+			},
+		}
+		require.NoError(t, c.Create(ctx, udpRoute))
+		udpHub := &v1.UDPRoute{}
+		require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(udpRoute), udpHub))
+		assert.Len(t, udpHub.Spec.Rules, 1)
+		udpRes := &v1alpha2.UDPRoute{}
+		require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(udpRoute), udpRes))
+		assert.Equal(t, udpRoute.Spec, udpRes.Spec)
+	})
+
+	t.Run("BackendTLSPolicy v1alpha3 is converted to v1", func(t *testing.T) {
+		policy := &v1alpha3.BackendTLSPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "policy", Namespace: metav1.NamespaceDefault},
+			Spec: v1.BackendTLSPolicySpec{
+				TargetRefs: []v1.LocalPolicyTargetReferenceWithSectionName{{
+					LocalPolicyTargetReference: v1.LocalPolicyTargetReference{Kind: "Service", Name: "backend"},
+				}},
+				Validation: v1.BackendTLSPolicyValidation{
+					WellKnownCACertificates: new(v1.WellKnownCACertificatesType("System")),
+					Hostname:                "foo.example.com",
+				},
+			},
+		}
+		require.NoError(t, c.Create(ctx, policy))
+		hub := &v1.BackendTLSPolicy{}
+		require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(policy), hub))
+		assert.Equal(t, policy.Spec, hub.Spec)
+	})
+
+	t.Run("older versions can still be listed", func(t *testing.T) {
+		list := &v1alpha2.TLSRouteList{}
+		require.NoError(t, c.List(ctx, list, client.InNamespace(metav1.NamespaceDefault)))
+		require.Len(t, list.Items, 1)
+		assert.Len(t, list.Items[0].Spec.Rules, 2)
+	})
+}
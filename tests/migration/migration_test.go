@@ -0,0 +1,164 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package migration_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiextensionsclientset "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/flowcontrol"
+	"sigs.k8s.io/controller-runtime/pkg/envtest"
+
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/crdtools/migration"
+	"sigs.k8s.io/gateway-api/pkg/client/clientset/versioned"
+)
+
+const gatewaysCRD = "gateways.gateway.networking.k8s.io"
+
+func TestMigrate(t *testing.T) {
+	testEnv := &envtest.Environment{
+		ErrorIfCRDPathMissing:       true,
+		DownloadBinaryAssets:        true,
+		DownloadBinaryAssetsVersion: os.Getenv("K8S_VERSION"),
+		CRDInstallOptions: envtest.CRDInstallOptions{
+			Paths:           []string{filepath.Join("..", "..", "config", "crd", "experimental")},
+			CleanUpAfterUse: true,
+		},
+	}
+	restConfig, err := testEnv.Start()
+	require.NoError(t, err, "Error initializing test environment")
+	t.Cleanup(func() {
+		require.NoError(t, testEnv.Stop())
+	})
+	crdClient, err := apiextensionsclientset.NewForConfig(restConfig)
+	require.NoError(t, err)
+	gatewayClient, err := versioned.NewForConfig(restConfig)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	// Store Gateways in v1beta1, then go back to v1 so that v1beta1 remains
+	// in the stored versions until the Gateways are migrated.
+	setStorageVersion(t, crdClient, gatewaysCRD, "v1beta1")
+	resourceVersions := map[string]string{}
+	for _, name := range []string{"a", "b", "c"} {
+		gw, err := gatewayClient.GatewayV1().Gateways(metav1.NamespaceDefault).Create(ctx, &v1.Gateway{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: v1.GatewaySpec{
+				GatewayClassName: "example",
+				Listeners:        []v1.Listener{{Name: "http", Port: 80, Protocol: v1.HTTPProtocolType}},
+			},
+		}, metav1.CreateOptions{})
+		require.NoError(t, err)
+		resourceVersions[name] = gw.ResourceVersion
+	}
+	setStorageVersion(t, crdClient, gatewaysCRD, "v1")
+	require.ElementsMatch(t, []string{"v1", "v1beta1"}, storedVersions(t, crdClient, gatewaysCRD))
+
+	t.Run("dry run doesn't persist anything", func(t *testing.T) {
+		require.NoError(t, migration.Migrate(ctx, crdClient, gatewayClient, migration.Options{
+			DryRun: true,
+			Checkpoint: func(*migration.Progress) error {
+				return errors.New("checkpoint in dry run")
+			},
+		}))
+		assert.ElementsMatch(t, []string{"v1", "v1beta1"}, storedVersions(t, crdClient, gatewaysCRD))
+		assert.Equal(t, resourceVersions, gatewayResourceVersions(t, gatewayClient))
+	})
+
+	t.Run("interrupted migration is resumed", func(t *testing.T) {
+		progress := &migration.Progress{}
+		errInterrupted := errors.New("interrupted")
+		err := migration.Migrate(ctx, crdClient, gatewayClient, migration.Options{
+			PageSize:    1,
+			RateLimiter: flowcontrol.NewTokenBucketRateLimiter(100, 1),
+			Progress:    progress,
+			Checkpoint: func(*migration.Progress) error {
+				return errInterrupted
+			},
+		})
+		require.ErrorIs(t, err, errInterrupted)
+		require.Contains(t, progress.CRDs, gatewaysCRD)
+		assert.Equal(t, 1, progress.CRDs[gatewaysCRD].Rewritten)
+		assert.NotEmpty(t, progress.CRDs[gatewaysCRD].Continue)
+		assert.False(t, progress.CRDs[gatewaysCRD].Completed)
+		assert.ElementsMatch(t, []string{"v1", "v1beta1"}, storedVersions(t, crdClient, gatewaysCRD))
+
+		var checkpoints int
+		require.NoError(t, migration.Migrate(ctx, crdClient, gatewayClient, migration.Options{
+			PageSize: 1,
+			Progress: progress,
+			Checkpoint: func(*migration.Progress) error {
+				checkpoints++
+				return nil
+			},
+		}))
+		assert.Equal(t, &migration.CRDProgress{StorageVersion: "v1", Rewritten: 3, Completed: true}, progress.CRDs[gatewaysCRD])
+		assert.Positive(t, checkpoints)
+		assert.Equal(t, []string{"v1"}, storedVersions(t, crdClient, gatewaysCRD))
+		for name, rv := range gatewayResourceVersions(t, gatewayClient) {
+			assert.NotEqual(t, resourceVersions[name], rv, "Gateway %s wasn't rewritten", name)
+		}
+	})
+
+	t.Run("migrated CRDs are skipped", func(t *testing.T) {
+		progress := &migration.Progress{}
+		require.NoError(t, migration.Migrate(ctx, crdClient, gatewayClient, migration.Options{Progress: progress}))
+		for name, p := range progress.CRDs {
+			assert.True(t, p.Completed, name)
+			assert.Zero(t, p.Rewritten, name)
+		}
+	})
+}
+
+// setStorageVersion sets the storage version of the CRD name.
+func setStorageVersion(t *testing.T, c apiextensionsclientset.Interface, name, version string) {
+	t.Helper()
+	crds := c.ApiextensionsV1().CustomResourceDefinitions()
+	crd, err := crds.Get(context.Background(), name, metav1.GetOptions{})
+	require.NoError(t, err)
+	for i := range crd.Spec.Versions {
+		crd.Spec.Versions[i].Storage = crd.Spec.Versions[i].Name == version
+	}
+	_, err = crds.Update(context.Background(), crd, metav1.UpdateOptions{})
+	require.NoError(t, err)
+}
+
+func storedVersions(t *testing.T, c apiextensionsclientset.Interface, name string) []string {
+	t.Helper()
+	crd, err := c.ApiextensionsV1().CustomResourceDefinitions().Get(context.Background(), name, metav1.GetOptions{})
+	require.NoError(t, err)
+	return crd.Status.StoredVersions
+}
+
+func gatewayResourceVersions(t *testing.T, c versioned.Interface) map[string]string {
+	t.Helper()
+	gateways, err := c.GatewayV1().Gateways(metav1.NamespaceDefault).List(context.Background(), metav1.ListOptions{})
+	require.NoError(t, err)
+	resourceVersions := map[string]string{}
+	for _, gw := range gateways.Items {
+		resourceVersions[gw.Name] = gw.ResourceVersion
+	}
+	return resourceVersions
+}
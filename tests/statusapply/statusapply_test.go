@@ -0,0 +1,244 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package statusapply_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1ac "k8s.io/client-go/applyconfigurations/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/envtest"
+
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	apisxv1alpha1 "sigs.k8s.io/gateway-api/apisx/v1alpha1"
+	applyv1 "sigs.k8s.io/gateway-api/applyconfiguration/apis/v1"
+	applyxv1alpha1 "sigs.k8s.io/gateway-api/applyconfiguration/apisx/v1alpha1"
+	"sigs.k8s.io/gateway-api/pkg/builder"
+	"sigs.k8s.io/gateway-api/pkg/client/clientset/versioned"
+	"sigs.k8s.io/gateway-api/pkg/client/statusapply"
+)
+
+const (
+	controllerA = gatewayv1.GatewayController("example.com/controller-a")
+	controllerB = gatewayv1.GatewayController("example.com/controller-b")
+
+	// updates is the number of status updates of each controller.
+	updates = 10
+)
+
+func newClient(t *testing.T) versioned.Interface {
+	t.Helper()
+	testEnv := &envtest.Environment{
+		ErrorIfCRDPathMissing:       true,
+		DownloadBinaryAssets:        true,
+		DownloadBinaryAssetsVersion: os.Getenv("K8S_VERSION"),
+		CRDInstallOptions: envtest.CRDInstallOptions{
+			Paths:           []string{filepath.Join("..", "..", "config", "crd", "experimental")},
+			CleanUpAfterUse: true,
+		},
+	}
+	restConfig, err := testEnv.Start()
+	require.NoError(t, err, "Error initializing test environment")
+	t.Cleanup(func() {
+		require.NoError(t, testEnv.Stop())
+	})
+	c, err := versioned.NewForConfig(restConfig)
+	require.NoError(t, err)
+	return c
+}
+
+func condition(conditionType string, message string) *metav1ac.ConditionApplyConfiguration {
+	return metav1ac.Condition().
+		WithType(conditionType).
+		WithStatus(metav1.ConditionTrue).
+		WithReason(conditionType).
+		WithMessage(message).
+		WithLastTransitionTime(metav1.Now())
+}
+
+// concurrently runs update for i in [0, updates) in two goroutines, one for
+// each controller, and returns the errors.
+func concurrently(update func(controller gatewayv1.GatewayController, i int) error) []error {
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var errs []error
+	for _, controller := range []gatewayv1.GatewayController{controllerA, controllerB} {
+		wg.Go(func() {
+			for i := range updates {
+				if err := update(controller, i); err != nil {
+					mu.Lock()
+					errs = append(errs, fmt.Errorf("%s: %w", controller, err))
+					mu.Unlock()
+				}
+			}
+		})
+	}
+	wg.Wait()
+	return errs
+}
+
+func TestApplier(t *testing.T) {
+	c := newClient(t)
+	ctx := context.Background()
+	ns := metav1.NamespaceDefault
+	appliers := map[gatewayv1.GatewayController]*statusapply.Applier{
+		controllerA: statusapply.NewApplier(c, controllerA, "controller-a"),
+		controllerB: statusapply.NewApplier(c, controllerB, "controller-b"),
+	}
+	// Each controller is responsible for the Gateway of the same name.
+	gateways := map[gatewayv1.GatewayController]*gatewayv1.Gateway{}
+	for controller, name := range map[gatewayv1.GatewayController]string{controllerA: "a", controllerB: "b"} {
+		gw, err := c.GatewayV1().Gateways(ns).Create(ctx, builder.NewGateway(ns, name, name).HTTPListener("http", 80).Build(), metav1.CreateOptions{})
+		require.NoError(t, err)
+		gateways[controller] = gw
+	}
+
+	t.Run("route parents of concurrent controllers are kept", func(t *testing.T) {
+		route := builder.NewHTTPRoute(ns, "route").
+			AttachTo(gateways[controllerA], "").
+			AttachTo(gateways[controllerB], "").
+			Rule().Backend("svc", 80, 1).
+			Build()
+		_, err := c.GatewayV1().HTTPRoutes(ns).Create(ctx, route, metav1.CreateOptions{})
+		require.NoError(t, err)
+
+		errs := concurrently(func(controller gatewayv1.GatewayController, i int) error {
+			_, applyErr := appliers[controller].HTTPRouteParents(ctx, ns, "route", applyv1.RouteParentStatus().
+				WithParentRef(applyv1.ParentReference().WithName(gatewayv1.ObjectName(gateways[controller].Name))).
+				WithConditions(condition(string(gatewayv1.RouteConditionAccepted), fmt.Sprint(i))))
+			return applyErr
+		})
+		require.Empty(t, errs)
+
+		route, err = c.GatewayV1().HTTPRoutes(ns).Get(ctx, "route", metav1.GetOptions{})
+		require.NoError(t, err)
+		require.Len(t, route.Status.Parents, 2)
+		for _, parent := range route.Status.Parents {
+			assert.Equal(t, gateways[parent.ControllerName].Name, string(parent.ParentRef.Name))
+			accepted := apimeta.FindStatusCondition(parent.Conditions, string(gatewayv1.RouteConditionAccepted))
+			require.NotNil(t, accepted)
+			assert.Equal(t, fmt.Sprint(updates-1), accepted.Message)
+		}
+
+		route, err = appliers[controllerA].HTTPRouteParents(ctx, ns, "route")
+		require.NoError(t, err)
+		require.Len(t, route.Status.Parents, 1)
+		assert.Equal(t, controllerB, route.Status.Parents[0].ControllerName)
+
+		route, err = appliers[controllerB].HTTPRouteParents(ctx, ns, "route")
+		require.NoError(t, err)
+		assert.Empty(t, route.Status.Parents)
+	})
+
+	t.Run("policy ancestors of concurrent controllers are kept", func(t *testing.T) {
+		policy := builder.NewBackendTLSPolicy(ns, "policy").
+			TargetService("svc", "").
+			Hostname("svc.example.com").
+			WellKnownCACertificates(gatewayv1.WellKnownCACertificatesSystem).
+			Build()
+		_, err := c.GatewayV1().BackendTLSPolicies(ns).Create(ctx, policy, metav1.CreateOptions{})
+		require.NoError(t, err)
+
+		errs := concurrently(func(controller gatewayv1.GatewayController, i int) error {
+			_, applyErr := appliers[controller].BackendTLSPolicyAncestors(ctx, ns, "policy", applyv1.PolicyAncestorStatus().
+				WithAncestorRef(applyv1.ParentReference().WithName(gatewayv1.ObjectName(gateways[controller].Name))).
+				WithConditions(condition(string(gatewayv1.PolicyConditionAccepted), fmt.Sprint(i))))
+			return applyErr
+		})
+		require.Empty(t, errs)
+
+		policy, err = c.GatewayV1().BackendTLSPolicies(ns).Get(ctx, "policy", metav1.GetOptions{})
+		require.NoError(t, err)
+		require.Len(t, policy.Status.Ancestors, 2)
+		for _, ancestor := range policy.Status.Ancestors {
+			assert.Equal(t, gateways[ancestor.ControllerName].Name, string(ancestor.AncestorRef.Name))
+			accepted := apimeta.FindStatusCondition(ancestor.Conditions, string(gatewayv1.PolicyConditionAccepted))
+			require.NotNil(t, accepted)
+			assert.Equal(t, fmt.Sprint(updates-1), accepted.Message)
+		}
+	})
+
+	t.Run("Gateway conditions of concurrent controllers are kept", func(t *testing.T) {
+		// controller-a is the controller of the Gateway, and controller-b a
+		// policy controller adding its own condition.
+		conditionTypes := map[gatewayv1.GatewayController]string{
+			controllerA: string(gatewayv1.GatewayConditionProgrammed),
+			controllerB: "example.com/PolicyAffected",
+		}
+		errs := concurrently(func(controller gatewayv1.GatewayController, i int) error {
+			_, applyErr := appliers[controller].GatewayStatus(ctx, ns, "a", applyv1.GatewayStatus().
+				WithConditions(condition(conditionTypes[controller], fmt.Sprint(i))))
+			return applyErr
+		})
+		require.Empty(t, errs)
+
+		gw, err := c.GatewayV1().Gateways(ns).Get(ctx, "a", metav1.GetOptions{})
+		require.NoError(t, err)
+		for _, conditionType := range conditionTypes {
+			cond := apimeta.FindStatusCondition(gw.Status.Conditions, conditionType)
+			require.NotNil(t, cond, conditionType)
+			assert.Equal(t, metav1.ConditionTrue, cond.Status)
+			assert.Equal(t, fmt.Sprint(updates-1), cond.Message)
+		}
+	})
+
+	t.Run("ListenerSet status", func(t *testing.T) {
+		ls := &gatewayv1.ListenerSet{
+			ObjectMeta: metav1.ObjectMeta{Namespace: ns, Name: "listeners"},
+			Spec: gatewayv1.ListenerSetSpec{
+				ParentRef: gatewayv1.ParentGatewayReference{Name: "a"},
+				Listeners: []gatewayv1.ListenerEntry{{Name: "extra", Port: 8080, Protocol: gatewayv1.HTTPProtocolType}},
+			},
+		}
+		_, err := c.GatewayV1().ListenerSets(ns).Create(ctx, ls, metav1.CreateOptions{})
+		require.NoError(t, err)
+
+		ls, err = appliers[controllerA].ListenerSetStatus(ctx, ns, "listeners", applyv1.ListenerSetStatus().
+			WithConditions(condition(string(gatewayv1.ListenerSetConditionAccepted), "")).
+			WithListeners(applyv1.ListenerEntryStatus().
+				WithName("extra").
+				WithAttachedRoutes(0).
+				WithSupportedKinds(applyv1.RouteGroupKind().WithGroup(gatewayv1.GroupName).WithKind("HTTPRoute")).
+				WithConditions(condition(string(gatewayv1.ListenerEntryConditionAccepted), ""))))
+		require.NoError(t, err)
+		assert.True(t, apimeta.IsStatusConditionTrue(ls.Status.Conditions, string(gatewayv1.ListenerSetConditionAccepted)))
+		require.Len(t, ls.Status.Listeners, 1)
+		assert.Equal(t, gatewayv1.SectionName("extra"), ls.Status.Listeners[0].Name)
+	})
+
+	t.Run("XMesh status", func(t *testing.T) {
+		mesh := &apisxv1alpha1.XMesh{
+			ObjectMeta: metav1.ObjectMeta{Name: "mesh"},
+			Spec:       apisxv1alpha1.MeshSpec{ControllerName: controllerA},
+		}
+		_, err := c.ExperimentalV1alpha1().XMeshes().Create(ctx, mesh, metav1.CreateOptions{})
+		require.NoError(t, err)
+
+		mesh, err = appliers[controllerA].XMeshStatus(ctx, "mesh", applyxv1alpha1.MeshStatus().
+			WithConditions(condition(string(apisxv1alpha1.MeshConditionAccepted), "")))
+		require.NoError(t, err)
+		assert.True(t, apimeta.IsStatusConditionTrue(mesh.Status.Conditions, string(apisxv1alpha1.MeshConditionAccepted)))
+	})
+}